@@ -0,0 +1,437 @@
+// Package attrjson encodes attr.Value into a stable JSON representation and
+// decodes that representation back into attr.Value, given an attr.Type. It is
+// intended for debugging, logging, and golden file testing purposes.
+//
+// Values are represented as follows:
+//
+//   - Null values of any type are JSON null.
+//   - Unknown values of any type are the JSON object {"$unknown":true}.
+//   - Bool values are JSON booleans.
+//   - Number values are JSON numbers, without loss of precision.
+//   - String values are JSON strings.
+//   - List, Set, and Tuple values are JSON arrays. Set elements are sorted by
+//     their encoded representation, so the output is stable.
+//   - Map and Object values are JSON objects. Map keys beginning with "$" are
+//     prefixed with an additional "$", so they cannot be confused with the
+//     unknown value representation.
+package attrjson
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// unknownKey is the only key of the JSON object representing an unknown value.
+const unknownKey = "$unknown"
+
+// Marshal returns the JSON encoding of the attr.Value.
+func Marshal(ctx context.Context, val attr.Value) ([]byte, error) {
+	if val == nil {
+		return nil, fmt.Errorf("cannot marshal nil attr.Value")
+	}
+
+	tfValue, err := val.ToTerraformValue(ctx)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert %T to tftypes.Value: %w", val, err)
+	}
+
+	encoded, err := encode(tftypes.NewAttributePath(), tfValue)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(encoded)
+}
+
+// MarshalIndent is like Marshal, but applies json.Indent to format the output.
+func MarshalIndent(ctx context.Context, val attr.Value, prefix, indent string) ([]byte, error) {
+	b, err := Marshal(ctx, val)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	if err := json.Indent(&buf, b, prefix, indent); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Unmarshal returns the attr.Value of the given attr.Type represented by the
+// JSON encoded data.
+func Unmarshal(ctx context.Context, typ attr.Type, data []byte) (attr.Value, error) {
+	if typ == nil {
+		return nil, fmt.Errorf("cannot unmarshal into nil attr.Type")
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var raw interface{}
+
+	if err := dec.Decode(&raw); err != nil {
+		return nil, fmt.Errorf("unable to decode JSON: %w", err)
+	}
+
+	if dec.More() {
+		return nil, fmt.Errorf("unable to decode JSON: unexpected data after top-level value")
+	}
+
+	tfValue, err := decode(tftypes.NewAttributePath(), typ.TerraformType(ctx), raw)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return typ.ValueFromTerraform(ctx, tfValue)
+}
+
+// encode converts a tftypes.Value into a value which encoding/json will
+// marshal into the stable representation.
+func encode(path *tftypes.AttributePath, val tftypes.Value) (interface{}, error) {
+	if !val.IsKnown() {
+		return map[string]bool{unknownKey: true}, nil
+	}
+
+	if val.IsNull() {
+		return nil, nil
+	}
+
+	typ := val.Type()
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		var b bool
+
+		if err := val.As(&b); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		return b, nil
+	case typ.Is(tftypes.Number):
+		// A zero precision big.Float adopts the precision of the value.
+		n := new(big.Float)
+
+		if err := val.As(n); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		return json.Number(n.Text('g', -1)), nil
+	case typ.Is(tftypes.String):
+		var s string
+
+		if err := val.As(&s); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		return s, nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		result := make([]interface{}, 0, len(elems))
+
+		for idx, elem := range elems {
+			encoded, err := encode(path.WithElementKeyInt(idx), elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, encoded)
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Set{}):
+		var elems []tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		result := make([]json.RawMessage, 0, len(elems))
+
+		for _, elem := range elems {
+			encoded, err := encode(path.WithElementKeyValue(elem), elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			b, err := json.Marshal(encoded)
+
+			if err != nil {
+				return nil, path.NewError(err)
+			}
+
+			result = append(result, b)
+		}
+
+		sort.Slice(result, func(i, j int) bool {
+			return bytes.Compare(result[i], result[j]) < 0
+		})
+
+		return result, nil
+	case typ.Is(tftypes.Map{}):
+		var elems map[string]tftypes.Value
+
+		if err := val.As(&elems); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		result := make(map[string]interface{}, len(elems))
+
+		for key, elem := range elems {
+			encoded, err := encode(path.WithElementKeyString(key), elem)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[escapeMapKey(key)] = encoded
+		}
+
+		return result, nil
+	case typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+
+		if err := val.As(&attrs); err != nil {
+			return nil, path.NewError(err)
+		}
+
+		result := make(map[string]interface{}, len(attrs))
+
+		for name, attrValue := range attrs {
+			encoded, err := encode(path.WithAttributeName(name), attrValue)
+
+			if err != nil {
+				return nil, err
+			}
+
+			result[name] = encoded
+		}
+
+		return result, nil
+	default:
+		return nil, path.NewErrorf("unsupported type %s", typ)
+	}
+}
+
+// decode converts a value produced by encoding/json, using json.Number for
+// numbers, into a tftypes.Value of the given type.
+func decode(path *tftypes.AttributePath, typ tftypes.Type, raw interface{}) (tftypes.Value, error) {
+	if raw == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	if isUnknown(raw) {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch typ := typ.(type) {
+	case tftypes.List:
+		elems, ok := raw.([]interface{})
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON array for %s, got %T", typ, raw)
+		}
+
+		vals := make([]tftypes.Value, 0, len(elems))
+
+		for idx, elem := range elems {
+			val, err := decode(path.WithElementKeyInt(idx), typ.ElementType, elem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals = append(vals, val)
+		}
+
+		return newValue(path, typ, vals)
+	case tftypes.Set:
+		elems, ok := raw.([]interface{})
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON array for %s, got %T", typ, raw)
+		}
+
+		vals := make([]tftypes.Value, 0, len(elems))
+
+		for idx, elem := range elems {
+			// Set element values are not known yet, so the element index is
+			// used for error reporting instead.
+			val, err := decode(path.WithElementKeyInt(idx), typ.ElementType, elem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals = append(vals, val)
+		}
+
+		return newValue(path, typ, vals)
+	case tftypes.Tuple:
+		elems, ok := raw.([]interface{})
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON array for %s, got %T", typ, raw)
+		}
+
+		if len(elems) != len(typ.ElementTypes) {
+			return tftypes.Value{}, path.NewErrorf("expected %d tuple elements, got %d", len(typ.ElementTypes), len(elems))
+		}
+
+		vals := make([]tftypes.Value, 0, len(elems))
+
+		for idx, elem := range elems {
+			val, err := decode(path.WithElementKeyInt(idx), typ.ElementTypes[idx], elem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals = append(vals, val)
+		}
+
+		return newValue(path, typ, vals)
+	case tftypes.Map:
+		elems, ok := raw.(map[string]interface{})
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON object for %s, got %T", typ, raw)
+		}
+
+		vals := make(map[string]tftypes.Value, len(elems))
+
+		for escapedKey, elem := range elems {
+			key := unescapeMapKey(escapedKey)
+			val, err := decode(path.WithElementKeyString(key), typ.ElementType, elem)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals[key] = val
+		}
+
+		return newValue(path, typ, vals)
+	case tftypes.Object:
+		attrs, ok := raw.(map[string]interface{})
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON object for %s, got %T", typ, raw)
+		}
+
+		vals := make(map[string]tftypes.Value, len(typ.AttributeTypes))
+
+		for name := range attrs {
+			if _, ok := typ.AttributeTypes[name]; !ok {
+				return tftypes.Value{}, path.NewErrorf("unexpected attribute %q", name)
+			}
+		}
+
+		for name, attrType := range typ.AttributeTypes {
+			val, err := decode(path.WithAttributeName(name), attrType, attrs[name])
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			vals[name] = val
+		}
+
+		return newValue(path, typ, vals)
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		b, ok := raw.(bool)
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON boolean for %s, got %T", typ, raw)
+		}
+
+		return newValue(path, typ, b)
+	case typ.Is(tftypes.Number):
+		n, ok := raw.(json.Number)
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON number for %s, got %T", typ, raw)
+		}
+
+		f, _, err := big.ParseFloat(n.String(), 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		return newValue(path, typ, f)
+	case typ.Is(tftypes.String):
+		s, ok := raw.(string)
+
+		if !ok {
+			return tftypes.Value{}, path.NewErrorf("expected JSON string for %s, got %T", typ, raw)
+		}
+
+		return newValue(path, typ, s)
+	default:
+		return tftypes.Value{}, path.NewErrorf("unsupported type %s", typ)
+	}
+}
+
+// isUnknown returns true if the decoded JSON value is the unknown value
+// representation.
+func isUnknown(raw interface{}) bool {
+	m, ok := raw.(map[string]interface{})
+
+	if !ok || len(m) != 1 {
+		return false
+	}
+
+	b, ok := m[unknownKey].(bool)
+
+	return ok && b
+}
+
+// newValue wraps tftypes.NewValue with validation, so invalid data returns an
+// error rather than panicking.
+func newValue(path *tftypes.AttributePath, typ tftypes.Type, val interface{}) (tftypes.Value, error) {
+	if err := tftypes.ValidateValue(typ, val); err != nil {
+		return tftypes.Value{}, path.NewError(err)
+	}
+
+	return tftypes.NewValue(typ, val), nil
+}
+
+func escapeMapKey(key string) string {
+	if strings.HasPrefix(key, "$") {
+		return "$" + key
+	}
+
+	return key
+}
+
+func unescapeMapKey(key string) string {
+	if strings.HasPrefix(key, "$") {
+		return key[1:]
+	}
+
+	return key
+}
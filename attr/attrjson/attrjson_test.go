@@ -0,0 +1,240 @@
+package attrjson_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrjson"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMarshalUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"bool":   types.BoolType,
+			"list":   types.ListType{ElemType: types.StringType},
+			"map":    types.MapType{ElemType: types.Int64Type},
+			"number": types.NumberType,
+			"set":    types.SetType{ElemType: types.StringType},
+			"string": types.StringType,
+		},
+	}
+
+	testCases := map[string]struct {
+		value    attr.Value
+		expected string
+	}{
+		"bool": {
+			value:    types.Bool{Value: true},
+			expected: `true`,
+		},
+		"bool-null": {
+			value:    types.Bool{Null: true},
+			expected: `null`,
+		},
+		"bool-unknown": {
+			value:    types.Bool{Unknown: true},
+			expected: `{"$unknown":true}`,
+		},
+		"float64": {
+			value:    types.Float64{Value: 1.5},
+			expected: `1.5`,
+		},
+		"int64": {
+			value:    types.Int64{Value: 123},
+			expected: `123`,
+		},
+		"number-large": {
+			value:    types.Number{Value: big.NewFloat(1.5e+300)},
+			expected: `1.5e+300`,
+		},
+		"string": {
+			value:    types.String{Value: "test"},
+			expected: `"test"`,
+		},
+		"list": {
+			value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "b"},
+					types.String{Unknown: true},
+					types.String{Value: "a"},
+				},
+			},
+			expected: `["b",{"$unknown":true},"a"]`,
+		},
+		"map-escaped-keys": {
+			value: types.Map{
+				ElemType: types.BoolType,
+				Elems: map[string]attr.Value{
+					"$unknown": types.Bool{Value: true},
+					"key":      types.Bool{Null: true},
+				},
+			},
+			expected: `{"$$unknown":true,"key":null}`,
+		},
+		"set-sorted": {
+			value: types.Set{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "c"},
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+				},
+			},
+			expected: `["a","b","c"]`,
+		},
+		"object": {
+			value: types.Object{
+				AttrTypes: objectType.AttrTypes,
+				Attrs: map[string]attr.Value{
+					"bool": types.Bool{Value: false},
+					"list": types.List{
+						ElemType: types.StringType,
+						Elems: []attr.Value{
+							types.String{Value: "one"},
+						},
+					},
+					"map":    types.Map{ElemType: types.Int64Type, Unknown: true},
+					"number": types.Number{Value: big.NewFloat(1.25)},
+					"set":    types.Set{ElemType: types.StringType, Null: true},
+					"string": types.String{Value: "two"},
+				},
+			},
+			expected: `{"bool":false,"list":["one"],"map":{"$unknown":true},"number":1.25,"set":null,"string":"two"}`,
+		},
+		"object-null": {
+			value: types.Object{
+				AttrTypes: objectType.AttrTypes,
+				Null:      true,
+			},
+			expected: `null`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			got, err := attrjson.Marshal(ctx, tc.value)
+
+			if err != nil {
+				t.Fatalf("unexpected marshal error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), tc.expected); diff != "" {
+				t.Errorf("unexpected marshal difference: %s", diff)
+			}
+
+			roundTrip, err := attrjson.Unmarshal(ctx, tc.value.Type(ctx), got)
+
+			if err != nil {
+				t.Fatalf("unexpected unmarshal error: %s", err)
+			}
+
+			// Sets are compared by encoding again, since element order is
+			// not preserved.
+			again, err := attrjson.Marshal(ctx, roundTrip)
+
+			if err != nil {
+				t.Fatalf("unexpected marshal error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(again), tc.expected); diff != "" {
+				t.Errorf("unexpected round trip difference: %s", diff)
+			}
+
+			if _, ok := tc.value.(types.Set); !ok && !roundTrip.Equal(tc.value) {
+				t.Errorf("expected round trip value %#v, got %#v", tc.value, roundTrip)
+			}
+		})
+	}
+}
+
+func TestMarshalIndent(t *testing.T) {
+	t.Parallel()
+
+	got, err := attrjson.MarshalIndent(context.Background(), types.List{
+		ElemType: types.StringType,
+		Elems: []attr.Value{
+			types.String{Value: "a"},
+		},
+	}, "", "  ")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := "[\n  \"a\"\n]"
+
+	if diff := cmp.Diff(string(got), expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		typ         attr.Type
+		data        string
+		expectedErr string
+	}{
+		"invalid-json": {
+			typ:         types.StringType,
+			data:        `"test`,
+			expectedErr: "unable to decode JSON: unexpected EOF",
+		},
+		"mismatched-type": {
+			typ:         types.StringType,
+			data:        `true`,
+			expectedErr: "expected JSON string for tftypes.String, got bool",
+		},
+		"trailing-data": {
+			typ:         types.StringType,
+			data:        `"a" "b"`,
+			expectedErr: "unable to decode JSON: unexpected data after top-level value",
+		},
+		"unexpected-attribute": {
+			typ: types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"test": types.StringType,
+				},
+			},
+			data:        `{"other":"value"}`,
+			expectedErr: `unexpected attribute "other"`,
+		},
+		"nested-mismatched-type": {
+			typ:         types.ListType{ElemType: types.Int64Type},
+			data:        `[1,"two"]`,
+			expectedErr: "ElementKeyInt(1): expected JSON number for tftypes.Number, got string",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := attrjson.Unmarshal(context.Background(), tc.typ, []byte(tc.data))
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if diff := cmp.Diff(err.Error(), tc.expectedErr); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Schema defines the shape of a data source.
+type Schema struct {
+	// Attributes are value fields inside the data source. The map key is the
+	// name of the attribute, and the body defines how it behaves. Names
+	// must only contain lowercase letters, numbers, and underscores. Names
+	// must not collide with any Blocks names.
+	//
+	// Attributes are strongly preferred over Blocks.
+	Attributes map[string]Attribute
+
+	// Blocks are structural fields inside the data source. The map key is
+	// the name of the block, and the body defines how it behaves. Names
+	// must only contain lowercase letters, numbers, and underscores. Names
+	// must not collide with any Attributes names.
+	//
+	// Deprecated: Attributes are preferred over Blocks. Blocks should only be
+	// used for configuration compatibility with previously existing schemas
+	// from an older Terraform Plugin SDK.
+	Blocks map[string]Block
+
+	// DeprecationMessage defines a message to display to practitioners
+	// using this data source, warning them that it is deprecated and
+	// instructing them on what upgrade steps to take.
+	DeprecationMessage string
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this data source is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this data source is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription string
+}
+
+// ToTfsdkSchema returns the equivalent tfsdk.Schema.
+func (s Schema) ToTfsdkSchema() tfsdk.Schema {
+	return tfsdk.Schema{
+		Attributes:          tfsdkAttributes(s.Attributes),
+		Blocks:              tfsdkBlocks(s.Blocks),
+		DeprecationMessage:  s.DeprecationMessage,
+		Description:         s.Description,
+		MarkdownDescription: s.MarkdownDescription,
+	}
+}
@@ -1,7 +1,7 @@
 package diag
 
 import (
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // Diagnostic is an interface for providing enhanced feedback.
@@ -48,5 +48,5 @@ type DiagnosticWithPath interface {
 	//
 	// If present, this enables the display of source configuration context for
 	// supporting implementations such as Terraform CLI commands.
-	Path() *tftypes.AttributePath
+	Path() path.Path
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -14,45 +15,45 @@ func TestDiagnosticsAddAttributeError(t *testing.T) {
 
 	testCases := map[string]struct {
 		diags    diag.Diagnostics
-		path     *tftypes.AttributePath
+		path     path.Path
 		summary  string
 		detail   string
 		expected diag.Diagnostics
 	}{
 		"nil-add": {
 			diags:   nil,
-			path:    tftypes.NewAttributePath().WithAttributeName("test"),
+			path:    path.Root("test"),
 			summary: "one summary",
 			detail:  "one detail",
 			expected: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
 			},
 		},
 		"add": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
 			},
-			path:    tftypes.NewAttributePath().WithAttributeName("test"),
+			path:    path.Root("test"),
 			summary: "three summary",
 			detail:  "three detail",
 			expected: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "three summary", "three detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "three summary", "three detail"),
 			},
 		},
 		"duplicate": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
 			},
-			path:    tftypes.NewAttributePath().WithAttributeName("test"),
+			path:    path.Root("test"),
 			summary: "one summary",
 			detail:  "one detail",
 			expected: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
 			},
 		},
 	}
@@ -76,45 +77,45 @@ func TestDiagnosticsAddAttributeWarning(t *testing.T) {
 
 	testCases := map[string]struct {
 		diags    diag.Diagnostics
-		path     *tftypes.AttributePath
+		path     path.Path
 		summary  string
 		detail   string
 		expected diag.Diagnostics
 	}{
 		"nil-add": {
 			diags:   nil,
-			path:    tftypes.NewAttributePath().WithAttributeName("test"),
+			path:    path.Root("test"),
 			summary: "one summary",
 			detail:  "one detail",
 			expected: diag.Diagnostics{
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "one summary", "one detail"),
 			},
 		},
 		"add": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
 			},
-			path:    tftypes.NewAttributePath().WithAttributeName("test"),
+			path:    path.Root("test"),
 			summary: "three summary",
 			detail:  "three detail",
 			expected: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "three summary", "three detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "three summary", "three detail"),
 			},
 		},
 		"duplicate": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
 			},
-			path:    tftypes.NewAttributePath().WithAttributeName("test"),
+			path:    path.Root("test"),
 			summary: "two summary",
 			detail:  "two detail",
 			expected: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("test"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
 			},
 		},
 	}
@@ -286,16 +287,16 @@ func TestDiagnosticsAppend(t *testing.T) {
 		},
 		"append-less-specific": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			},
 			in: diag.Diagnostics{
 				diag.NewErrorDiagnostic("one summary", "one detail"),
 				diag.NewWarningDiagnostic("two summary", "two detail"),
 			},
 			expected: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 				diag.NewErrorDiagnostic("one summary", "one detail"),
 				diag.NewWarningDiagnostic("two summary", "two detail"),
 			},
@@ -306,14 +307,14 @@ func TestDiagnosticsAppend(t *testing.T) {
 				diag.NewWarningDiagnostic("two summary", "two detail"),
 			},
 			in: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			},
 			expected: diag.Diagnostics{
 				diag.NewErrorDiagnostic("one summary", "one detail"),
 				diag.NewWarningDiagnostic("two summary", "two detail"),
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			},
 		},
 		"empty-diagnostics": {
@@ -389,10 +390,10 @@ func TestDiagnosticsContains(t *testing.T) {
 		},
 		"matching-attribute-path": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			},
-			in:       diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+			in:       diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			expected: true,
 		},
 		"nil-diagnostics": {
@@ -410,10 +411,10 @@ func TestDiagnosticsContains(t *testing.T) {
 		},
 		"different-attribute-path": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			},
-			in:       diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("different"), "two summary", "two detail"),
+			in:       diag.NewAttributeWarningDiagnostic(path.Root("different"), "two summary", "two detail"),
 			expected: false,
 		},
 		"different-detail": {
@@ -442,8 +443,8 @@ func TestDiagnosticsContains(t *testing.T) {
 		},
 		"different-type-less-specific": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			},
 			in:       diag.NewWarningDiagnostic("two summary", "two detail"),
 			expected: false,
@@ -453,7 +454,7 @@ func TestDiagnosticsContains(t *testing.T) {
 				diag.NewErrorDiagnostic("one summary", "one detail"),
 				diag.NewWarningDiagnostic("two summary", "two detail"),
 			},
-			in:       diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+			in:       diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			expected: false,
 		},
 	}
@@ -488,8 +489,8 @@ func TestDiagnosticsHasError(t *testing.T) {
 		},
 		"matching-attribute-path": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("error"), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("warning"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Root("error"), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("warning"), "two summary", "two detail"),
 			},
 			expected: true,
 		},
@@ -563,8 +564,8 @@ func TestDiagnosticsToTfprotov6Diagnostics(t *testing.T) {
 		},
 		"DiagnosticWithPath": {
 			diags: diag.Diagnostics{
-				diag.NewAttributeErrorDiagnostic(tftypes.NewAttributePath(), "one summary", "one detail"),
-				diag.NewAttributeWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"), "two summary", "two detail"),
+				diag.NewAttributeErrorDiagnostic(path.Empty(), "one summary", "one detail"),
+				diag.NewAttributeWarningDiagnostic(path.Root("test"), "two summary", "two detail"),
 			},
 			expected: []*tfprotov6.Diagnostic{
 				{
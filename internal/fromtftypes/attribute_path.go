@@ -0,0 +1,31 @@
+package fromtftypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributePath returns the path.Path equivalent of a
+// *tftypes.AttributePath. A nil path is returned as an empty path.
+func AttributePath(tfType *tftypes.AttributePath) path.Path {
+	result := path.Empty()
+
+	if tfType == nil {
+		return result
+	}
+
+	for _, step := range tfType.Steps() {
+		switch step := step.(type) {
+		case tftypes.AttributeName:
+			result = result.AtName(string(step))
+		case tftypes.ElementKeyInt:
+			result = result.AtListIndex(int(step))
+		case tftypes.ElementKeyString:
+			result = result.AtMapKey(string(step))
+		case tftypes.ElementKeyValue:
+			result = result.AtSetValue(tftypes.Value(step))
+		}
+	}
+
+	return result
+}
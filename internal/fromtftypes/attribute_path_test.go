@@ -0,0 +1,50 @@
+package fromtftypes_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		tfType   *tftypes.AttributePath
+		expected path.Path
+	}{
+		"nil": {
+			tfType:   nil,
+			expected: path.Empty(),
+		},
+		"empty": {
+			tfType:   tftypes.NewAttributePath(),
+			expected: path.Empty(),
+		},
+		"all-steps": {
+			tfType: tftypes.NewAttributePath().
+				WithAttributeName("test").
+				WithElementKeyInt(1).
+				WithElementKeyString("key").
+				WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: path.Root("test").AtListIndex(1).AtMapKey("key").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := fromtftypes.AttributePath(tc.tfType)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
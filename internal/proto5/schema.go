@@ -0,0 +1,84 @@
+package proto5
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Schema returns the protocol version 5 equivalent of a protocol version 6
+// schema, which are identical other than nested attributes. It returns an
+// error for the first attribute which uses nested attributes.
+func Schema(in *tfprotov6.Schema) (*tfprotov5.Schema, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block, err := schemaBlock(in.Block, tftypes.NewAttributePath())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &tfprotov5.Schema{
+		Version: in.Version,
+		Block:   block,
+	}, nil
+}
+
+func schemaBlock(in *tfprotov6.SchemaBlock, path *tftypes.AttributePath) (*tfprotov5.SchemaBlock, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	block := &tfprotov5.SchemaBlock{
+		Version:         in.Version,
+		Description:     in.Description,
+		DescriptionKind: tfprotov5.StringKind(in.DescriptionKind),
+		Deprecated:      in.Deprecated,
+	}
+
+	for _, attr := range in.Attributes {
+		if attr == nil {
+			continue
+		}
+
+		if attr.NestedType != nil {
+			return nil, path.WithAttributeName(attr.Name).NewErrorf("attribute uses nested attributes")
+		}
+
+		block.Attributes = append(block.Attributes, &tfprotov5.SchemaAttribute{
+			Name:            attr.Name,
+			Type:            attr.Type,
+			Description:     attr.Description,
+			Required:        attr.Required,
+			Optional:        attr.Optional,
+			Computed:        attr.Computed,
+			Sensitive:       attr.Sensitive,
+			DescriptionKind: tfprotov5.StringKind(attr.DescriptionKind),
+			Deprecated:      attr.Deprecated,
+		})
+	}
+
+	for _, nestedBlock := range in.BlockTypes {
+		if nestedBlock == nil {
+			continue
+		}
+
+		nested, err := schemaBlock(nestedBlock.Block, path.WithAttributeName(nestedBlock.TypeName))
+
+		if err != nil {
+			return nil, err
+		}
+
+		block.BlockTypes = append(block.BlockTypes, &tfprotov5.SchemaNestedBlock{
+			TypeName: nestedBlock.TypeName,
+			Block:    nested,
+			Nesting:  tfprotov5.SchemaNestedBlockNestingMode(nestedBlock.Nesting),
+			MinItems: nestedBlock.MinItems,
+			MaxItems: nestedBlock.MaxItems,
+		})
+	}
+
+	return block, nil
+}
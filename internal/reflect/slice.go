@@ -7,12 +7,13 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // build a slice of elements, matching the type of `target`, and fill it with
 // the data in `val`.
-func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path *tftypes.AttributePath) (reflect.Value, diag.Diagnostics) {
+func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target reflect.Value, opts Options, path path.Path) (reflect.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// this only works with slices, so check that out first
@@ -62,10 +63,10 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 		targetValue := reflect.Zero(elemType)
 
 		// update our path so we can have nice errors
-		valPath := path.WithElementKeyInt(pos)
+		valPath := path.AtListIndex(pos)
 
 		if typ.TerraformType(ctx).Is(tftypes.Set{}) {
-			valPath = path.WithElementKeyValue(value)
+			valPath = path.AtSetValue(value)
 		}
 
 		// reflect the value into our new target
@@ -91,7 +92,7 @@ func reflectSlice(ctx context.Context, typ attr.Type, val tftypes.Value, target
 // `typ` to construct values for them.
 //
 // It is meant to be called through FromValue, not directly.
-func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	// TODO: support tuples, which are attr.TypeWithElementTypes
@@ -141,7 +142,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path *tfty
 		// the index until the value is retrieved, this will pass the
 		// technically incorrect index-based path at first for framework
 		// debugging purposes, then correct the path afterwards.
-		valPath := path.WithElementKeyInt(i)
+		valPath := path.AtListIndex(i)
 
 		val, valDiags := FromValue(ctx, elemType, val.Index(i).Interface(), valPath)
 		diags.Append(valDiags...)
@@ -156,7 +157,7 @@ func FromSlice(ctx context.Context, typ attr.Type, val reflect.Value, path *tfty
 		}
 
 		if tfType.Is(tftypes.Set{}) {
-			valPath = path.WithElementKeyValue(tfVal)
+			valPath = path.AtSetValue(tfVal)
 		}
 
 		if typeWithValidate, ok := elemType.(attr.TypeWithValidate); ok {
@@ -8,12 +8,12 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	refl "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-
-	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -22,14 +22,14 @@ func TestNewStruct_notAnObject(t *testing.T) {
 
 	var s struct{}
 	expectedDiags := diag.Diagnostics{
-		diag.WithPath(tftypes.NewAttributePath(), refl.DiagIntoIncompatibleType{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
 			Val:        tftypes.NewValue(tftypes.String, "hello"),
 			TargetType: reflect.TypeOf(s),
 			Err:        fmt.Errorf("cannot reflect %s into a struct, must be an object", tftypes.String),
 		}),
 	}
 
-	_, diags := refl.Struct(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(s), refl.Options{}, tftypes.NewAttributePath())
+	_, diags := refl.Struct(context.Background(), types.StringType, tftypes.NewValue(tftypes.String, "hello"), reflect.ValueOf(s), refl.Options{}, path.Empty())
 
 	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
 		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -49,7 +49,7 @@ func TestNewStruct_notAStruct(t *testing.T) {
 
 	var s string
 	expectedDiags := diag.Diagnostics{
-		diag.WithPath(tftypes.NewAttributePath(), refl.DiagIntoIncompatibleType{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
 			TargetType: reflect.TypeOf(s),
 			Val:        val,
 			Err:        fmt.Errorf("expected a struct type, got string"),
@@ -60,7 +60,7 @@ func TestNewStruct_notAStruct(t *testing.T) {
 		AttrTypes: map[string]attr.Type{
 			"a": types.StringType,
 		},
-	}, val, reflect.ValueOf(s), refl.Options{}, tftypes.NewAttributePath())
+	}, val, reflect.ValueOf(s), refl.Options{}, path.Empty())
 
 	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
 		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -78,14 +78,14 @@ func TestNewStruct_objectMissingFields(t *testing.T) {
 		A string `tfsdk:"a"`
 	}
 	expectedDiags := diag.Diagnostics{
-		diag.WithPath(tftypes.NewAttributePath(), refl.DiagIntoIncompatibleType{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
 			Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: a."),
 			Val:        val,
 			TargetType: reflect.TypeOf(s),
 		}),
 	}
 
-	_, diags := refl.Struct(context.Background(), types.ObjectType{}, val, reflect.ValueOf(s), refl.Options{}, tftypes.NewAttributePath())
+	_, diags := refl.Struct(context.Background(), types.ObjectType{}, val, reflect.ValueOf(s), refl.Options{}, path.Empty())
 
 	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
 		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -105,7 +105,7 @@ func TestNewStruct_structMissingProperties(t *testing.T) {
 
 	var s struct{}
 	expectedDiags := diag.Diagnostics{
-		diag.WithPath(tftypes.NewAttributePath(), refl.DiagIntoIncompatibleType{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
 			Err:        errors.New("mismatch between struct and object: Object defines fields not found in struct: a."),
 			Val:        val,
 			TargetType: reflect.TypeOf(s),
@@ -116,7 +116,7 @@ func TestNewStruct_structMissingProperties(t *testing.T) {
 		AttrTypes: map[string]attr.Type{
 			"a": types.StringType,
 		},
-	}, val, reflect.ValueOf(s), refl.Options{}, tftypes.NewAttributePath())
+	}, val, reflect.ValueOf(s), refl.Options{}, path.Empty())
 
 	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
 		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -138,7 +138,7 @@ func TestNewStruct_objectMissingFieldsAndStructMissingProperties(t *testing.T) {
 		A string `tfsdk:"a"`
 	}
 	expectedDiags := diag.Diagnostics{
-		diag.WithPath(tftypes.NewAttributePath(), refl.DiagIntoIncompatibleType{
+		diag.WithPath(path.Empty(), refl.DiagIntoIncompatibleType{
 			TargetType: reflect.TypeOf(s),
 			Val:        val,
 			Err:        errors.New("mismatch between struct and object: Struct defines fields not found in object: a. Object defines fields not found in struct: b."),
@@ -149,7 +149,7 @@ func TestNewStruct_objectMissingFieldsAndStructMissingProperties(t *testing.T) {
 		AttrTypes: map[string]attr.Type{
 			"a": types.StringType,
 		},
-	}, val, reflect.ValueOf(s), refl.Options{}, tftypes.NewAttributePath())
+	}, val, reflect.ValueOf(s), refl.Options{}, path.Empty())
 
 	if diff := cmp.Diff(diags, expectedDiags); diff != "" {
 		t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -180,7 +180,7 @@ func TestNewStruct_primitives(t *testing.T) {
 		"a": tftypes.NewValue(tftypes.String, "hello"),
 		"b": tftypes.NewValue(tftypes.Number, 123),
 		"c": tftypes.NewValue(tftypes.Bool, true),
-	}), reflect.ValueOf(s), refl.Options{}, tftypes.NewAttributePath())
+	}), reflect.ValueOf(s), refl.Options{}, path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -429,7 +429,7 @@ func TestNewStruct_complex(t *testing.T) {
 	}), reflect.ValueOf(s), refl.Options{
 		UnhandledNullAsEmpty:    true,
 		UnhandledUnknownAsEmpty: true,
-	}, tftypes.NewAttributePath())
+	}, path.Empty())
 	reflect.ValueOf(&s).Elem().Set(result)
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
@@ -514,7 +514,7 @@ func TestFromStruct_primitives(t *testing.T) {
 			"age":      types.NumberType,
 			"opted_in": types.BoolType,
 		},
-	}, reflect.ValueOf(disk1), tftypes.NewAttributePath())
+	}, reflect.ValueOf(disk1), path.Empty())
 	if diags.HasError() {
 		t.Fatalf("Unexpected error: %v", diags)
 	}
@@ -669,7 +669,7 @@ func TestFromStruct_complex(t *testing.T) {
 			"big_int":         types.NumberType,
 			"uint":            types.NumberType,
 		},
-	}, reflect.ValueOf(s), tftypes.NewAttributePath())
+	}, reflect.ValueOf(s), path.Empty())
 	if diags.HasError() {
 		t.Errorf("Unexpected error: %v", diags)
 	}
@@ -851,3 +851,98 @@ func TestFromStruct_complex(t *testing.T) {
 		t.Errorf("Didn't get expected value. Diff (+ is expected, - is result): %s", diff)
 	}
 }
+
+func TestNewStruct_nestedPointer(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		Name string `tfsdk:"name"`
+	}
+
+	type parent struct {
+		Nested *nested `tfsdk:"nested"`
+	}
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	objectType := types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"nested": types.ObjectType{
+				AttrTypes: map[string]attr.Type{
+					"name": types.StringType,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		val      tftypes.Value
+		expected parent
+	}{
+		"null": {
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": nestedType,
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(nestedType, nil),
+			}),
+			expected: parent{},
+		},
+		"known": {
+			val: tftypes.NewValue(tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"nested": nestedType,
+				},
+			}, map[string]tftypes.Value{
+				"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				}),
+			}),
+			expected: parent{
+				Nested: &nested{
+					Name: "test",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got parent
+
+			diags := refl.Into(context.Background(), objectType, tc.val, &got, refl.Options{}, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("Unexpected diff (+wanted, -got): %s", diff)
+			}
+
+			roundTrip, diags := refl.FromValue(context.Background(), objectType, got, path.Empty())
+
+			if diags.HasError() {
+				t.Fatalf("Unexpected error: %v", diags)
+			}
+
+			roundTripVal, err := roundTrip.ToTerraformValue(context.Background())
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if !roundTripVal.Equal(tc.val) {
+				t.Errorf("Expected round trip value %s, got %s", tc.val, roundTripVal)
+			}
+		})
+	}
+}
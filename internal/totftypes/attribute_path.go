@@ -0,0 +1,42 @@
+package totftypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributePath returns the *tftypes.AttributePath equivalent of a path.Path.
+func AttributePath(fw path.Path) *tftypes.AttributePath {
+	result := tftypes.NewAttributePath()
+
+	for _, step := range fw.Steps() {
+		switch step := step.(type) {
+		case path.PathStepAttributeName:
+			result = result.WithAttributeName(string(step))
+		case path.PathStepElementKeyInt:
+			result = result.WithElementKeyInt(int(step))
+		case path.PathStepElementKeyString:
+			result = result.WithElementKeyString(string(step))
+		case path.PathStepElementKeyValue:
+			result = result.WithElementKeyValue(step.Value)
+		}
+	}
+
+	return result
+}
+
+// AttributePaths returns the []*tftypes.AttributePath equivalent of a
+// path.Paths.
+func AttributePaths(fw path.Paths) []*tftypes.AttributePath {
+	if fw == nil {
+		return nil
+	}
+
+	result := make([]*tftypes.AttributePath, 0, len(fw))
+
+	for _, p := range fw {
+		result = append(result, AttributePath(p))
+	}
+
+	return result
+}
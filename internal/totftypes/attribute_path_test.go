@@ -0,0 +1,46 @@
+package totftypes_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributePath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fw       path.Path
+		expected *tftypes.AttributePath
+	}{
+		"empty": {
+			fw:       path.Empty(),
+			expected: tftypes.NewAttributePath(),
+		},
+		"all-steps": {
+			fw: path.Root("test").AtListIndex(1).AtMapKey("key").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: tftypes.NewAttributePath().
+				WithAttributeName("test").
+				WithElementKeyInt(1).
+				WithElementKeyString("key").
+				WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := totftypes.AttributePath(tc.fw)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
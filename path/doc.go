@@ -0,0 +1,17 @@
+// Package path implements attribute path functionality, which defines
+// transversals into schema-based data, such as configuration, plan, and state.
+//
+// Paths are constructed starting with Root and then appending steps, such as:
+//
+//	path.Root("disk").AtListIndex(0).AtName("size")
+//
+// Paths supplied as strings, such as in import identifiers, can be converted
+// with Parse:
+//
+//	path.Parse("disk[0].size")
+//
+// Expressions are constructed starting with MatchRoot or MatchRelative and
+// can match zero, one, or more paths, such as every element of a list:
+//
+//	path.MatchRoot("disk").AtAnyListIndex().AtName("size")
+package path
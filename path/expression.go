@@ -0,0 +1,209 @@
+package path
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Expression represents an attribute path with expression steps, which can
+// represent zero, one, or more actual Paths. For example, AtAnyListIndex
+// matches every element of a list.
+//
+// Use the MatchRoot function to create an Expression starting from the root
+// of the schema, or the MatchRelative function to create an Expression which
+// is merged with another Expression or Path, such as the path of the
+// attribute being validated. Expression functionality follows a builder
+// pattern, which allows for chaining method calls to construct a full
+// expression. The available traversal steps after Expression creation are:
+//
+//   - AtAnyListIndex(): Step into a list at any index
+//   - AtAnyMapKey(): Step into a map at any key
+//   - AtAnySetValue(): Step into a set at any value
+//   - AtListIndex(): Step into a list at a specific 0-based index
+//   - AtMapKey(): Step into a map at a specific key
+//   - AtName(): Step into an attribute or block with a specific name
+//   - AtParent(): Step backwards to the parent of the previous step
+//   - AtSetValue(): Step into a set at a specific value
+//
+// For example, to express the "size" attribute of every element of a root
+// list nested attribute named "disks":
+//
+//	path.MatchRoot("disks").AtAnyListIndex().AtName("size")
+//
+// Or to express a sibling attribute named "size", relative to an attribute:
+//
+//	path.MatchRelative().AtParent().AtName("size")
+//
+// Expression is immutable, each traversal step creates a new Expression.
+type Expression struct {
+	// root is true when the expression starts from the root of the schema,
+	// rather than being relative to another expression.
+	root bool
+
+	// steps is the transversals included with the expression. In general,
+	// operations against the expression should protect against modification
+	// of the original.
+	steps ExpressionSteps
+}
+
+// MatchRelative creates an empty Expression which is intended to be merged
+// with another Expression or Path, such as the path of the attribute being
+// validated, using Merge.
+func MatchRelative() Expression {
+	return Expression{
+		steps: ExpressionSteps{},
+	}
+}
+
+// MatchRoot creates an Expression starting from the root of the schema with
+// an initial ExpressionStepAttributeNameExact.
+func MatchRoot(rootAttributeName string) Expression {
+	return Expression{
+		root: true,
+		steps: ExpressionSteps{
+			ExpressionStepAttributeNameExact(rootAttributeName),
+		},
+	}
+}
+
+// AtAnyListIndex returns a copied expression with a new list index step at
+// the end, which matches any index. The returned expression is safe to modify
+// without affecting the original.
+func (e Expression) AtAnyListIndex() Expression {
+	return e.withStep(ExpressionStepElementKeyIntAny{})
+}
+
+// AtAnyMapKey returns a copied expression with a new map key step at the
+// end, which matches any key. The returned expression is safe to modify
+// without affecting the original.
+func (e Expression) AtAnyMapKey() Expression {
+	return e.withStep(ExpressionStepElementKeyStringAny{})
+}
+
+// AtAnySetValue returns a copied expression with a new set value step at the
+// end, which matches any value. The returned expression is safe to modify
+// without affecting the original.
+func (e Expression) AtAnySetValue() Expression {
+	return e.withStep(ExpressionStepElementKeyValueAny{})
+}
+
+// AtListIndex returns a copied expression with a new list index step at the
+// end. The returned expression is safe to modify without affecting the
+// original.
+//
+// List indices are 0-based. The first element of a list is 0.
+func (e Expression) AtListIndex(index int) Expression {
+	return e.withStep(ExpressionStepElementKeyIntExact(index))
+}
+
+// AtMapKey returns a copied expression with a new map key step at the end.
+// The returned expression is safe to modify without affecting the original.
+func (e Expression) AtMapKey(key string) Expression {
+	return e.withStep(ExpressionStepElementKeyStringExact(key))
+}
+
+// AtName returns a copied expression with a new attribute or block name step
+// at the end. The returned expression is safe to modify without affecting
+// the original.
+func (e Expression) AtName(name string) Expression {
+	return e.withStep(ExpressionStepAttributeNameExact(name))
+}
+
+// AtParent returns a copied expression with a new parent step at the end.
+// The returned expression is safe to modify without affecting the original.
+//
+// Parent steps are removed, along with the step before them, by Resolve.
+func (e Expression) AtParent() Expression {
+	return e.withStep(ExpressionStepParent{})
+}
+
+// AtSetValue returns a copied expression with a new set value step at the
+// end. The returned expression is safe to modify without affecting the
+// original.
+func (e Expression) AtSetValue(value tftypes.Value) Expression {
+	return e.withStep(ExpressionStepElementKeyValueExact{Value: value})
+}
+
+// Copy returns a duplicate of the expression that is safe to modify without
+// affecting the original.
+func (e Expression) Copy() Expression {
+	return Expression{
+		root:  e.root,
+		steps: e.Steps(),
+	}
+}
+
+// Equal returns true if the given expression is exactly equivalent.
+func (e Expression) Equal(o Expression) bool {
+	return e.root == o.root && e.steps.Equal(o.steps)
+}
+
+// IsRelative returns true if the expression was created with MatchRelative,
+// rather than starting from the root of the schema.
+func (e Expression) IsRelative() bool {
+	return !e.root
+}
+
+// Matches returns true if the given Path is matched by the Expression.
+//
+// Any parent steps are resolved before matching.
+func (e Expression) Matches(path Path) bool {
+	return e.steps.Matches(path.steps)
+}
+
+// MatchesParent returns true if the given Path is a parent of paths which can
+// be matched by the Expression, or is matched by the Expression itself.
+//
+// Any parent steps are resolved before matching.
+func (e Expression) MatchesParent(path Path) bool {
+	return e.steps.MatchesParent(path.steps)
+}
+
+// Merge returns a copied expression with the steps of the given relative
+// expression appended. If the given expression is not relative, a copy of it
+// is returned instead, since it already starts from the root of the schema.
+func (e Expression) Merge(other Expression) Expression {
+	if other.root {
+		return other.Copy()
+	}
+
+	steps := make(ExpressionSteps, 0, len(e.steps)+len(other.steps))
+	steps = append(steps, e.steps...)
+
+	return Expression{
+		root:  e.root,
+		steps: append(steps, other.steps...),
+	}
+}
+
+// Resolve returns a copied expression with any parent steps removed, along
+// with the step before each of them.
+func (e Expression) Resolve() Expression {
+	return Expression{
+		root:  e.root,
+		steps: e.steps.Resolve(),
+	}
+}
+
+// Steps returns a copy of the underlying expression steps.
+func (e Expression) Steps() ExpressionSteps {
+	return e.steps.Copy()
+}
+
+// String returns the human-readable representation of the expression.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (e Expression) String() string {
+	return e.steps.String()
+}
+
+// withStep returns a copied expression with the step appended, so the
+// underlying steps of the original expression are never shared.
+func (e Expression) withStep(step ExpressionStep) Expression {
+	steps := make(ExpressionSteps, 0, len(e.steps)+1)
+	steps = append(steps, e.steps...)
+
+	return Expression{
+		root:  e.root,
+		steps: append(steps, step),
+	}
+}
@@ -0,0 +1,46 @@
+package path
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ExpressionStepElementKeyValueExact is an attribute path expression for an
+// exact value match within a set.
+type ExpressionStepElementKeyValueExact struct {
+	// Value is the entire set element value.
+	Value tftypes.Value
+}
+
+// Equal returns true if the given ExpressionStep is a
+// ExpressionStepElementKeyValueExact and the value is equivalent.
+func (s ExpressionStepElementKeyValueExact) Equal(o ExpressionStep) bool {
+	other, ok := o.(ExpressionStepElementKeyValueExact)
+
+	if !ok {
+		return false
+	}
+
+	return s.Value.Equal(other.Value)
+}
+
+// Matches returns true if the given PathStep is fulfilled by the
+// ExpressionStepElementKeyValueExact condition.
+func (s ExpressionStepElementKeyValueExact) Matches(pathStep PathStep) bool {
+	pathStepElementKeyValue, ok := pathStep.(PathStepElementKeyValue)
+
+	if !ok {
+		return false
+	}
+
+	return s.Value.Equal(pathStepElementKeyValue.Value)
+}
+
+// String returns the human-readable representation of the element key
+// expression. It is intended for logging and error messages and is not
+// protected by compatibility guarantees.
+func (s ExpressionStepElementKeyValueExact) String() string {
+	return "[Value(" + s.Value.String() + ")]"
+}
+
+// unexported satisfies the ExpressionStep interface.
+func (s ExpressionStepElementKeyValueExact) unexported() {}
@@ -0,0 +1,30 @@
+package path
+
+// ExpressionStepParent is an attribute path expression for a traversal to
+// the parent of the previous step, such as from a nested attribute to the
+// object containing it. It is removed by ExpressionSteps.Resolve and never
+// matches a PathStep itself.
+type ExpressionStepParent struct{}
+
+// Equal returns true if the given ExpressionStep is a ExpressionStepParent.
+func (s ExpressionStepParent) Equal(o ExpressionStep) bool {
+	_, ok := o.(ExpressionStepParent)
+
+	return ok
+}
+
+// Matches always returns false, since parent traversals must be resolved
+// before matching.
+func (s ExpressionStepParent) Matches(_ PathStep) bool {
+	return false
+}
+
+// String returns the human-readable representation of the parent traversal.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (s ExpressionStepParent) String() string {
+	return "<"
+}
+
+// unexported satisfies the ExpressionStep interface.
+func (s ExpressionStepParent) unexported() {}
@@ -0,0 +1,157 @@
+package path
+
+import (
+	"strings"
+)
+
+// ExpressionSteps represents an ordered collection of attribute path
+// expressions.
+type ExpressionSteps []ExpressionStep
+
+// Append adds the given ExpressionSteps to the end of the previous
+// ExpressionSteps and returns the combined result.
+func (s *ExpressionSteps) Append(steps ...ExpressionStep) ExpressionSteps {
+	if s == nil {
+		return steps
+	}
+
+	*s = append(*s, steps...)
+
+	return *s
+}
+
+// Copy returns a duplicate of the steps that is safe to modify without
+// affecting the original. Returns nil if the original steps is nil.
+func (s ExpressionSteps) Copy() ExpressionSteps {
+	if s == nil {
+		return nil
+	}
+
+	copiedExpressionSteps := make(ExpressionSteps, len(s))
+
+	copy(copiedExpressionSteps, s)
+
+	return copiedExpressionSteps
+}
+
+// Equal returns true if the given ExpressionSteps are equivalent.
+func (s ExpressionSteps) Equal(o ExpressionSteps) bool {
+	if len(s) != len(o) {
+		return false
+	}
+
+	for stepIndex, step := range s {
+		if !step.Equal(o[stepIndex]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LastStep returns the final ExpressionStep and the remaining
+// ExpressionSteps.
+func (s ExpressionSteps) LastStep() (ExpressionStep, ExpressionSteps) {
+	if len(s) == 0 {
+		return nil, ExpressionSteps{}
+	}
+
+	if len(s) == 1 {
+		return s[0], ExpressionSteps{}
+	}
+
+	return s[len(s)-1], s[:len(s)-1]
+}
+
+// Matches returns true if the given PathSteps match each ExpressionStep.
+//
+// Any ExpressionStepParent will be resolved before matching.
+func (s ExpressionSteps) Matches(pathSteps PathSteps) bool {
+	resolvedExpressionSteps := s.Resolve()
+
+	if len(resolvedExpressionSteps) != len(pathSteps) {
+		return false
+	}
+
+	return resolvedExpressionSteps.matchesPrefix(pathSteps)
+}
+
+// MatchesParent returns true if the given PathSteps match each
+// ExpressionStep until there are no more PathSteps. This is useful for
+// determining if the PathSteps are the parent of values which may match the
+// expression, such as when walking data to find matching paths.
+//
+// Any ExpressionStepParent will be resolved before matching.
+func (s ExpressionSteps) MatchesParent(pathSteps PathSteps) bool {
+	resolvedExpressionSteps := s.Resolve()
+
+	if len(pathSteps) > len(resolvedExpressionSteps) {
+		return false
+	}
+
+	return resolvedExpressionSteps.matchesPrefix(pathSteps)
+}
+
+// NextStep returns the first ExpressionStep and the remaining
+// ExpressionSteps.
+func (s ExpressionSteps) NextStep() (ExpressionStep, ExpressionSteps) {
+	if len(s) == 0 {
+		return nil, s
+	}
+
+	return s[0], s[1:]
+}
+
+// Resolve returns a copy of ExpressionSteps without any
+// ExpressionStepParent. Each ExpressionStepParent removes the step before
+// it, if any.
+func (s ExpressionSteps) Resolve() ExpressionSteps {
+	result := make(ExpressionSteps, 0, len(s))
+
+	for _, step := range s {
+		if _, ok := step.(ExpressionStepParent); ok {
+			if len(result) > 0 {
+				result = result[:len(result)-1]
+			}
+
+			continue
+		}
+
+		result = append(result, step)
+	}
+
+	return result
+}
+
+// String returns the human-readable representation of the ExpressionSteps.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (s ExpressionSteps) String() string {
+	var result strings.Builder
+
+	for stepIndex, step := range s {
+		if stepIndex != 0 {
+			switch step.(type) {
+			case ExpressionStepAttributeNameExact, ExpressionStepParent:
+				result.WriteString(".")
+			}
+		}
+
+		result.WriteString(step.String())
+	}
+
+	return result.String()
+}
+
+// matchesPrefix returns true if each of the PathSteps matches the
+// ExpressionStep at the same position. The ExpressionSteps must already be
+// resolved and contain at least as many steps as the PathSteps.
+func (s ExpressionSteps) matchesPrefix(pathSteps PathSteps) bool {
+	for stepIndex, pathStep := range pathSteps {
+		if !s[stepIndex].Matches(pathStep) {
+			return false
+		}
+	}
+
+	return true
+}
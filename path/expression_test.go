@@ -0,0 +1,448 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExpressionAtStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		expected   path.ExpressionSteps
+	}{
+		"relative": {
+			expression: path.MatchRelative(),
+			expected:   path.ExpressionSteps{},
+		},
+		"root": {
+			expression: path.MatchRoot("test"),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+			},
+		},
+		"AtAnyListIndex": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepElementKeyIntAny{},
+			},
+		},
+		"AtAnyMapKey": {
+			expression: path.MatchRoot("test").AtAnyMapKey(),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepElementKeyStringAny{},
+			},
+		},
+		"AtAnySetValue": {
+			expression: path.MatchRoot("test").AtAnySetValue(),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepElementKeyValueAny{},
+			},
+		},
+		"AtListIndex": {
+			expression: path.MatchRoot("test").AtListIndex(1),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepElementKeyIntExact(1),
+			},
+		},
+		"AtMapKey": {
+			expression: path.MatchRoot("test").AtMapKey("key"),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepElementKeyStringExact("key"),
+			},
+		},
+		"AtName": {
+			expression: path.MatchRelative().AtParent().AtName("other"),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepParent{},
+				path.ExpressionStepAttributeNameExact("other"),
+			},
+		},
+		"AtSetValue": {
+			expression: path.MatchRoot("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: path.ExpressionSteps{
+				path.ExpressionStepAttributeNameExact("test"),
+				path.ExpressionStepElementKeyValueExact{Value: tftypes.NewValue(tftypes.String, "value")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.Steps()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpressionAtStepImmutable(t *testing.T) {
+	t.Parallel()
+
+	parent := path.MatchRoot("test").AtAnyListIndex()
+	first := parent.AtName("first")
+	second := parent.AtName("second")
+
+	if got, expected := first.String(), "test[*].first"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if got, expected := second.String(), "test[*].second"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+
+	if got, expected := parent.String(), "test[*]"; got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestExpressionEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		other      path.Expression
+		expected   bool
+	}{
+		"relative-relative": {
+			expression: path.MatchRelative(),
+			other:      path.MatchRelative(),
+			expected:   true,
+		},
+		"root-root": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			other:      path.MatchRoot("test").AtAnyListIndex(),
+			expected:   true,
+		},
+		"root-relative": {
+			expression: path.MatchRoot("test"),
+			other:      path.MatchRelative().AtName("test"),
+			expected:   false,
+		},
+		"different-step": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			other:      path.MatchRoot("test").AtListIndex(0),
+			expected:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		path       path.Path
+		expected   bool
+	}{
+		"root-exact": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("test"),
+			expected:   true,
+		},
+		"root-different": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("other"),
+			expected:   false,
+		},
+		"shorter-path": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			path:       path.Root("test"),
+			expected:   false,
+		},
+		"longer-path": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   false,
+		},
+		"any-list-index": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+			path:       path.Root("test").AtListIndex(3).AtName("nested"),
+			expected:   true,
+		},
+		"any-list-index-map-key": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			path:       path.Root("test").AtMapKey("key"),
+			expected:   false,
+		},
+		"any-map-key": {
+			expression: path.MatchRoot("test").AtAnyMapKey(),
+			path:       path.Root("test").AtMapKey("key"),
+			expected:   true,
+		},
+		"any-set-value": {
+			expression: path.MatchRoot("test").AtAnySetValue(),
+			path:       path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected:   true,
+		},
+		"exact-list-index": {
+			expression: path.MatchRoot("test").AtListIndex(1),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   false,
+		},
+		"exact-map-key": {
+			expression: path.MatchRoot("test").AtMapKey("key"),
+			path:       path.Root("test").AtMapKey("key"),
+			expected:   true,
+		},
+		"exact-set-value": {
+			expression: path.MatchRoot("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			path:       path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "other")),
+			expected:   false,
+		},
+		"parent": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("first").AtParent().AtName("second"),
+			path:       path.Root("test").AtListIndex(0).AtName("second"),
+			expected:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.Matches(tc.path)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionMatchesParent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		path       path.Path
+		expected   bool
+	}{
+		"empty-path": {
+			expression: path.MatchRoot("test"),
+			path:       path.Empty(),
+			expected:   true,
+		},
+		"parent": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   true,
+		},
+		"exact": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   true,
+		},
+		"different": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("nested"),
+			path:       path.Root("other").AtListIndex(0),
+			expected:   false,
+		},
+		"longer-path": {
+			expression: path.MatchRoot("test"),
+			path:       path.Root("test").AtListIndex(0),
+			expected:   false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.MatchesParent(tc.path)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionMerge(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		other      path.Expression
+		expected   path.Expression
+	}{
+		"relative": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("first"),
+			other:      path.MatchRelative().AtParent().AtName("second"),
+			expected:   path.MatchRoot("test").AtAnyListIndex().AtName("first").AtParent().AtName("second"),
+		},
+		"relative-empty": {
+			expression: path.MatchRoot("test"),
+			other:      path.MatchRelative(),
+			expected:   path.MatchRoot("test"),
+		},
+		"root": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			other:      path.MatchRoot("other"),
+			expected:   path.MatchRoot("other"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.Merge(tc.other)
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionResolve(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		expected   path.Expression
+	}{
+		"no-parent": {
+			expression: path.MatchRoot("test").AtAnyListIndex(),
+			expected:   path.MatchRoot("test").AtAnyListIndex(),
+		},
+		"parent": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("first").AtParent().AtName("second"),
+			expected:   path.MatchRoot("test").AtAnyListIndex().AtName("second"),
+		},
+		"multiple-parents": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("first").AtParent().AtParent().AtParent().AtName("other"),
+			expected:   path.MatchRoot("other"),
+		},
+		"parent-beyond-root": {
+			expression: path.MatchRoot("test").AtParent().AtParent().AtName("other"),
+			expected:   path.MatchRoot("other"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.Resolve()
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestExpressionString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression path.Expression
+		expected   string
+	}{
+		"relative": {
+			expression: path.MatchRelative(),
+			expected:   "",
+		},
+		"any": {
+			expression: path.MatchRoot("test").AtAnyListIndex().AtName("nested").AtAnyMapKey().AtAnySetValue(),
+			expected:   `test[*].nested["*"][Value(*)]`,
+		},
+		"exact": {
+			expression: path.MatchRoot("test").AtListIndex(0).AtMapKey("key").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected:   `test[0]["key"][Value(tftypes.String<"value">)]`,
+		},
+		"parent": {
+			expression: path.MatchRelative().AtParent().AtName("other"),
+			expected:   "<.other",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expression.String()
+
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPathExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected path.Expression
+	}{
+		"root": {
+			path:     path.Root("test"),
+			expected: path.MatchRoot("test"),
+		},
+		"nested": {
+			path:     path.Root("test").AtListIndex(0).AtMapKey("key").AtName("nested"),
+			expected: path.MatchRoot("test").AtListIndex(0).AtMapKey("key").AtName("nested"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.Expression()
+
+			if !got.Equal(tc.expected) {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+
+			if !got.Matches(tc.path) {
+				t.Errorf("expected expression %s to match path %s", got, tc.path)
+			}
+		})
+	}
+}
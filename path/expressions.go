@@ -0,0 +1,62 @@
+package path
+
+import (
+	"strings"
+)
+
+// Expressions is a collection of attribute path expressions.
+type Expressions []Expression
+
+// Append adds the given Expressions to the collection without duplication
+// and returns the combined result.
+func (e *Expressions) Append(expressions ...Expression) Expressions {
+	if e == nil {
+		return expressions
+	}
+
+	for _, newExpression := range expressions {
+		if e.Contains(newExpression) {
+			continue
+		}
+
+		*e = append(*e, newExpression)
+	}
+
+	return *e
+}
+
+// Contains returns true if the collection of expressions includes the given
+// expression.
+func (e Expressions) Contains(checkExpression Expression) bool {
+	for _, expression := range e {
+		if expression.Equal(checkExpression) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches returns true if any of the expressions matches the given Path.
+func (e Expressions) Matches(path Path) bool {
+	for _, expression := range e {
+		if expression.Matches(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// String returns the human-readable representation of the expression
+// collection. It is intended for logging and error messages and is not
+// protected by compatibility guarantees.
+func (e Expressions) String() string {
+	expressionStrings := make([]string, 0, len(e))
+
+	for _, expression := range e {
+		expressionStrings = append(expressionStrings, expression.String())
+	}
+
+	return "[" + strings.Join(expressionStrings, ",") + "]"
+}
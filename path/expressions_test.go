@@ -0,0 +1,58 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestExpressionsAppend(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expressions path.Expressions
+		add         []path.Expression
+		expected    path.Expressions
+	}{
+		"nil": {
+			add:      []path.Expression{path.MatchRoot("test")},
+			expected: path.Expressions{path.MatchRoot("test")},
+		},
+		"duplicate": {
+			expressions: path.Expressions{path.MatchRoot("first")},
+			add:         []path.Expression{path.MatchRoot("first"), path.MatchRoot("second").AtAnyListIndex()},
+			expected:    path.Expressions{path.MatchRoot("first"), path.MatchRoot("second").AtAnyListIndex()},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.expressions.Append(tc.add...)
+
+			if len(got) != len(tc.expected) {
+				t.Fatalf("expected %s, got %s", tc.expected, got)
+			}
+
+			for idx := range got {
+				if !got[idx].Equal(tc.expected[idx]) {
+					t.Errorf("expected %s, got %s", tc.expected, got)
+				}
+			}
+		})
+	}
+}
+
+func TestExpressionsString(t *testing.T) {
+	t.Parallel()
+
+	got := path.Expressions{path.MatchRoot("first"), path.MatchRoot("second").AtAnyListIndex()}.String()
+
+	if diff := cmp.Diff(got, "[first,second[*]]"); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
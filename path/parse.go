@@ -0,0 +1,143 @@
+package path
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Parse returns the Path represented by the given string, which uses the
+// same syntax as Path.String(), such as:
+//
+//	disks[0].size
+//	tags["Name"]
+//
+// Attribute names are separated by periods, list indices are integers in
+// square brackets, and map keys are double quoted strings in square brackets.
+// An empty string is the root of the schema. Set value steps cannot be
+// parsed, since their value requires type information.
+//
+// This is intended for strings supplied outside of the provider code, such
+// as import identifiers, practitioner-supplied lists of attributes, and test
+// fixtures. The returned error describes the position of any syntax error.
+func Parse(s string) (Path, error) {
+	result := Empty()
+	pos := 0
+
+	for pos < len(s) {
+		switch {
+		case s[pos] == '[' && pos > 0:
+			step, next, err := parseElementKey(s, pos)
+
+			if err != nil {
+				return Empty(), err
+			}
+
+			result = result.withStep(step)
+			pos = next
+
+			if pos < len(s) && s[pos] != '.' && s[pos] != '[' {
+				return Empty(), parseError(s, pos, `expected "." or "[" after element key`)
+			}
+		case s[pos] == '.' && pos > 0:
+			pos++
+
+			fallthrough
+		default:
+			name := parseAttributeName(s, pos)
+
+			if name == "" {
+				return Empty(), parseError(s, pos, "expected attribute name")
+			}
+
+			result = result.AtName(name)
+			pos += len(name)
+
+			if pos < len(s) && s[pos] != '.' && s[pos] != '[' {
+				return Empty(), parseError(s, pos, `expected "." or "[" after attribute name`)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// parseAttributeName returns the attribute name starting at pos, which is
+// empty if there is no valid attribute name.
+func parseAttributeName(s string, pos int) string {
+	end := pos
+
+	for end < len(s) && isAttributeNameByte(s[end]) {
+		end++
+	}
+
+	return s[pos:end]
+}
+
+// parseElementKey returns the list index or map key step starting with the
+// opening square bracket at pos, and the position after its closing square
+// bracket.
+func parseElementKey(s string, pos int) (PathStep, int, error) {
+	keyPos := pos + 1
+
+	if keyPos >= len(s) {
+		return nil, 0, parseError(s, keyPos, "expected list index or map key")
+	}
+
+	var step PathStep
+	var end int
+
+	switch {
+	case s[keyPos] == '"':
+		quoted, err := strconv.QuotedPrefix(s[keyPos:])
+
+		if err != nil {
+			return nil, 0, parseError(s, keyPos, "invalid map key string")
+		}
+
+		key, err := strconv.Unquote(quoted)
+
+		if err != nil {
+			return nil, 0, parseError(s, keyPos, "invalid map key string")
+		}
+
+		step = PathStepElementKeyString(key)
+		end = keyPos + len(quoted)
+	case s[keyPos] >= '0' && s[keyPos] <= '9':
+		end = keyPos
+
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+
+		index, err := strconv.Atoi(s[keyPos:end])
+
+		if err != nil {
+			return nil, 0, parseError(s, keyPos, "invalid list index")
+		}
+
+		step = PathStepElementKeyInt(index)
+	case strings.HasPrefix(s[keyPos:], "Value("):
+		return nil, 0, parseError(s, keyPos, "set value steps are not supported")
+	default:
+		return nil, 0, parseError(s, keyPos, "expected list index or map key")
+	}
+
+	if end >= len(s) || s[end] != ']' {
+		return nil, 0, parseError(s, end, `expected "]"`)
+	}
+
+	return step, end + 1, nil
+}
+
+// isAttributeNameByte returns true if the byte can be used in an attribute
+// name.
+func isAttributeNameByte(b byte) bool {
+	return b == '_' || b == '-' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+// parseError returns an error describing a syntax error at the 0-based pos,
+// which is reported as a 1-based column.
+func parseError(s string, pos int, problem string) error {
+	return fmt.Errorf("invalid path %q at column %d: %s", s, pos+1, problem)
+}
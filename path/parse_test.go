@@ -0,0 +1,121 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expected      path.Path
+		expectedError string
+	}{
+		"empty": {
+			input:    "",
+			expected: path.Empty(),
+		},
+		"AttributeName": {
+			input:    "test",
+			expected: path.Root("test"),
+		},
+		"AttributeName-AttributeName": {
+			input:    "test.nested_test-2",
+			expected: path.Root("test").AtName("nested_test-2"),
+		},
+		"AttributeName-ElementKeyInt-AttributeName": {
+			input:    "disks[0].size",
+			expected: path.Root("disks").AtListIndex(0).AtName("size"),
+		},
+		"AttributeName-ElementKeyInt-ElementKeyString": {
+			input:    `test[10]["key"]`,
+			expected: path.Root("test").AtListIndex(10).AtMapKey("key"),
+		},
+		"AttributeName-ElementKeyString-escaped": {
+			input:    `test["a \"quoted\" key.with[brackets]"]`,
+			expected: path.Root("test").AtMapKey(`a "quoted" key.with[brackets]`),
+		},
+		"leading-period": {
+			input:         ".test",
+			expectedError: `invalid path ".test" at column 1: expected attribute name`,
+		},
+		"leading-element-key": {
+			input:         "[0]",
+			expectedError: `invalid path "[0]" at column 1: expected attribute name`,
+		},
+		"trailing-period": {
+			input:         "test.",
+			expectedError: `invalid path "test." at column 6: expected attribute name`,
+		},
+		"double-period": {
+			input:         "test..nested",
+			expectedError: `invalid path "test..nested" at column 6: expected attribute name`,
+		},
+		"invalid-attribute-name": {
+			input:         "test name",
+			expectedError: `invalid path "test name" at column 5: expected "." or "[" after attribute name`,
+		},
+		"missing-period-after-element-key": {
+			input:         "test[0]nested",
+			expectedError: `invalid path "test[0]nested" at column 8: expected "." or "[" after element key`,
+		},
+		"missing-closing-bracket": {
+			input:         "test[0",
+			expectedError: `invalid path "test[0" at column 7: expected "]"`,
+		},
+		"empty-element-key": {
+			input:         "test[]",
+			expectedError: `invalid path "test[]" at column 6: expected list index or map key`,
+		},
+		"unterminated-map-key": {
+			input:         `test["key]`,
+			expectedError: `invalid path "test[\"key]" at column 6: invalid map key string`,
+		},
+		"negative-list-index": {
+			input:         "test[-1]",
+			expectedError: `invalid path "test[-1]" at column 6: expected list index or map key`,
+		},
+		"set-value": {
+			input:         `test[Value(tftypes.String<"value">)]`,
+			expectedError: `invalid path "test[Value(tftypes.String<\"value\">)]" at column 6: set value steps are not supported`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := path.Parse(tc.input)
+
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), tc.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if tc.expectedError != "" {
+				t.Fatalf("expected error: %s", tc.expectedError)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.String(), tc.input); diff != "" {
+				t.Errorf("unexpected String() difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package path
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Path represents exact traversal steps into a schema or schema-based data.
+// These steps always start from the root of the schema, which is an object
+// with zero or more attributes and blocks.
+//
+// Use the Root function to create a Path with an initial AttributeName step.
+// Path functionality follows a builder pattern, which allows for chaining
+// method calls to construct a full path. The available traversal steps after
+// Path creation are:
+//
+//   - AtListIndex(): Step into a list at a specific 0-based index
+//   - AtMapKey(): Step into a map at a specific key
+//   - AtName(): Step into an attribute or block with a specific name
+//   - AtSetValue(): Step into a set at a specific value
+//
+// For example, to represent the first list element with a root list
+// attribute named "some_attribute":
+//
+//	path.Root("some_attribute").AtListIndex(0)
+//
+// Path is immutable, each traversal step creates a new Path.
+type Path struct {
+	// steps is the transversals included with the path. In general, operations
+	// against the path should protect against modification of the original.
+	steps PathSteps
+}
+
+// Empty returns an empty Path, which represents the root of the schema.
+func Empty() Path {
+	return Path{
+		steps: PathSteps{},
+	}
+}
+
+// Root creates an attribute path starting with a PathStepAttributeName.
+func Root(rootAttributeName string) Path {
+	return Path{
+		steps: PathSteps{
+			PathStepAttributeName(rootAttributeName),
+		},
+	}
+}
+
+// AtListIndex returns a copied path with a new list index step at the end.
+// The returned path is safe to modify without affecting the original.
+//
+// List indices are 0-based. The first element of a list is 0.
+func (p Path) AtListIndex(index int) Path {
+	return p.withStep(PathStepElementKeyInt(index))
+}
+
+// AtMapKey returns a copied path with a new map key step at the end.
+// The returned path is safe to modify without affecting the original.
+func (p Path) AtMapKey(key string) Path {
+	return p.withStep(PathStepElementKeyString(key))
+}
+
+// AtName returns a copied path with a new attribute or block name step at the
+// end. The returned path is safe to modify without affecting the original.
+func (p Path) AtName(name string) Path {
+	return p.withStep(PathStepAttributeName(name))
+}
+
+// AtSetValue returns a copied path with a new set value step at the end.
+// The returned path is safe to modify without affecting the original.
+func (p Path) AtSetValue(value tftypes.Value) Path {
+	return p.withStep(PathStepElementKeyValue{Value: value})
+}
+
+// Copy returns a duplicate of the path that is safe to modify without
+// affecting the original.
+func (p Path) Copy() Path {
+	return Path{
+		steps: p.Steps(),
+	}
+}
+
+// Equal returns true if the given path is exactly equivalent.
+func (p Path) Equal(o Path) bool {
+	return p.steps.Equal(o.steps)
+}
+
+// Expression returns an Expression which exactly matches the Path.
+func (p Path) Expression() Expression {
+	steps := make(ExpressionSteps, 0, len(p.steps))
+
+	for _, step := range p.steps {
+		steps = append(steps, step.ExpressionStep())
+	}
+
+	return Expression{
+		root:  true,
+		steps: steps,
+	}
+}
+
+// ParentPath returns a copy of the path with the last step removed.
+//
+// If the current path is empty, an empty path is returned.
+func (p Path) ParentPath() Path {
+	if len(p.steps) == 0 {
+		return Empty()
+	}
+
+	_, remainingSteps := p.steps.Copy().LastStep()
+
+	return Path{
+		steps: remainingSteps,
+	}
+}
+
+// Steps returns a copy of the underlying path steps. Returns an empty
+// collection of steps if path is nil.
+func (p Path) Steps() PathSteps {
+	return p.steps.Copy()
+}
+
+// String returns the human-readable representation of the path.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (p Path) String() string {
+	return p.steps.String()
+}
+
+// withStep returns a copied path with the step appended, so the underlying
+// steps of the original path are never shared.
+func (p Path) withStep(step PathStep) Path {
+	steps := make(PathSteps, 0, len(p.steps)+1)
+	steps = append(steps, p.steps...)
+
+	return Path{
+		steps: append(steps, step),
+	}
+}
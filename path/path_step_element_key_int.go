@@ -0,0 +1,40 @@
+package path
+
+import (
+	"strconv"
+)
+
+// PathStepElementKeyInt is an attribute path transversal for an integer
+// element of a list. List indexing starts at 0.
+//
+// Map elements must be transversed by PathStepElementKeyString.
+// Object elements must be transversed by PathStepAttributeName.
+// Set elements must be transversed by PathStepElementKeyValue.
+type PathStepElementKeyInt int64
+
+// Equal returns true if the given PathStep is a PathStepElementKeyInt and
+// the integer element equivalent.
+func (s PathStepElementKeyInt) Equal(o PathStep) bool {
+	other, ok := o.(PathStepElementKeyInt)
+
+	if !ok {
+		return false
+	}
+
+	return int64(s) == int64(other)
+}
+
+// ExpressionStep returns the ExpressionStep for the PathStep.
+func (s PathStepElementKeyInt) ExpressionStep() ExpressionStep {
+	return ExpressionStepElementKeyIntExact(s)
+}
+
+// String returns the human-readable representation of the element key.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (s PathStepElementKeyInt) String() string {
+	return "[" + strconv.FormatInt(int64(s), 10) + "]"
+}
+
+// unexported satisfies the PathStep interface.
+func (s PathStepElementKeyInt) unexported() {}
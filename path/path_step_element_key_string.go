@@ -0,0 +1,40 @@
+package path
+
+import (
+	"strconv"
+)
+
+// PathStepElementKeyString is an attribute path transversal for a string
+// key of a map.
+//
+// List elements must be transversed by PathStepElementKeyInt.
+// Object elements must be transversed by PathStepAttributeName.
+// Set elements must be transversed by PathStepElementKeyValue.
+type PathStepElementKeyString string
+
+// Equal returns true if the given PathStep is a PathStepElementKeyString and
+// the string element equivalent.
+func (s PathStepElementKeyString) Equal(o PathStep) bool {
+	other, ok := o.(PathStepElementKeyString)
+
+	if !ok {
+		return false
+	}
+
+	return string(s) == string(other)
+}
+
+// ExpressionStep returns the ExpressionStep for the PathStep.
+func (s PathStepElementKeyString) ExpressionStep() ExpressionStep {
+	return ExpressionStepElementKeyStringExact(s)
+}
+
+// String returns the human-readable representation of the element key.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (s PathStepElementKeyString) String() string {
+	return "[" + strconv.Quote(string(s)) + "]"
+}
+
+// unexported satisfies the PathStep interface.
+func (s PathStepElementKeyString) unexported() {}
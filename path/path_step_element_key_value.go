@@ -0,0 +1,43 @@
+package path
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PathStepElementKeyValue is an attribute path transversal for a value of a
+// set. Sets do not use integer-based indexing.
+//
+// List elements must be transversed by PathStepElementKeyInt.
+// Map elements must be transversed by PathStepElementKeyString.
+// Object elements must be transversed by PathStepAttributeName.
+type PathStepElementKeyValue struct {
+	// Value is the entire set element value.
+	Value tftypes.Value
+}
+
+// Equal returns true if the given PathStep is a PathStepElementKeyValue and
+// the value element equivalent.
+func (s PathStepElementKeyValue) Equal(o PathStep) bool {
+	other, ok := o.(PathStepElementKeyValue)
+
+	if !ok {
+		return false
+	}
+
+	return s.Value.Equal(other.Value)
+}
+
+// ExpressionStep returns the ExpressionStep for the PathStep.
+func (s PathStepElementKeyValue) ExpressionStep() ExpressionStep {
+	return ExpressionStepElementKeyValueExact{Value: s.Value}
+}
+
+// String returns the human-readable representation of the element key.
+// It is intended for logging and error messages and is not protected by
+// compatibility guarantees.
+func (s PathStepElementKeyValue) String() string {
+	return "[Value(" + s.Value.String() + ")]"
+}
+
+// unexported satisfies the PathStep interface.
+func (s PathStepElementKeyValue) unexported() {}
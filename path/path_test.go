@@ -0,0 +1,228 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPathAtStep(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected path.PathSteps
+	}{
+		"empty": {
+			path:     path.Empty(),
+			expected: path.PathSteps{},
+		},
+		"root": {
+			path: path.Root("test"),
+			expected: path.PathSteps{
+				path.PathStepAttributeName("test"),
+			},
+		},
+		"AtListIndex": {
+			path: path.Root("test").AtListIndex(1),
+			expected: path.PathSteps{
+				path.PathStepAttributeName("test"),
+				path.PathStepElementKeyInt(1),
+			},
+		},
+		"AtMapKey": {
+			path: path.Root("test").AtMapKey("key"),
+			expected: path.PathSteps{
+				path.PathStepAttributeName("test"),
+				path.PathStepElementKeyString("key"),
+			},
+		},
+		"AtName": {
+			path: path.Root("test").AtListIndex(0).AtName("nested"),
+			expected: path.PathSteps{
+				path.PathStepAttributeName("test"),
+				path.PathStepElementKeyInt(0),
+				path.PathStepAttributeName("nested"),
+			},
+		},
+		"AtSetValue": {
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: path.PathSteps{
+				path.PathStepAttributeName("test"),
+				path.PathStepElementKeyValue{Value: tftypes.NewValue(tftypes.String, "value")},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.Steps()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathAtStepImmutable(t *testing.T) {
+	t.Parallel()
+
+	parent := path.Root("test").AtListIndex(0)
+
+	first := parent.AtName("first")
+	second := parent.AtName("second")
+
+	if diff := cmp.Diff(first, path.Root("test").AtListIndex(0).AtName("first")); diff != "" {
+		t.Errorf("unexpected first path difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(second, path.Root("test").AtListIndex(0).AtName("second")); diff != "" {
+		t.Errorf("unexpected second path difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(parent, path.Root("test").AtListIndex(0)); diff != "" {
+		t.Errorf("unexpected parent path difference: %s", diff)
+	}
+}
+
+func TestPathEqual(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		other    path.Path
+		expected bool
+	}{
+		"empty-empty": {
+			path:     path.Empty(),
+			other:    path.Empty(),
+			expected: true,
+		},
+		"empty-root": {
+			path:     path.Empty(),
+			other:    path.Root("test"),
+			expected: false,
+		},
+		"equal": {
+			path:     path.Root("test").AtMapKey("key"),
+			other:    path.Root("test").AtMapKey("key"),
+			expected: true,
+		},
+		"different-step-type": {
+			path:     path.Root("test").AtMapKey("0"),
+			other:    path.Root("test").AtListIndex(0),
+			expected: false,
+		},
+		"different-step-value": {
+			path:     path.Root("test").AtName("first"),
+			other:    path.Root("test").AtName("second"),
+			expected: false,
+		},
+		"different-length": {
+			path:     path.Root("test"),
+			other:    path.Root("test").AtListIndex(0),
+			expected: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.Equal(tc.other)
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestPathParentPath(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected path.Path
+	}{
+		"empty": {
+			path:     path.Empty(),
+			expected: path.Empty(),
+		},
+		"root": {
+			path:     path.Root("test"),
+			expected: path.Empty(),
+		},
+		"nested": {
+			path:     path.Root("test").AtListIndex(0).AtName("nested"),
+			expected: path.Root("test").AtListIndex(0),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.ParentPath()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		path     path.Path
+		expected string
+	}{
+		"empty": {
+			path:     path.Empty(),
+			expected: "",
+		},
+		"root": {
+			path:     path.Root("test"),
+			expected: "test",
+		},
+		"list": {
+			path:     path.Root("test").AtListIndex(0).AtName("nested"),
+			expected: "test[0].nested",
+		},
+		"map": {
+			path:     path.Root("test").AtMapKey("key"),
+			expected: `test["key"]`,
+		},
+		"set": {
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
+			expected: `test[Value(tftypes.String<"value">)]`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.path.String()
+
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
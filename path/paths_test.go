@@ -0,0 +1,78 @@
+package path_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestPathsAppend(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		paths    path.Paths
+		add      []path.Path
+		expected path.Paths
+	}{
+		"nil": {
+			add:      []path.Path{path.Root("test")},
+			expected: path.Paths{path.Root("test")},
+		},
+		"new": {
+			paths:    path.Paths{path.Root("first")},
+			add:      []path.Path{path.Root("second")},
+			expected: path.Paths{path.Root("first"), path.Root("second")},
+		},
+		"duplicate": {
+			paths:    path.Paths{path.Root("first")},
+			add:      []path.Path{path.Root("first"), path.Root("second"), path.Root("second")},
+			expected: path.Paths{path.Root("first"), path.Root("second")},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.paths.Append(tc.add...)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPathsString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		paths    path.Paths
+		expected string
+	}{
+		"nil": {
+			expected: "[]",
+		},
+		"multiple": {
+			paths:    path.Paths{path.Root("first"), path.Empty(), path.Root("second").AtListIndex(1)},
+			expected: "[first,second[1]]",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.paths.String()
+
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
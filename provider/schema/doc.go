@@ -0,0 +1,8 @@
+// Package schema contains the schema types for providers. They only contain
+// the fields which apply to providers, unlike the tfsdk package types, so
+// fields which would have no effect, such as Computed, PlanModifiers,
+// PreserveState, and Version, cannot be set.
+//
+// The ToTfsdkSchema method converts a Schema for the GetSchema method of
+// the tfsdk.Provider.
+package schema
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// NestedAttributes surfaces a group of attributes to nest beneath another
+// attribute, and how that nesting should behave. The zero value represents
+// no nested attributes.
+type NestedAttributes struct {
+	attributes  map[string]Attribute
+	nestingMode tfsdk.NestingMode
+}
+
+// SingleNestedAttributes nests `attributes` under another attribute, only
+// allowing one instance of that group of attributes to appear in the
+// configuration.
+func SingleNestedAttributes(attributes map[string]Attribute) NestedAttributes {
+	return NestedAttributes{
+		attributes:  attributes,
+		nestingMode: tfsdk.NestingModeSingle,
+	}
+}
+
+// ListNestedAttributes nests `attributes` under another attribute, allowing
+// multiple instances of that group of attributes to appear in the
+// configuration.
+func ListNestedAttributes(attributes map[string]Attribute) NestedAttributes {
+	return NestedAttributes{
+		attributes:  attributes,
+		nestingMode: tfsdk.NestingModeList,
+	}
+}
+
+// SetNestedAttributes nests `attributes` under another attribute, allowing
+// multiple instances of that group of attributes to appear in the
+// configuration, while requiring each group of values be unique.
+func SetNestedAttributes(attributes map[string]Attribute) NestedAttributes {
+	return NestedAttributes{
+		attributes:  attributes,
+		nestingMode: tfsdk.NestingModeSet,
+	}
+}
+
+// MapNestedAttributes nests `attributes` under another attribute, allowing
+// multiple instances of that group of attributes to appear in the
+// configuration. Each group will need to be associated with a unique string
+// by the user.
+func MapNestedAttributes(attributes map[string]Attribute) NestedAttributes {
+	return NestedAttributes{
+		attributes:  attributes,
+		nestingMode: tfsdk.NestingModeMap,
+	}
+}
+
+// GetAttributes returns the nested attributes.
+func (n NestedAttributes) GetAttributes() map[string]Attribute {
+	return n.attributes
+}
+
+// GetNestingMode returns the nesting mode, which is tfsdk.NestingModeUnknown
+// for the zero value.
+func (n NestedAttributes) GetNestingMode() tfsdk.NestingMode {
+	return n.nestingMode
+}
+
+// toTfsdkNestedAttributes returns the equivalent tfsdk.NestedAttributes, or
+// nil for the zero value.
+func (n NestedAttributes) toTfsdkNestedAttributes() tfsdk.NestedAttributes {
+	attributes := tfsdkAttributes(n.attributes)
+
+	switch n.nestingMode {
+	case tfsdk.NestingModeSingle:
+		return tfsdk.SingleNestedAttributes(attributes)
+	case tfsdk.NestingModeList:
+		return tfsdk.ListNestedAttributes(attributes, tfsdk.ListNestedAttributesOptions{})
+	case tfsdk.NestingModeSet:
+		return tfsdk.SetNestedAttributes(attributes, tfsdk.SetNestedAttributesOptions{})
+	case tfsdk.NestingModeMap:
+		return tfsdk.MapNestedAttributes(attributes, tfsdk.MapNestedAttributesOptions{})
+	default:
+		return nil
+	}
+}
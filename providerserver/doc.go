@@ -0,0 +1,32 @@
+// Package providerserver implements the Terraform plugin protocol servers
+// for framework providers. The servers are for use with the serve functions
+// of terraform-plugin-go, such as tf6server.Serve, and with
+// terraform-plugin-mux, when a provider needs more control than
+// tfsdk.Serve provides, or with acceptance testing frameworks which accept
+// provider server factories.
+//
+// NewProtocol6 returns protocol version 6 servers, which support every
+// framework feature. NewProtocol5 returns protocol version 5 servers, which
+// allow muxing a framework provider with a provider built with
+// terraform-plugin-sdk version 2, so resources can be migrated to the
+// framework incrementally:
+//
+//	ctx := context.Background()
+//	providers := []func() tfprotov5.ProviderServer{
+//		sdkv2provider.New().GRPCProvider,
+//		providerserver.NewProtocol5(frameworkprovider.New()),
+//	}
+//
+//	muxServer, err := tf5muxserver.NewMuxServer(ctx, providers...)
+//
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	err = tf5server.Serve("registry.terraform.io/example/example", muxServer.ProviderServer)
+//
+// Muxed providers must return identical provider and provider meta
+// schemas, and each resource and data source type must only be implemented
+// by one of them. Protocol version 5 does not support nested attributes, so
+// framework schemas served with NewProtocol5 must use blocks instead.
+package providerserver
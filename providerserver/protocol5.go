@@ -0,0 +1,293 @@
+package providerserver
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewProtocol5 returns a function which returns a protocol version 5
+// ProviderServer implementation based on the given Provider, suitable for
+// usage with tf5server.Serve and terraform-plugin-mux. Each call of the
+// returned function returns a new server.
+//
+// Protocol version 5 is supported by Terraform CLI version 0.12 and later
+// and is the protocol of providers built with terraform-plugin-sdk version
+// 2, so this allows muxing a framework provider with an existing provider
+// while migrating resources incrementally. Protocol version 5 does not
+// support nested attributes, so GetProviderSchema returns an error
+// diagnostic for every schema which uses them.
+func NewProtocol5(p tfsdk.Provider) func() tfprotov5.ProviderServer {
+	return NewProtocol5WithOptions(p, tfsdk.ServeOpts{})
+}
+
+// NewProtocol5WithOptions returns a function which returns a protocol
+// version 5 ProviderServer implementation based on the given Provider,
+// configured with the framework behaviors of the given ServeOpts. The Name
+// option and the process management of the Debug option are ignored, since
+// the process is managed by the caller.
+func NewProtocol5WithOptions(p tfsdk.Provider, opts tfsdk.ServeOpts) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return protocol5Server{
+			server: tfsdk.NewProtocol6ServerWithOptions(p, opts),
+		}
+	}
+}
+
+// NewProtocol5WithError returns a function which returns a protocol version
+// 5 ProviderServer implementation based on the given Provider and a nil
+// error, which is the function signature expected by some acceptance
+// testing frameworks for provider server factories.
+func NewProtocol5WithError(p tfsdk.Provider) func() (tfprotov5.ProviderServer, error) {
+	return func() (tfprotov5.ProviderServer, error) {
+		return NewProtocol5(p)(), nil
+	}
+}
+
+var _ tfprotov5.ProviderServer = protocol5Server{}
+
+// protocol5Server serves protocol version 5 requests by converting them to
+// protocol version 6 requests of the framework server, and converting the
+// responses back.
+type protocol5Server struct {
+	server tfprotov6.ProviderServer
+}
+
+func (s protocol5Server) GetProviderSchema(ctx context.Context, req *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	resp, err := s.server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	diags := diagnostics6To5(resp.Diagnostics)
+
+	provider, err := proto5.Schema(resp.Provider)
+
+	if err != nil {
+		diags = append(diags, incompatibleSchemaDiagnostic("provider schema", err))
+	}
+
+	providerMeta, err := proto5.Schema(resp.ProviderMeta)
+
+	if err != nil {
+		diags = append(diags, incompatibleSchemaDiagnostic("provider meta schema", err))
+	}
+
+	resourceSchemas, resourceDiags := schemas6To5(resp.ResourceSchemas, "resource")
+	diags = append(diags, resourceDiags...)
+
+	dataSourceSchemas, dataSourceDiags := schemas6To5(resp.DataSourceSchemas, "data source")
+	diags = append(diags, dataSourceDiags...)
+
+	return &tfprotov5.GetProviderSchemaResponse{
+		Provider:          provider,
+		ProviderMeta:      providerMeta,
+		ResourceSchemas:   resourceSchemas,
+		DataSourceSchemas: dataSourceSchemas,
+		Diagnostics:       diags,
+	}, nil
+}
+
+func (s protocol5Server) PrepareProviderConfig(ctx context.Context, req *tfprotov5.PrepareProviderConfigRequest) (*tfprotov5.PrepareProviderConfigResponse, error) {
+	resp, err := s.server.ValidateProviderConfig(ctx, &tfprotov6.ValidateProviderConfigRequest{
+		Config: dynamicValue5To6(req.Config),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.PrepareProviderConfigResponse{
+		PreparedConfig: dynamicValue6To5(resp.PreparedConfig),
+		Diagnostics:    diagnostics6To5(resp.Diagnostics),
+	}, nil
+}
+
+func (s protocol5Server) ConfigureProvider(ctx context.Context, req *tfprotov5.ConfigureProviderRequest) (*tfprotov5.ConfigureProviderResponse, error) {
+	resp, err := s.server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: req.TerraformVersion,
+		Config:           dynamicValue5To6(req.Config),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ConfigureProviderResponse{
+		Diagnostics: diagnostics6To5(resp.Diagnostics),
+	}, nil
+}
+
+func (s protocol5Server) StopProvider(ctx context.Context, req *tfprotov5.StopProviderRequest) (*tfprotov5.StopProviderResponse, error) {
+	resp, err := s.server.StopProvider(ctx, &tfprotov6.StopProviderRequest{})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.StopProviderResponse{
+		Error: resp.Error,
+	}, nil
+}
+
+func (s protocol5Server) ValidateResourceTypeConfig(ctx context.Context, req *tfprotov5.ValidateResourceTypeConfigRequest) (*tfprotov5.ValidateResourceTypeConfigResponse, error) {
+	resp, err := s.server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: req.TypeName,
+		Config:   dynamicValue5To6(req.Config),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ValidateResourceTypeConfigResponse{
+		Diagnostics: diagnostics6To5(resp.Diagnostics),
+	}, nil
+}
+
+func (s protocol5Server) UpgradeResourceState(ctx context.Context, req *tfprotov5.UpgradeResourceStateRequest) (*tfprotov5.UpgradeResourceStateResponse, error) {
+	resp, err := s.server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: req.TypeName,
+		Version:  req.Version,
+		RawState: rawState5To6(req.RawState),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.UpgradeResourceStateResponse{
+		UpgradedState: dynamicValue6To5(resp.UpgradedState),
+		Diagnostics:   diagnostics6To5(resp.Diagnostics),
+	}, nil
+}
+
+func (s protocol5Server) ReadResource(ctx context.Context, req *tfprotov5.ReadResourceRequest) (*tfprotov5.ReadResourceResponse, error) {
+	resp, err := s.server.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+		TypeName:     req.TypeName,
+		CurrentState: dynamicValue5To6(req.CurrentState),
+		Private:      req.Private,
+		ProviderMeta: dynamicValue5To6(req.ProviderMeta),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ReadResourceResponse{
+		NewState:    dynamicValue6To5(resp.NewState),
+		Diagnostics: diagnostics6To5(resp.Diagnostics),
+		Private:     resp.Private,
+	}, nil
+}
+
+func (s protocol5Server) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         req.TypeName,
+		PriorState:       dynamicValue5To6(req.PriorState),
+		ProposedNewState: dynamicValue5To6(req.ProposedNewState),
+		Config:           dynamicValue5To6(req.Config),
+		PriorPrivate:     req.PriorPrivate,
+		ProviderMeta:     dynamicValue5To6(req.ProviderMeta),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.PlanResourceChangeResponse{
+		PlannedState:                dynamicValue6To5(resp.PlannedState),
+		RequiresReplace:             resp.RequiresReplace,
+		PlannedPrivate:              resp.PlannedPrivate,
+		Diagnostics:                 diagnostics6To5(resp.Diagnostics),
+		UnsafeToUseLegacyTypeSystem: resp.UnsafeToUseLegacyTypeSystem,
+	}, nil
+}
+
+func (s protocol5Server) ApplyResourceChange(ctx context.Context, req *tfprotov5.ApplyResourceChangeRequest) (*tfprotov5.ApplyResourceChangeResponse, error) {
+	resp, err := s.server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       req.TypeName,
+		PriorState:     dynamicValue5To6(req.PriorState),
+		PlannedState:   dynamicValue5To6(req.PlannedState),
+		Config:         dynamicValue5To6(req.Config),
+		PlannedPrivate: req.PlannedPrivate,
+		ProviderMeta:   dynamicValue5To6(req.ProviderMeta),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ApplyResourceChangeResponse{
+		NewState:                    dynamicValue6To5(resp.NewState),
+		Private:                     resp.Private,
+		Diagnostics:                 diagnostics6To5(resp.Diagnostics),
+		UnsafeToUseLegacyTypeSystem: resp.UnsafeToUseLegacyTypeSystem,
+	}, nil
+}
+
+func (s protocol5Server) ImportResourceState(ctx context.Context, req *tfprotov5.ImportResourceStateRequest) (*tfprotov5.ImportResourceStateResponse, error) {
+	resp, err := s.server.ImportResourceState(ctx, &tfprotov6.ImportResourceStateRequest{
+		TypeName: req.TypeName,
+		ID:       req.ID,
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	var importedResources []*tfprotov5.ImportedResource
+
+	for _, importedResource := range resp.ImportedResources {
+		if importedResource == nil {
+			continue
+		}
+
+		importedResources = append(importedResources, &tfprotov5.ImportedResource{
+			TypeName: importedResource.TypeName,
+			State:    dynamicValue6To5(importedResource.State),
+			Private:  importedResource.Private,
+		})
+	}
+
+	return &tfprotov5.ImportResourceStateResponse{
+		ImportedResources: importedResources,
+		Diagnostics:       diagnostics6To5(resp.Diagnostics),
+	}, nil
+}
+
+func (s protocol5Server) ValidateDataSourceConfig(ctx context.Context, req *tfprotov5.ValidateDataSourceConfigRequest) (*tfprotov5.ValidateDataSourceConfigResponse, error) {
+	resp, err := s.server.ValidateDataResourceConfig(ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+		TypeName: req.TypeName,
+		Config:   dynamicValue5To6(req.Config),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ValidateDataSourceConfigResponse{
+		Diagnostics: diagnostics6To5(resp.Diagnostics),
+	}, nil
+}
+
+func (s protocol5Server) ReadDataSource(ctx context.Context, req *tfprotov5.ReadDataSourceRequest) (*tfprotov5.ReadDataSourceResponse, error) {
+	resp, err := s.server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName:     req.TypeName,
+		Config:       dynamicValue5To6(req.Config),
+		ProviderMeta: dynamicValue5To6(req.ProviderMeta),
+	})
+
+	if err != nil || resp == nil {
+		return nil, err
+	}
+
+	return &tfprotov5.ReadDataSourceResponse{
+		State:       dynamicValue6To5(resp.State),
+		Diagnostics: diagnostics6To5(resp.Diagnostics),
+	}, nil
+}
@@ -0,0 +1,117 @@
+package providerserver
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// The protocol version 5 and 6 types are identical, other than nested
+// attributes, which only exist in protocol version 6. These functions
+// convert requests from protocol version 5 and responses to protocol
+// version 5.
+
+func dynamicValue5To6(in *tfprotov5.DynamicValue) *tfprotov6.DynamicValue {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.DynamicValue{
+		MsgPack: in.MsgPack,
+		JSON:    in.JSON,
+	}
+}
+
+func dynamicValue6To5(in *tfprotov6.DynamicValue) *tfprotov5.DynamicValue {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov5.DynamicValue{
+		MsgPack: in.MsgPack,
+		JSON:    in.JSON,
+	}
+}
+
+func rawState5To6(in *tfprotov5.RawState) *tfprotov6.RawState {
+	if in == nil {
+		return nil
+	}
+
+	return &tfprotov6.RawState{
+		JSON:    in.JSON,
+		Flatmap: in.Flatmap,
+	}
+}
+
+func diagnostics6To5(in []*tfprotov6.Diagnostic) []*tfprotov5.Diagnostic {
+	if in == nil {
+		return nil
+	}
+
+	diags := make([]*tfprotov5.Diagnostic, 0, len(in))
+
+	for _, diag := range in {
+		if diag == nil {
+			continue
+		}
+
+		diags = append(diags, &tfprotov5.Diagnostic{
+			Severity:  tfprotov5.DiagnosticSeverity(diag.Severity),
+			Summary:   diag.Summary,
+			Detail:    diag.Detail,
+			Attribute: diag.Attribute,
+		})
+	}
+
+	return diags
+}
+
+// incompatibleSchemaDiagnostic returns the diagnostic for a schema which
+// cannot be represented in protocol version 5.
+func incompatibleSchemaDiagnostic(schemaName string, err error) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Protocol Version 5 Incompatible Schema",
+		Detail: fmt.Sprintf("The %s cannot be served over protocol version 5. This is always an error in the provider. Please report the following to the provider developer:\n\n%s. "+
+			"Nested attributes require protocol version 6; use blocks instead, or serve the provider with protocol version 6.", schemaName, err),
+	}
+}
+
+// schemas6To5 converts the named schemas to protocol version 5, returning
+// an error diagnostic for each schema which is incompatible. The
+// diagnostics are sorted by schema name.
+func schemas6To5(in map[string]*tfprotov6.Schema, kind string) (map[string]*tfprotov5.Schema, []*tfprotov5.Diagnostic) {
+	if in == nil {
+		return nil, nil
+	}
+
+	names := make([]string, 0, len(in))
+
+	for name := range in {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	schemas := make(map[string]*tfprotov5.Schema, len(in))
+
+	var diags []*tfprotov5.Diagnostic
+
+	for _, name := range names {
+		schema, err := proto5.Schema(in[name])
+
+		if err != nil {
+			diags = append(diags, incompatibleSchemaDiagnostic(fmt.Sprintf("%s %q schema", kind, name), err))
+
+			continue
+		}
+
+		schemas[name] = schema
+	}
+
+	return schemas, diags
+}
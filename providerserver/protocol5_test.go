@@ -0,0 +1,136 @@
+package providerserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testNestedAttributesProvider has a provider schema with nested
+// attributes, which cannot be served over protocol version 5.
+type testNestedAttributesProvider struct {
+	testProvider
+}
+
+func (p testNestedAttributesProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"nested": {
+				Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+					"endpoint": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}, nil
+}
+
+func TestNewProtocol5GetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		provider      tfsdk.Provider
+		expected      *tfprotov5.Schema
+		expectedDiags []*tfprotov5.Diagnostic
+	}{
+		"compatible": {
+			provider: testProvider{},
+			expected: &tfprotov5.Schema{
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "endpoint",
+							Type:     tftypes.String,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+		"nested-attributes": {
+			provider: testNestedAttributesProvider{},
+			expectedDiags: []*tfprotov5.Diagnostic{
+				{
+					Severity: tfprotov5.DiagnosticSeverityError,
+					Summary:  "Protocol Version 5 Incompatible Schema",
+					Detail: "The provider schema cannot be served over protocol version 5. This is always an error in the provider. Please report the following to the provider developer:\n\n" +
+						`AttributeName("nested"): attribute uses nested attributes. ` +
+						"Nested attributes require protocol version 6; use blocks instead, or serve the provider with protocol version 6.",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server, err := providerserver.NewProtocol5WithError(tc.provider)()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := server.GetProviderSchema(context.Background(), &tfprotov5.GetProviderSchemaRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.Provider, tc.expected); diff != "" {
+				t.Errorf("unexpected provider schema difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestNewProtocol5PrepareProviderConfig(t *testing.T) {
+	t.Parallel()
+
+	configType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"endpoint": tftypes.String,
+		},
+	}
+	config, err := tfprotov5.NewDynamicValue(configType, tftypes.NewValue(configType, map[string]tftypes.Value{
+		"endpoint": tftypes.NewValue(tftypes.String, "https://example.com"),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	server := providerserver.NewProtocol5(testProvider{})()
+
+	got, err := server.PrepareProviderConfig(context.Background(), &tfprotov5.PrepareProviderConfigRequest{
+		Config: &config,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got.Diagnostics) != 0 {
+		t.Errorf("unexpected diagnostics: %v", got.Diagnostics)
+	}
+
+	if diff := cmp.Diff(got.PreparedConfig, &config); diff != "" {
+		t.Errorf("unexpected prepared config difference: %s", diff)
+	}
+}
@@ -0,0 +1,38 @@
+package providerserver
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewProtocol6 returns a function which returns a protocol version 6
+// ProviderServer implementation based on the given Provider, suitable for
+// usage with tf6server.Serve and terraform-plugin-mux. Each call of the
+// returned function returns a new server.
+//
+// Protocol version 6 supports features such as nested attributes natively
+// and requires Terraform CLI version 1.0 or later.
+func NewProtocol6(p tfsdk.Provider) func() tfprotov6.ProviderServer {
+	return NewProtocol6WithOptions(p, tfsdk.ServeOpts{})
+}
+
+// NewProtocol6WithOptions returns a function which returns a protocol
+// version 6 ProviderServer implementation based on the given Provider,
+// configured with the framework behaviors of the given ServeOpts. The Name
+// option and the process management of the Debug option are ignored, since
+// the process is managed by the caller.
+func NewProtocol6WithOptions(p tfsdk.Provider, opts tfsdk.ServeOpts) func() tfprotov6.ProviderServer {
+	return func() tfprotov6.ProviderServer {
+		return tfsdk.NewProtocol6ServerWithOptions(p, opts)
+	}
+}
+
+// NewProtocol6WithError returns a function which returns a protocol version
+// 6 ProviderServer implementation based on the given Provider and a nil
+// error, which is the function signature expected by some acceptance
+// testing frameworks for provider server factories.
+func NewProtocol6WithError(p tfsdk.Provider) func() (tfprotov6.ProviderServer, error) {
+	return func() (tfprotov6.ProviderServer, error) {
+		return NewProtocol6(p)(), nil
+	}
+}
@@ -0,0 +1,100 @@
+package providerserver_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testProvider struct{}
+
+func (p testProvider) GetSchema(_ context.Context) (tfsdk.Schema, diag.Diagnostics) {
+	return tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			"endpoint": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}, nil
+}
+
+func (p testProvider) Configure(_ context.Context, _ tfsdk.ConfigureProviderRequest, _ *tfsdk.ConfigureProviderResponse) {
+}
+
+func (p testProvider) GetResources(_ context.Context) (map[string]tfsdk.ResourceType, diag.Diagnostics) {
+	return nil, nil
+}
+
+func (p testProvider) GetDataSources(_ context.Context) (map[string]tfsdk.DataSourceType, diag.Diagnostics) {
+	return nil, nil
+}
+
+func TestNewProtocol6(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		serverFunc func() (tfprotov6.ProviderServer, error)
+	}{
+		"NewProtocol6": {
+			serverFunc: func() (tfprotov6.ProviderServer, error) {
+				return providerserver.NewProtocol6(testProvider{})(), nil
+			},
+		},
+		"NewProtocol6WithOptions": {
+			serverFunc: func() (tfprotov6.ProviderServer, error) {
+				return providerserver.NewProtocol6WithOptions(testProvider{}, tfsdk.ServeOpts{Debug: true})(), nil
+			},
+		},
+		"NewProtocol6WithError": {
+			serverFunc: providerserver.NewProtocol6WithError(testProvider{}),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server, err := tc.serverFunc()
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expected := &tfprotov6.Schema{
+				Block: &tfprotov6.SchemaBlock{
+					Attributes: []*tfprotov6.SchemaAttribute{
+						{
+							Name:     "endpoint",
+							Type:     tftypes.String,
+							Optional: true,
+						},
+					},
+				},
+			}
+
+			if diff := cmp.Diff(got.Provider, expected); diff != "" {
+				t.Errorf("unexpected provider schema difference: %s", diff)
+			}
+
+			if len(got.Diagnostics) != 0 {
+				t.Errorf("unexpected diagnostics: %v", got.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Block defines the constraints and behaviors of a single structural field
+// in a resource schema.
+//
+// Deprecated: Attributes are preferred over Blocks. Blocks should only be
+// used for configuration compatibility with previously existing schemas from
+// an older Terraform Plugin SDK.
+type Block struct {
+	// Attributes are value fields inside the block.
+	Attributes map[string]Attribute
+
+	// Blocks can have their own nested blocks.
+	Blocks map[string]Block
+
+	// DeprecationMessage defines a message to display to practitioners
+	// using this block, warning them that it is deprecated and
+	// instructing them on what upgrade steps to take.
+	DeprecationMessage string
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this block is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this block is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription string
+
+	// MaxItems is the maximum number of blocks that can be present in a
+	// practitioner configuration.
+	MaxItems int64
+
+	// MinItems is the minimum number of blocks that must be present in a
+	// practitioner configuration.
+	MinItems int64
+
+	// NestingMode indicates the block kind.
+	NestingMode tfsdk.BlockNestingMode
+
+	// PlanModifiers defines a sequence of modifiers for this block at
+	// plan time. Block-level plan modifications occur before any
+	// resource-level plan modifications.
+	PlanModifiers tfsdk.AttributePlanModifiers
+
+	// Validators defines validation functionality for the block.
+	Validators []tfsdk.AttributeValidator
+}
+
+// ToTfsdkBlock returns the equivalent tfsdk.Block.
+func (b Block) ToTfsdkBlock() tfsdk.Block {
+	return tfsdk.Block{
+		Attributes:          tfsdkAttributes(b.Attributes),
+		Blocks:              tfsdkBlocks(b.Blocks),
+		DeprecationMessage:  b.DeprecationMessage,
+		Description:         b.Description,
+		MarkdownDescription: b.MarkdownDescription,
+		MaxItems:            b.MaxItems,
+		MinItems:            b.MinItems,
+		NestingMode:         b.NestingMode,
+		PlanModifiers:       b.PlanModifiers,
+		Validators:          b.Validators,
+	}
+}
+
+// tfsdkBlocks converts every Block, preserving a nil map.
+func tfsdkBlocks(blocks map[string]Block) map[string]tfsdk.Block {
+	if blocks == nil {
+		return nil
+	}
+
+	result := make(map[string]tfsdk.Block, len(blocks))
+
+	for name, block := range blocks {
+		result[name] = block.ToTfsdkBlock()
+	}
+
+	return result
+}
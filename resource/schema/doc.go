@@ -0,0 +1,7 @@
+// Package schema contains the schema types for resources. They only
+// contain the fields which apply to resources, so schemas for other
+// concepts cannot accidentally use these types.
+//
+// The ToTfsdkSchema method converts a Schema for the GetSchema method of
+// the tfsdk.ResourceType.
+package schema
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Schema defines the shape of a resource.
+type Schema struct {
+	// Attributes are value fields inside the resource. The map key is the
+	// name of the attribute, and the body defines how it behaves. Names
+	// must only contain lowercase letters, numbers, and underscores. Names
+	// must not collide with any Blocks names.
+	//
+	// Attributes are strongly preferred over Blocks.
+	Attributes map[string]Attribute
+
+	// Blocks are structural fields inside the resource. The map key is
+	// the name of the block, and the body defines how it behaves. Names
+	// must only contain lowercase letters, numbers, and underscores. Names
+	// must not collide with any Attributes names.
+	//
+	// Deprecated: Attributes are preferred over Blocks. Blocks should only be
+	// used for configuration compatibility with previously existing schemas
+	// from an older Terraform Plugin SDK.
+	Blocks map[string]Block
+
+	// Version indicates the current version of the schema. Schemas are
+	// versioned to help with automatic upgrade process. This is not
+	// typically required unless there is a change in the schema, such as
+	// changing an attribute type, that needs manual upgrade handling.
+	// Versions should only be incremented by one each release.
+	Version int64
+
+	// DeprecationMessage defines a message to display to practitioners
+	// using this resource, warning them that it is deprecated and
+	// instructing them on what upgrade steps to take.
+	DeprecationMessage string
+
+	// Description is used in various tooling, like the language server, to
+	// give practitioners more information about what this resource is,
+	// what it's for, and how it should be used. It should be written as
+	// plain text, with no special formatting.
+	Description string
+
+	// MarkdownDescription is used in various tooling, like the
+	// documentation generator, to give practitioners more information
+	// about what this resource is, what it's for, and how it should be
+	// used. It should be formatted using Markdown.
+	MarkdownDescription string
+}
+
+// ToTfsdkSchema returns the equivalent tfsdk.Schema.
+func (s Schema) ToTfsdkSchema() tfsdk.Schema {
+	return tfsdk.Schema{
+		Attributes:          tfsdkAttributes(s.Attributes),
+		Blocks:              tfsdkBlocks(s.Blocks),
+		Version:             s.Version,
+		DeprecationMessage:  s.DeprecationMessage,
+		Description:         s.Description,
+		MarkdownDescription: s.MarkdownDescription,
+	}
+}
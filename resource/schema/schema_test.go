@@ -0,0 +1,217 @@
+package schema_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testValidator struct{}
+
+func (v testValidator) Description(_ context.Context) string {
+	return "test validator"
+}
+
+func (v testValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testValidator) Validate(_ context.Context, _ tfsdk.ValidateAttributeRequest, _ *tfsdk.ValidateAttributeResponse) {
+}
+
+func TestSchemaToTfsdkSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   schema.Schema
+		expected tfsdk.Schema
+	}{
+		"empty": {
+			schema:   schema.Schema{},
+			expected: tfsdk.Schema{},
+		},
+		"map-nested-attributes": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"map": {
+						Attributes: schema.MapNestedAttributes(map[string]schema.Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expected: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"map": {
+						Attributes: tfsdk.MapNestedAttributes(map[string]tfsdk.Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}, tfsdk.MapNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+			},
+		},
+		"all-fields": {
+			schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": {
+						Type:          types.StringType,
+						Description:   "Identifier.",
+						Computed:      true,
+						PreserveState: true,
+						Validators:    []tfsdk.AttributeValidator{testValidator{}},
+						PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+					},
+					"document": {
+						Type:     types.StringType,
+						Computed: true,
+						Codec:    tfsdk.GzipBase64Codec(),
+					},
+					"list": {
+						Attributes: schema.ListNestedAttributes(map[string]schema.Attribute{
+							"name": {
+								Type:      types.StringType,
+								Required:  true,
+								Sensitive: true,
+							},
+						}),
+						Optional: true,
+					},
+					"set": {
+						Attributes: schema.SetNestedAttributes(map[string]schema.Attribute{
+							"single": {
+								Attributes: schema.SingleNestedAttributes(map[string]schema.Attribute{
+									"value": {
+										Type:     types.Int64Type,
+										Optional: true,
+									},
+								}),
+								Optional: true,
+							},
+						}),
+						Optional:           true,
+						DeprecationMessage: "Use list instead.",
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"block": {
+						Attributes: map[string]schema.Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						Blocks: map[string]schema.Block{
+							"inner": {
+								NestingMode: tfsdk.BlockNestingModeSet,
+							},
+						},
+						MaxItems:      2,
+						MinItems:      1,
+						NestingMode:   tfsdk.BlockNestingModeList,
+						PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+					},
+				},
+				Version:             2,
+				DeprecationMessage:  "Deprecated.",
+				Description:         "Description.",
+				MarkdownDescription: "Markdown description.",
+			},
+			expected: tfsdk.Schema{
+				Attributes: map[string]tfsdk.Attribute{
+					"id": {
+						Type:          types.StringType,
+						Description:   "Identifier.",
+						Computed:      true,
+						PreserveState: true,
+						Validators:    []tfsdk.AttributeValidator{testValidator{}},
+						PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+					},
+					"document": {
+						Type:     types.StringType,
+						Computed: true,
+						Codec:    tfsdk.GzipBase64Codec(),
+					},
+					"list": {
+						Attributes: tfsdk.ListNestedAttributes(map[string]tfsdk.Attribute{
+							"name": {
+								Type:      types.StringType,
+								Required:  true,
+								Sensitive: true,
+							},
+						}, tfsdk.ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+					"set": {
+						Attributes: tfsdk.SetNestedAttributes(map[string]tfsdk.Attribute{
+							"single": {
+								Attributes: tfsdk.SingleNestedAttributes(map[string]tfsdk.Attribute{
+									"value": {
+										Type:     types.Int64Type,
+										Optional: true,
+									},
+								}),
+								Optional: true,
+							},
+						}, tfsdk.SetNestedAttributesOptions{}),
+						Optional:           true,
+						DeprecationMessage: "Use list instead.",
+					},
+				},
+				Blocks: map[string]tfsdk.Block{
+					"block": {
+						Attributes: map[string]tfsdk.Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						Blocks: map[string]tfsdk.Block{
+							"inner": {
+								NestingMode: tfsdk.BlockNestingModeSet,
+							},
+						},
+						MaxItems:      2,
+						MinItems:      1,
+						NestingMode:   tfsdk.BlockNestingModeList,
+						PlanModifiers: tfsdk.AttributePlanModifiers{tfsdk.RequiresReplace()},
+					},
+				},
+				Version:             2,
+				DeprecationMessage:  "Deprecated.",
+				Description:         "Description.",
+				MarkdownDescription: "Markdown description.",
+			},
+		},
+	}
+
+	// tfsdk.Attribute.Equal does not compare Validators, PlanModifiers, or Codec.
+	opt := cmp.Comparer(func(x, y tfsdk.Attribute) bool {
+		return x.Equal(y) && cmp.Equal(x.Validators, y.Validators) && cmp.Equal(x.PlanModifiers, y.PlanModifiers) && cmp.Equal(x.Codec, y.Codec)
+	})
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.schema.ToTfsdkSchema()
+
+			if diff := cmp.Diff(got, tc.expected, opt); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
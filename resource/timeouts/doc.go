@@ -0,0 +1,15 @@
+// Package timeouts contains helpers for the standard timeouts block or
+// attribute of resources, which lets practitioners configure how long each
+// resource operation may take, such as:
+//
+//	timeouts {
+//	  create = "60m"
+//	  delete = "2h"
+//	}
+//
+// Block or Attributes adds the timeouts to the resource schema, depending on
+// whether the configuration should use block or attribute syntax. Context
+// then reads the configured timeout for an operation from the Config, Plan,
+// or State of a request and returns a context.Context with a matching
+// operation budget, which is observed by helpers such as tfsdk.Retry.
+package timeouts
@@ -0,0 +1,81 @@
+package timeouts
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// AttributeName is the name of the timeouts block or attribute in the
+	// resource schema.
+	AttributeName = "timeouts"
+
+	// Create is the name of the create operation timeout.
+	Create = "create"
+
+	// Read is the name of the read operation timeout.
+	Read = "read"
+
+	// Update is the name of the update operation timeout.
+	Update = "update"
+
+	// Delete is the name of the delete operation timeout.
+	Delete = "delete"
+)
+
+// Opts selects which operation timeouts are configurable. Resources without
+// an Update method, for example, should not accept an update timeout.
+type Opts struct {
+	Create bool
+	Read   bool
+	Update bool
+	Delete bool
+}
+
+// Block returns a timeouts block for the resource schema, configured with
+// block syntax. Add it to the schema Blocks under AttributeName.
+func Block(ctx context.Context, opts Opts) tfsdk.Block {
+	return tfsdk.Block{
+		Attributes:  attributes(ctx, opts),
+		NestingMode: tfsdk.BlockNestingModeList,
+		MaxItems:    1,
+	}
+}
+
+// Attributes returns a timeouts attribute for the resource schema, configured
+// with attribute syntax. Add it to the schema Attributes under AttributeName.
+func Attributes(ctx context.Context, opts Opts) tfsdk.Attribute {
+	return tfsdk.Attribute{
+		Attributes: tfsdk.SingleNestedAttributes(attributes(ctx, opts)),
+		Optional:   true,
+	}
+}
+
+// attributes returns the duration attribute of each selected operation.
+func attributes(ctx context.Context, opts Opts) map[string]tfsdk.Attribute {
+	attributes := map[string]tfsdk.Attribute{}
+
+	for operation, enabled := range map[string]bool{
+		Create: opts.Create,
+		Read:   opts.Read,
+		Update: opts.Update,
+		Delete: opts.Delete,
+	} {
+		if !enabled {
+			continue
+		}
+
+		attributes[operation] = tfsdk.Attribute{
+			Type:        types.StringType,
+			Optional:    true,
+			Description: "A duration for the " + operation + " operation, such as \"30s\" or \"2h45m\".",
+			Validators: []tfsdk.AttributeValidator{
+				durationValidator{},
+			},
+		}
+	}
+
+	return attributes
+}
@@ -0,0 +1,118 @@
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefaultTimeout is the timeout of operations which do not have one
+// configured, matching the default of Terraform Plugin SDK version 2.
+const DefaultTimeout = 20 * time.Minute
+
+// Duration returns the configured timeout of the operation, such as Create,
+// from the timeouts block or attribute in data. The defaultTimeout is
+// returned if no timeout is configured, or if it is not yet known.
+//
+// The data is usually the Config or Plan of the request. The State should
+// be used for the delete operation, which has neither.
+func Duration(ctx context.Context, data tfsdk.SchemaData, operation string, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics) {
+	// Check for null first, as data such as the plan of a delete operation
+	// is entirely null and cannot be read into a value.
+	isNull, diags := data.AttributeIsNull(ctx, path.Root(AttributeName))
+
+	if diags.HasError() || isNull {
+		return defaultTimeout, diags
+	}
+
+	var timeouts attr.Value
+
+	diags.Append(data.GetAttribute(ctx, path.Root(AttributeName), &timeouts)...)
+
+	if diags.HasError() {
+		return defaultTimeout, diags
+	}
+
+	operationPath := path.Root(AttributeName)
+	var attrs map[string]attr.Value
+
+	switch timeouts := timeouts.(type) {
+	case types.List:
+		// Block syntax, which has at most one block.
+		if timeouts.Null || timeouts.Unknown || len(timeouts.Elems) == 0 {
+			return defaultTimeout, diags
+		}
+
+		operationPath = operationPath.AtListIndex(0)
+		attrs = objectAttrs(timeouts.Elems[0])
+	case types.Object:
+		// Attribute syntax.
+		attrs = objectAttrs(timeouts)
+	default:
+		diags.AddAttributeError(
+			operationPath,
+			"Timeouts Read Error",
+			"An unexpected error was encountered trying to read the timeouts. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected timeouts to be added with the timeouts.Block or timeouts.Attributes functions, got: %T", timeouts),
+		)
+		return defaultTimeout, diags
+	}
+
+	operationPath = operationPath.AtName(operation)
+	value, ok := attrs[operation].(types.String)
+
+	if !ok || value.Null || value.Unknown {
+		return defaultTimeout, diags
+	}
+
+	duration, err := time.ParseDuration(value.Value)
+
+	if err != nil {
+		diags.AddAttributeError(
+			operationPath,
+			"Invalid Timeout Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", operationPath, durationDescription, value.Value),
+		)
+		return defaultTimeout, diags
+	}
+
+	return duration, diags
+}
+
+// Context returns a copy of ctx with an operation budget of the configured
+// timeout of the operation, or defaultTimeout, as returned by Duration. The
+// budget is shared with helpers such as tfsdk.Retry, and limited to any
+// budget already in ctx, as with tfsdk.ContextWithOperationBudget. The
+// returned CancelFunc must be called once the operation completes to release
+// its resources:
+//
+//	ctx, cancel, diags := timeouts.Context(ctx, req.Plan, timeouts.Create, timeouts.DefaultTimeout)
+//	resp.Diagnostics.Append(diags...)
+//	defer cancel()
+//
+// The returned context is always usable, even when diagnostics contain
+// errors, in which case it uses defaultTimeout.
+func Context(ctx context.Context, data tfsdk.SchemaData, operation string, defaultTimeout time.Duration) (context.Context, context.CancelFunc, diag.Diagnostics) {
+	duration, diags := Duration(ctx, data, operation, defaultTimeout)
+
+	ctx, cancel := tfsdk.ContextWithOperationBudget(ctx, duration)
+
+	return ctx, cancel, diags
+}
+
+// objectAttrs returns the attributes of a known object value, or nil.
+func objectAttrs(value attr.Value) map[string]attr.Value {
+	object, ok := value.(types.Object)
+
+	if !ok || object.Null || object.Unknown {
+		return nil
+	}
+
+	return object.Attrs
+}
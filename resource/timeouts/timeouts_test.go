@@ -0,0 +1,368 @@
+package timeouts_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDuration(t *testing.T) {
+	t.Parallel()
+
+	opts := timeouts.Opts{
+		Create: true,
+		Delete: true,
+	}
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+			"delete": tftypes.String,
+		},
+	}
+	blockSchema := tfsdk.Schema{
+		Blocks: map[string]tfsdk.Block{
+			timeouts.AttributeName: timeouts.Block(context.Background(), opts),
+		},
+	}
+	blockSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"timeouts": tftypes.List{ElementType: timeoutsType},
+		},
+	}
+	attributeSchema := tfsdk.Schema{
+		Attributes: map[string]tfsdk.Attribute{
+			timeouts.AttributeName: timeouts.Attributes(context.Background(), opts),
+		},
+	}
+	attributeSchemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"timeouts": timeoutsType,
+		},
+	}
+	block := func(create interface{}) tftypes.Value {
+		return tftypes.NewValue(blockSchemaType, map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(tftypes.List{ElementType: timeoutsType}, []tftypes.Value{
+				tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+					"create": tftypes.NewValue(tftypes.String, create),
+					"delete": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+		})
+	}
+	attribute := func(create interface{}) tftypes.Value {
+		return tftypes.NewValue(attributeSchemaType, map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, create),
+				"delete": tftypes.NewValue(tftypes.String, nil),
+			}),
+		})
+	}
+
+	testCases := map[string]struct {
+		plan          tfsdk.Plan
+		operation     string
+		expected      time.Duration
+		expectedDiags diag.Diagnostics
+	}{
+		"block-configured": {
+			plan: tfsdk.Plan{
+				Raw:    block("1h"),
+				Schema: blockSchema,
+			},
+			operation: timeouts.Create,
+			expected:  time.Hour,
+		},
+		"block-missing": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(blockSchemaType, map[string]tftypes.Value{
+					"timeouts": tftypes.NewValue(tftypes.List{ElementType: timeoutsType}, []tftypes.Value{}),
+				}),
+				Schema: blockSchema,
+			},
+			operation: timeouts.Create,
+			expected:  timeouts.DefaultTimeout,
+		},
+		"block-null-operation": {
+			plan: tfsdk.Plan{
+				Raw:    block("1h"),
+				Schema: blockSchema,
+			},
+			operation: timeouts.Delete,
+			expected:  timeouts.DefaultTimeout,
+		},
+		"block-unknown-operation": {
+			plan: tfsdk.Plan{
+				Raw:    block(tftypes.UnknownValue),
+				Schema: blockSchema,
+			},
+			operation: timeouts.Create,
+			expected:  timeouts.DefaultTimeout,
+		},
+		"attribute-configured": {
+			plan: tfsdk.Plan{
+				Raw:    attribute("90s"),
+				Schema: attributeSchema,
+			},
+			operation: timeouts.Create,
+			expected:  90 * time.Second,
+		},
+		"attribute-null": {
+			plan: tfsdk.Plan{
+				Raw: tftypes.NewValue(attributeSchemaType, map[string]tftypes.Value{
+					"timeouts": tftypes.NewValue(timeoutsType, nil),
+				}),
+				Schema: attributeSchema,
+			},
+			operation: timeouts.Create,
+			expected:  timeouts.DefaultTimeout,
+		},
+		"attribute-unsupported-operation": {
+			plan: tfsdk.Plan{
+				Raw:    attribute("90s"),
+				Schema: attributeSchema,
+			},
+			operation: timeouts.Update,
+			expected:  timeouts.DefaultTimeout,
+		},
+		"attribute-invalid": {
+			plan: tfsdk.Plan{
+				Raw:    attribute("soon"),
+				Schema: attributeSchema,
+			},
+			operation: timeouts.Create,
+			expected:  timeouts.DefaultTimeout,
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Timeout Value",
+					`Attribute timeouts.create value must be a duration, such as "30s" or "2h45m", got: "soon"`,
+				),
+			},
+		},
+		"null-plan": {
+			plan: tfsdk.Plan{
+				Raw:    tftypes.NewValue(blockSchemaType, nil),
+				Schema: blockSchema,
+			},
+			operation: timeouts.Create,
+			expected:  timeouts.DefaultTimeout,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := timeouts.Duration(context.Background(), tc.plan, tc.operation, timeouts.DefaultTimeout)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if got != tc.expected {
+				t.Errorf("expected %s, got %s", tc.expected, got)
+			}
+		})
+	}
+}
+
+// testAdvancingClock is a tfsdk.Clock where After advances the current time
+// instantly.
+type testAdvancingClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testAdvancingClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testAdvancingClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+// testZeroRand is a tfsdk.Rand which always returns zero, so Retry waits
+// exactly its interval.
+type testZeroRand struct{}
+
+func (r testZeroRand) Float64() float64 {
+	return 0
+}
+
+func (r testZeroRand) Int63n(_ int64) int64 {
+	return 0
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+		},
+	}
+	plan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"timeouts": timeoutsType,
+			},
+		}, map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, "1h"),
+			}),
+		}),
+		Schema: tfsdk.Schema{
+			Attributes: map[string]tfsdk.Attribute{
+				timeouts.AttributeName: timeouts.Attributes(context.Background(), timeouts.Opts{Create: true}),
+			},
+		},
+	}
+
+	before := time.Now()
+	ctx, cancel, diags := timeouts.Context(context.Background(), plan, timeouts.Create, timeouts.DefaultTimeout)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	deadline, ok := ctx.Deadline()
+
+	if !ok {
+		t.Fatal("expected deadline")
+	}
+
+	if deadline.Before(before.Add(time.Hour)) || deadline.After(time.Now().Add(time.Hour)) {
+		t.Errorf("expected deadline in one hour, got %s", deadline)
+	}
+
+	if remaining, ok := tfsdk.OperationBudgetRemaining(ctx); !ok || remaining > time.Hour || remaining < time.Hour-time.Since(before) {
+		t.Errorf("expected operation budget of one hour, got %s", remaining)
+	}
+}
+
+func TestContext_retry(t *testing.T) {
+	t.Parallel()
+
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"create": tftypes.String,
+		},
+	}
+	plan := tfsdk.Plan{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"timeouts": timeoutsType,
+			},
+		}, map[string]tftypes.Value{
+			"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, "1h"),
+			}),
+		}),
+		Schema: tfsdk.Schema{
+			Attributes: map[string]tfsdk.Attribute{
+				timeouts.AttributeName: timeouts.Attributes(context.Background(), timeouts.Opts{Create: true}),
+			},
+		},
+	}
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &testAdvancingClock{now: start}
+	ctx := tfsdk.ContextWithRand(tfsdk.ContextWithClock(context.Background(), clock), testZeroRand{})
+
+	ctx, cancel, diags := timeouts.Context(ctx, plan, timeouts.Create, timeouts.DefaultTimeout)
+	defer cancel()
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	attempts := 0
+
+	err := tfsdk.Retry(ctx, 25*time.Minute, func(ctx context.Context) (bool, error) {
+		attempts++
+
+		return true, errors.New("not ready")
+	})
+
+	if !errors.Is(err, tfsdk.ErrOperationBudgetExceeded) {
+		t.Fatalf("expected operation budget exceeded error, got: %v", err)
+	}
+
+	// Attempts at 0, 25m, 50m and 1h, when the budget is exhausted.
+	if attempts != 4 {
+		t.Errorf("expected 4 attempts, got %d", attempts)
+	}
+
+	if elapsed := clock.Now().Sub(start); elapsed != time.Hour {
+		t.Errorf("expected one hour to elapse, got %s", elapsed)
+	}
+}
+
+func TestDurationValidator(t *testing.T) {
+	t.Parallel()
+
+	validator := timeouts.Attributes(context.Background(), timeouts.Opts{Create: true}).Attributes.GetAttributes()["create"].Validators[0]
+
+	testCases := map[string]struct {
+		value         string
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			value: "2h45m",
+		},
+		"invalid": {
+			value: "2 hours",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("timeouts").AtName("create"),
+					"Invalid Timeout Value",
+					`Attribute timeouts.create value must be a duration, such as "30s" or "2h45m", got: "2 hours"`,
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path.Root("timeouts").AtName("create"),
+				AttributeConfig: types.String{Value: tc.value},
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
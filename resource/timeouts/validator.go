@@ -0,0 +1,45 @@
+package timeouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ tfsdk.AttributeValidator = durationValidator{}
+
+// durationDescription describes a valid timeout value.
+const durationDescription = `value must be a duration, such as "30s" or "2h45m"`
+
+// durationValidator ensures that a configured string is a valid duration.
+type durationValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v durationValidator) Description(_ context.Context) string {
+	return durationDescription
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v durationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v durationValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := req.AttributeConfig.(types.String)
+
+	if !ok || s.Null || s.Unknown {
+		return
+	}
+
+	if _, err := time.ParseDuration(s.Value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.AttributePath,
+			"Invalid Timeout Value",
+			fmt.Sprintf("Attribute %s %s, got: %q", req.AttributePath, v.Description(ctx), s.Value),
+		)
+	}
+}
@@ -0,0 +1,144 @@
+package tfsdk
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// checkApplyConsistency returns an error diagnostic for each path where the
+// new state of a created or updated resource differs from a known planned
+// value, except beneath the exempt paths, and for each unknown value
+// remaining in the new state. Terraform rejects such results with errors
+// which do not identify the provider bug, so these diagnostics are returned
+// instead.
+func checkApplyConsistency(ctx context.Context, resource Resource, typeName string, planned, newState tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if planned.IsNull() || newState.IsNull() {
+		return diags
+	}
+
+	var exempt []*tftypes.AttributePath
+
+	if r, ok := resource.(ResourceWithInconsistentApply); ok {
+		for _, p := range r.InconsistentApplyPaths(ctx) {
+			exempt = append(exempt, totftypes.AttributePath(p))
+		}
+	}
+
+	var inconsistent []*tftypes.AttributePath
+
+	err := diffValues(tftypes.NewAttributePath(), planned, newState, func(path *tftypes.AttributePath, _ tftypes.Value) {
+		if attributePathHasPrefix(path, exempt) {
+			return
+		}
+
+		rawPlannedValue, _, err := tftypes.WalkAttributePath(planned, path)
+
+		if err == nil {
+			// Unknown planned values, including set elements, may be
+			// set to any value.
+			if plannedValue, ok := rawPlannedValue.(tftypes.Value); ok && !plannedValue.IsFullyKnown() {
+				return
+			}
+		}
+
+		inconsistent = append(inconsistent, path)
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Apply Consistency Check Error",
+			"An unexpected error was encountered trying to compare the planned and new state of the "+typeName+" resource. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	var unknown []*tftypes.AttributePath
+
+	err = tftypes.Walk(newState, func(path *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		if !value.IsKnown() {
+			unknown = append(unknown, path)
+
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Apply Consistency Check Error",
+			"An unexpected error was encountered trying to check the new state of the "+typeName+" resource for unknown values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return diags
+	}
+
+	sortAttributePaths(inconsistent)
+	sortAttributePaths(unknown)
+
+	for _, path := range inconsistent {
+		diags.AddAttributeError(
+			fromtftypes.AttributePath(path),
+			"Provider Produced Inconsistent Result After Apply",
+			"When applying changes to the "+typeName+" resource, the provider set a value which differs from the planned value. "+
+				"Known planned values must be returned unchanged, otherwise the value must be unknown in the plan, such as by marking the attribute Computed and leaving it unknown during ModifyPlan.\n\n"+
+				"This is always an error in the provider. Please report this to the provider developer.",
+		)
+	}
+
+	for _, path := range unknown {
+		diags.AddAttributeError(
+			fromtftypes.AttributePath(path),
+			"Provider Returned Unknown Value After Apply",
+			"When applying changes to the "+typeName+" resource, the provider left a value unknown in the new state. Every value must be known after apply.\n\n"+
+				"This is always an error in the provider. Please report this to the provider developer.",
+		)
+	}
+
+	return diags
+}
+
+// attributePathHasPrefix returns true if path is, or is beneath, any of the
+// prefixes.
+func attributePathHasPrefix(path *tftypes.AttributePath, prefixes []*tftypes.AttributePath) bool {
+	steps := path.Steps()
+
+	for _, prefix := range prefixes {
+		prefixSteps := prefix.Steps()
+
+		if len(prefixSteps) > len(steps) {
+			continue
+		}
+
+		matches := true
+
+		for idx, step := range prefixSteps {
+			if !step.Equal(steps[idx]) {
+				matches = false
+
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortAttributePaths sorts the paths in lexical order.
+func sortAttributePaths(paths []*tftypes.AttributePath) {
+	sort.Slice(paths, func(i, j int) bool {
+		return paths[i].String() < paths[j].String()
+	})
+}
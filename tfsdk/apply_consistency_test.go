@@ -0,0 +1,132 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testInconsistentApplyResource struct {
+	testResourceWithMetadata
+
+	paths path.Paths
+}
+
+func (r testInconsistentApplyResource) InconsistentApplyPaths(_ context.Context) path.Paths {
+	return r.paths
+}
+
+func TestCheckApplyConsistency(t *testing.T) {
+	t.Parallel()
+
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+	state := func(id tftypes.Value, tags ...tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"id":   id,
+			"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tags),
+		})
+	}
+	inconsistentDiag := func(p path.Path) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			p,
+			"Provider Produced Inconsistent Result After Apply",
+			"When applying changes to the test_one resource, the provider set a value which differs from the planned value. "+
+				"Known planned values must be returned unchanged, otherwise the value must be unknown in the plan, such as by marking the attribute Computed and leaving it unknown during ModifyPlan.\n\n"+
+				"This is always an error in the provider. Please report this to the provider developer.",
+		)
+	}
+	unknownDiag := func(p path.Path) diag.Diagnostic {
+		return diag.NewAttributeErrorDiagnostic(
+			p,
+			"Provider Returned Unknown Value After Apply",
+			"When applying changes to the test_one resource, the provider left a value unknown in the new state. Every value must be known after apply.\n\n"+
+				"This is always an error in the provider. Please report this to the provider developer.",
+		)
+	}
+	resource := testResourceWithMetadata{typeName: "test_one"}
+
+	testCases := map[string]struct {
+		resource      Resource
+		planned       tftypes.Value
+		newState      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"consistent": {
+			resource: resource,
+			planned:  state(tftypes.NewValue(tftypes.String, "one"), tftypes.NewValue(tftypes.String, "a")),
+			newState: state(tftypes.NewValue(tftypes.String, "one"), tftypes.NewValue(tftypes.String, "a")),
+		},
+		"null": {
+			resource: resource,
+			planned:  tftypes.NewValue(stateType, nil),
+			newState: state(tftypes.NewValue(tftypes.String, "one")),
+		},
+		"unknown-planned": {
+			resource: resource,
+			planned:  state(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, "a")),
+			newState: state(tftypes.NewValue(tftypes.String, "one"), tftypes.NewValue(tftypes.String, "a")),
+		},
+		"changed": {
+			resource: resource,
+			planned:  state(tftypes.NewValue(tftypes.String, "one"), tftypes.NewValue(tftypes.String, "a"), tftypes.NewValue(tftypes.String, "b")),
+			newState: state(tftypes.NewValue(tftypes.String, "two"), tftypes.NewValue(tftypes.String, "a"), tftypes.NewValue(tftypes.String, "c")),
+			expectedDiags: diag.Diagnostics{
+				inconsistentDiag(path.Root("id")),
+				inconsistentDiag(path.Root("tags").AtListIndex(1)),
+			},
+		},
+		"unknown-new-state": {
+			resource: resource,
+			planned:  state(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			newState: state(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			expectedDiags: diag.Diagnostics{
+				unknownDiag(path.Root("id")),
+			},
+		},
+		"exempt-path": {
+			resource: testInconsistentApplyResource{
+				testResourceWithMetadata: resource,
+				paths:                    path.Paths{path.Root("tags")},
+			},
+			planned:  state(tftypes.NewValue(tftypes.String, "one"), tftypes.NewValue(tftypes.String, "a")),
+			newState: state(tftypes.NewValue(tftypes.String, "two"), tftypes.NewValue(tftypes.String, "b")),
+			expectedDiags: diag.Diagnostics{
+				inconsistentDiag(path.Root("id")),
+			},
+		},
+		"exempt-all": {
+			resource: testInconsistentApplyResource{
+				testResourceWithMetadata: resource,
+				paths:                    path.Paths{path.Empty()},
+			},
+			planned:  state(tftypes.NewValue(tftypes.String, "one"), tftypes.NewValue(tftypes.String, "a")),
+			newState: state(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, "b")),
+			expectedDiags: diag.Diagnostics{
+				unknownDiag(path.Root("id")),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := checkApplyConsistency(context.Background(), tc.resource, "test_one", tc.planned, tc.newState)
+
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
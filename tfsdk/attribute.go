@@ -70,6 +70,36 @@ type Attribute struct {
 	// instructing them on what upgrade steps to take.
 	DeprecationMessage string
 
+	// PreserveState indicates the value is only obtainable when the
+	// resource is created or imported, such as an initial password, and
+	// must be kept unchanged afterwards. When the prior state value is not
+	// null, the framework restores it after the resource Read method and
+	// uses it as the planned value when updating, so the value is never
+	// refreshed or diffed. PreserveState must be used with Computed.
+	//
+	// PreserveState is only supported for attributes which are not nested
+	// inside a list, map, or set, and only applies to resources. Schemas
+	// setting it beneath list, map, or set nested attributes or blocks
+	// are rejected.
+	PreserveState bool
+
+	// Codec converts the value between the form used by the provider and
+	// the form saved in state, such as GzipBase64Codec for large documents.
+	// The framework encodes values sent to Terraform and decodes values
+	// received from Terraform, so the resource methods only see the
+	// original value. Codec must be used with a string Type and Computed,
+	// without Required or Optional, since Terraform compares configured
+	// values with the stored form, and only applies to resources.
+	//
+	// The stored form is not human-readable. It is shown in plan output,
+	// terraform show, and to other tools reading the state, such as the
+	// terraform_remote_state data source. Adding or removing a Codec on an
+	// existing attribute changes the stored form, which requires a schema
+	// Version increment and a state upgrader. The stored form of
+	// GzipBase64Codec may also change when the provider is built with a
+	// different Go toolchain.
+	Codec AttributeCodec
+
 	// Validators defines validation functionality for the attribute.
 	Validators []AttributeValidator
 
@@ -139,6 +169,9 @@ func (a Attribute) Equal(o Attribute) bool {
 	if a.DeprecationMessage != o.DeprecationMessage {
 		return false
 	}
+	if a.PreserveState != o.PreserveState {
+		return false
+	}
 	return true
 }
 
@@ -294,6 +327,10 @@ func (a Attribute) validate(ctx context.Context, req ValidateAttributeRequest, r
 
 	req.AttributeConfig = attributeConfig
 
+	if a.Sensitive {
+		req.AttributeSensitive = true
+	}
+
 	for _, validator := range a.Validators {
 		validator.Validate(ctx, req, resp)
 	}
@@ -347,8 +384,9 @@ func (a Attribute) validateAttributes(ctx context.Context, req ValidateAttribute
 		for idx := range l.Elems {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(nestedName),
-					Config:        req.Config,
+					AttributePath:      req.AttributePath.AtListIndex(idx).AtName(nestedName),
+					Config:             req.Config,
+					AttributeSensitive: req.AttributeSensitive,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -388,8 +426,9 @@ func (a Attribute) validateAttributes(ctx context.Context, req ValidateAttribute
 
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(nestedName),
-					Config:        req.Config,
+					AttributePath:      req.AttributePath.AtSetValue(tfValue).AtName(nestedName),
+					Config:             req.Config,
+					AttributeSensitive: req.AttributeSensitive,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -417,8 +456,9 @@ func (a Attribute) validateAttributes(ctx context.Context, req ValidateAttribute
 		for key := range m.Elems {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyString(key).WithAttributeName(nestedName),
-					Config:        req.Config,
+					AttributePath:      req.AttributePath.AtMapKey(key).AtName(nestedName),
+					Config:             req.Config,
+					AttributeSensitive: req.AttributeSensitive,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -446,8 +486,9 @@ func (a Attribute) validateAttributes(ctx context.Context, req ValidateAttribute
 		if !o.Null && !o.Unknown {
 			for nestedName, nestedAttr := range a.Attributes.GetAttributes() {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithAttributeName(nestedName),
-					Config:        req.Config,
+					AttributePath:      req.AttributePath.AtName(nestedName),
+					Config:             req.Config,
+					AttributeSensitive: req.AttributeSensitive,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
 					Diagnostics: resp.Diagnostics,
@@ -552,10 +593,11 @@ func (a Attribute) modifyPlan(ctx context.Context, req ModifyAttributePlanReques
 		for idx := range l.Elems {
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -591,10 +633,11 @@ func (a Attribute) modifyPlan(ctx context.Context, req ModifyAttributePlanReques
 
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -618,10 +661,11 @@ func (a Attribute) modifyPlan(ctx context.Context, req ModifyAttributePlanReques
 		for key := range m.Elems {
 			for name, attr := range a.Attributes.GetAttributes() {
 				attrReq := ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyString(key).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtMapKey(key).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -648,10 +692,11 @@ func (a Attribute) modifyPlan(ctx context.Context, req ModifyAttributePlanReques
 
 		for name, attr := range a.Attributes.GetAttributes() {
 			attrReq := ModifyAttributePlanRequest{
-				AttributePath: req.AttributePath.WithAttributeName(name),
+				AttributePath: req.AttributePath.AtName(name),
 				Config:        req.Config,
 				Plan:          resp.Plan,
 				ProviderMeta:  req.ProviderMeta,
+				ProviderData:  req.ProviderData,
 				State:         req.State,
 			}
 
@@ -0,0 +1,104 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+)
+
+// AttributeBounds is structured metadata about the values an attribute
+// allows, such as a minimum number, for tooling which consumes schema
+// constraints programmatically. It is informational only, validation is
+// still performed by the attribute validators. Unset fields are nil.
+type AttributeBounds struct {
+	// MinValue is the inclusive minimum of a number value.
+	MinValue *big.Float `json:"min_value,omitempty"`
+
+	// MaxValue is the inclusive maximum of a number value.
+	MaxValue *big.Float `json:"max_value,omitempty"`
+
+	// MinLength is the inclusive minimum byte length of a string value.
+	MinLength *int64 `json:"min_length,omitempty"`
+
+	// MaxLength is the inclusive maximum byte length of a string value.
+	MaxLength *int64 `json:"max_length,omitempty"`
+
+	// MinItems is the inclusive minimum number of elements of a list, map,
+	// or set value.
+	MinItems *int64 `json:"min_items,omitempty"`
+
+	// MaxItems is the inclusive maximum number of elements of a list, map,
+	// or set value.
+	MaxItems *int64 `json:"max_items,omitempty"`
+}
+
+// AttributeValidatorWithBounds is an AttributeValidator which also describes
+// the bounds it enforces as structured metadata.
+type AttributeValidatorWithBounds interface {
+	AttributeValidator
+
+	// Bounds returns the bounds enforced by the validator.
+	Bounds(context.Context) AttributeBounds
+}
+
+// IsEmpty returns true if no bounds are set.
+func (b AttributeBounds) IsEmpty() bool {
+	return b == AttributeBounds{}
+}
+
+// Bounds returns the combined bounds of the attribute Validators which
+// implement AttributeValidatorWithBounds. When multiple validators set the
+// same bound, the most restrictive value is returned.
+func (a Attribute) Bounds(ctx context.Context) AttributeBounds {
+	var result AttributeBounds
+
+	for _, validator := range a.Validators {
+		validatorWithBounds, ok := validator.(AttributeValidatorWithBounds)
+
+		if !ok {
+			continue
+		}
+
+		bounds := validatorWithBounds.Bounds(ctx)
+
+		result.MinValue = maxBigFloat(result.MinValue, bounds.MinValue)
+		result.MaxValue = minBigFloat(result.MaxValue, bounds.MaxValue)
+		result.MinLength = maxInt64(result.MinLength, bounds.MinLength)
+		result.MaxLength = minInt64(result.MaxLength, bounds.MaxLength)
+		result.MinItems = maxInt64(result.MinItems, bounds.MinItems)
+		result.MaxItems = minInt64(result.MaxItems, bounds.MaxItems)
+	}
+
+	return result
+}
+
+func maxBigFloat(a, b *big.Float) *big.Float {
+	if a == nil || (b != nil && b.Cmp(a) > 0) {
+		return b
+	}
+
+	return a
+}
+
+func minBigFloat(a, b *big.Float) *big.Float {
+	if a == nil || (b != nil && b.Cmp(a) < 0) {
+		return b
+	}
+
+	return a
+}
+
+func maxInt64(a, b *int64) *int64 {
+	if a == nil || (b != nil && *b > *a) {
+		return b
+	}
+
+	return a
+}
+
+func minInt64(a, b *int64) *int64 {
+	if a == nil || (b != nil && *b < *a) {
+		return b
+	}
+
+	return a
+}
@@ -0,0 +1,134 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testBoundsValidator struct {
+	bounds AttributeBounds
+}
+
+func (v testBoundsValidator) Description(_ context.Context) string {
+	return "test bounds validator"
+}
+
+func (v testBoundsValidator) MarkdownDescription(_ context.Context) string {
+	return "test bounds validator"
+}
+
+func (v testBoundsValidator) Validate(_ context.Context, _ ValidateAttributeRequest, _ *ValidateAttributeResponse) {
+}
+
+func (v testBoundsValidator) Bounds(_ context.Context) AttributeBounds {
+	return v.bounds
+}
+
+func TestAttributeBounds(t *testing.T) {
+	t.Parallel()
+
+	int64Pointer := func(value int64) *int64 {
+		return &value
+	}
+
+	testCases := map[string]struct {
+		attribute Attribute
+		expected  AttributeBounds
+	}{
+		"no-validators": {
+			attribute: Attribute{
+				Type:     types.Int64Type,
+				Optional: true,
+			},
+			expected: AttributeBounds{},
+		},
+		"validator-without-bounds": {
+			attribute: Attribute{
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []AttributeValidator{
+					testWarningAttributeValidator{},
+				},
+			},
+			expected: AttributeBounds{},
+		},
+		"single": {
+			attribute: Attribute{
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []AttributeValidator{
+					testBoundsValidator{
+						bounds: AttributeBounds{
+							MinValue: big.NewFloat(1),
+						},
+					},
+				},
+			},
+			expected: AttributeBounds{
+				MinValue: big.NewFloat(1),
+			},
+		},
+		"most-restrictive": {
+			attribute: Attribute{
+				Type:     types.Int64Type,
+				Optional: true,
+				Validators: []AttributeValidator{
+					testBoundsValidator{
+						bounds: AttributeBounds{
+							MinValue:  big.NewFloat(1),
+							MaxValue:  big.NewFloat(10),
+							MinLength: int64Pointer(1),
+							MaxItems:  int64Pointer(5),
+						},
+					},
+					testBoundsValidator{
+						bounds: AttributeBounds{
+							MinValue:  big.NewFloat(2),
+							MaxValue:  big.NewFloat(20),
+							MinLength: int64Pointer(0),
+							MaxLength: int64Pointer(3),
+							MinItems:  int64Pointer(1),
+							MaxItems:  int64Pointer(4),
+						},
+					},
+				},
+			},
+			expected: AttributeBounds{
+				MinValue:  big.NewFloat(2),
+				MaxValue:  big.NewFloat(10),
+				MinLength: int64Pointer(1),
+				MaxLength: int64Pointer(3),
+				MinItems:  int64Pointer(1),
+				MaxItems:  int64Pointer(4),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.attribute.Bounds(context.Background())
+
+			if diff := cmp.Diff(got, tc.expected, cmp.Comparer(func(a, b *big.Float) bool {
+				if a == nil || b == nil {
+					return a == b
+				}
+
+				return a.Cmp(b) == 0
+			})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if got.IsEmpty() != tc.expected.IsEmpty() {
+				t.Errorf("expected IsEmpty %t, got %t", tc.expected.IsEmpty(), got.IsEmpty())
+			}
+		})
+	}
+}
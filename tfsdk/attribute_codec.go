@@ -0,0 +1,171 @@
+package tfsdk
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributeCodec converts string attribute values between the form used by
+// the provider and the form saved in state. See the Attribute Codec
+// documentation for the interoperability considerations.
+type AttributeCodec interface {
+	// Encode returns the stored form of a value. It must always return the
+	// same stored form for the same value, otherwise Terraform will report
+	// differences between the prior state and the plan.
+	Encode(context.Context, string) (string, error)
+
+	// Decode returns the value from its stored form.
+	Decode(context.Context, string) (string, error)
+}
+
+// GzipBase64Codec returns an AttributeCodec which stores values as gzip
+// compressed, base64 encoded strings.
+//
+// The compressed bytes depend on the compress/flate implementation of the Go
+// toolchain used to build the provider, which is not guaranteed to produce
+// the same output across Go releases. A provider built with a different
+// toolchain may encode an unchanged value differently than the prior state,
+// which Terraform shows as an update to the attribute. Decoding is not
+// affected, so existing state remains readable.
+func GzipBase64Codec() AttributeCodec {
+	return gzipBase64Codec{}
+}
+
+type gzipBase64Codec struct{}
+
+// Encode returns the gzip compressed, base64 encoded value. The gzip header
+// is left empty so the result only depends on the value.
+func (c gzipBase64Codec) Encode(_ context.Context, value string) (string, error) {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+
+	if _, err := w.Write([]byte(value)); err != nil {
+		return "", fmt.Errorf("error compressing value: %w", err)
+	}
+
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("error compressing value: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Decode returns the value of a gzip compressed, base64 encoded string.
+func (c gzipBase64Codec) Decode(_ context.Context, stored string) (string, error) {
+	compressed, err := base64.StdEncoding.DecodeString(stored)
+
+	if err != nil {
+		return "", fmt.Errorf("error decoding base64 value: %w", err)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(compressed))
+
+	if err != nil {
+		return "", fmt.Errorf("error decompressing value: %w", err)
+	}
+
+	value, err := ioutil.ReadAll(r)
+
+	if err != nil {
+		return "", fmt.Errorf("error decompressing value: %w", err)
+	}
+
+	if err := r.Close(); err != nil {
+		return "", fmt.Errorf("error decompressing value: %w", err)
+	}
+
+	return string(value), nil
+}
+
+// encodeAttributeValues returns the value with the values of attributes
+// which have a Codec converted to their stored form.
+func encodeAttributeValues(ctx context.Context, schema Schema, val tftypes.Value) (tftypes.Value, error) {
+	if val.IsNull() || !definesAttributeCodecs(schema.Attributes, schema.Blocks) {
+		return val, nil
+	}
+
+	return tftypes.Transform(val, attributeCodecTransform(ctx, schema, AttributeCodec.Encode))
+}
+
+// decodeAttributeValues returns the value with the values of attributes
+// which have a Codec converted from their stored form.
+func decodeAttributeValues(ctx context.Context, schema Schema, val tftypes.Value) (tftypes.Value, error) {
+	if val.IsNull() || !definesAttributeCodecs(schema.Attributes, schema.Blocks) {
+		return val, nil
+	}
+
+	return tftypes.Transform(val, attributeCodecTransform(ctx, schema, AttributeCodec.Decode))
+}
+
+// attributeCodecTransform returns a tftypes.Transform function which calls
+// convert on the known, non-null values of attributes which have a Codec.
+func attributeCodecTransform(ctx context.Context, schema Schema, convert func(AttributeCodec, context.Context, string) (string, error)) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		// we are only modifying attributes, not the entire resource
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+
+		if !val.IsKnown() || val.IsNull() {
+			return val, nil
+		}
+
+		attribute, err := schema.attributeAtTerraformPath(path)
+
+		if err != nil {
+			if errors.Is(err, ErrPathInsideAtomicAttribute) || errors.Is(err, ErrPathIsBlock) {
+				return val, nil
+			}
+
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in schema: %w", err)
+		}
+
+		if attribute.Codec == nil {
+			return val, nil
+		}
+
+		var value string
+
+		if err := val.As(&value); err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		converted, err := convert(attribute.Codec, ctx, value)
+
+		if err != nil {
+			return tftypes.Value{}, path.NewError(err)
+		}
+
+		return tftypes.NewValue(val.Type(), converted), nil
+	}
+}
+
+// definesAttributeCodecs returns true if any of the attributes, including
+// nested attributes and attributes in blocks, has a Codec.
+func definesAttributeCodecs(attributes map[string]Attribute, blocks map[string]Block) bool {
+	for _, attribute := range attributes {
+		if attribute.Codec != nil {
+			return true
+		}
+
+		if attribute.definesAttributes() && definesAttributeCodecs(attribute.Attributes.GetAttributes(), nil) {
+			return true
+		}
+	}
+
+	for _, block := range blocks {
+		if definesAttributeCodecs(block.Attributes, block.Blocks) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,250 @@
+package tfsdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGzipBase64Codec(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value string
+	}{
+		"empty": {
+			value: "",
+		},
+		"document": {
+			value: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"*","Resource":"*"}]}`,
+		},
+		"large": {
+			value: strings.Repeat("rendered template line\n", 10000),
+		},
+		"unicode": {
+			value: "héllo wörld ✓",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			codec := GzipBase64Codec()
+
+			stored, err := codec.Encode(ctx, tc.value)
+
+			if err != nil {
+				t.Fatalf("unexpected encode error: %s", err)
+			}
+
+			storedAgain, err := codec.Encode(ctx, tc.value)
+
+			if err != nil {
+				t.Fatalf("unexpected encode error: %s", err)
+			}
+
+			if stored != storedAgain {
+				t.Errorf("expected deterministic stored form, got %q and %q", stored, storedAgain)
+			}
+
+			got, err := codec.Decode(ctx, stored)
+
+			if err != nil {
+				t.Fatalf("unexpected decode error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, tc.value); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGzipBase64CodecDecodeInvalid(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stored string
+	}{
+		"not-base64": {
+			stored: "not base64!",
+		},
+		"not-gzip": {
+			stored: "bm90IGd6aXA=",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := GzipBase64Codec().Decode(context.Background(), tc.stored)
+
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestAttributeValuesCodec(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"document": {
+				Type:     types.StringType,
+				Computed: true,
+				Codec:    GzipBase64Codec(),
+			},
+			"list": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"document": {
+						Type:     types.StringType,
+						Computed: true,
+						Codec:    GzipBase64Codec(),
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+		Blocks: map[string]Block{
+			"block": {
+				Attributes: map[string]Attribute{
+					"document": {
+						Type:     types.StringType,
+						Computed: true,
+						Codec:    GzipBase64Codec(),
+					},
+				},
+				NestingMode: BlockNestingModeList,
+			},
+		},
+	}
+	schemaType := schema.TerraformType(ctx)
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"document": tftypes.String,
+		},
+	}
+	listType := tftypes.List{ElementType: nestedType}
+
+	newValue := func(name, document, listDocument, blockDocument interface{}) tftypes.Value {
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"document": tftypes.NewValue(tftypes.String, document),
+			"list": tftypes.NewValue(listType, []tftypes.Value{
+				tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"document": tftypes.NewValue(tftypes.String, listDocument),
+				}),
+			}),
+			"block": tftypes.NewValue(listType, []tftypes.Value{
+				tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"document": tftypes.NewValue(tftypes.String, blockDocument),
+				}),
+			}),
+		})
+	}
+
+	encode := func(value string) string {
+		stored, err := GzipBase64Codec().Encode(ctx, value)
+
+		if err != nil {
+			t.Fatalf("unexpected encode error: %s", err)
+		}
+
+		return stored
+	}
+
+	testCases := map[string]struct {
+		value  tftypes.Value
+		stored tftypes.Value
+	}{
+		"known": {
+			value:  newValue("name", "document", "list-document", "block-document"),
+			stored: newValue("name", encode("document"), encode("list-document"), encode("block-document")),
+		},
+		"null": {
+			value:  newValue("name", nil, nil, nil),
+			stored: newValue("name", nil, nil, nil),
+		},
+		"unknown": {
+			value:  newValue("name", tftypes.UnknownValue, tftypes.UnknownValue, tftypes.UnknownValue),
+			stored: newValue("name", tftypes.UnknownValue, tftypes.UnknownValue, tftypes.UnknownValue),
+		},
+		"resource-null": {
+			value:  tftypes.NewValue(schemaType, nil),
+			stored: tftypes.NewValue(schemaType, nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			encoded, err := encodeAttributeValues(context.Background(), schema, tc.value)
+
+			if err != nil {
+				t.Fatalf("unexpected encode error: %s", err)
+			}
+
+			if diff := cmp.Diff(encoded, tc.stored); diff != "" {
+				t.Errorf("unexpected encode difference: %s", diff)
+			}
+
+			decoded, err := decodeAttributeValues(context.Background(), schema, encoded)
+
+			if err != nil {
+				t.Fatalf("unexpected decode error: %s", err)
+			}
+
+			if diff := cmp.Diff(decoded, tc.value); diff != "" {
+				t.Errorf("unexpected decode difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeValuesCodecNoCodecs(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"document": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}
+	value := tftypes.NewValue(schema.TerraformType(ctx), map[string]tftypes.Value{
+		"document": tftypes.NewValue(tftypes.String, "not encoded"),
+	})
+
+	got, err := decodeAttributeValues(ctx, schema, value)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(got, value); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
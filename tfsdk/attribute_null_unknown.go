@@ -0,0 +1,74 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// attributeNullOrUnknown returns whether the value at the path of raw is
+// null and whether it is unknown, without converting it into an attr.Value.
+// A value which cannot be reached, such as an attribute of a null object or
+// a list index beyond its length, takes on the null or unknown status of the
+// nearest ancestor value.
+//
+// The summary and dataName describe the raw data in diagnostics, such as
+// "State Read Error" and "state".
+func attributeNullOrUnknown(_ context.Context, schema Schema, raw tftypes.Value, attributePath path.Path, summary string, dataName string) (bool, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if _, err := schema.AttributeTypeAtPath(attributePath); err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			summary,
+			"An unexpected error was encountered trying to read an attribute from the "+dataName+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return false, false, diags
+	}
+
+	for currentPath := attributePath; ; currentPath = currentPath.ParentPath() {
+		rawValue, _, err := tftypes.WalkAttributePath(raw, totftypes.AttributePath(currentPath))
+
+		if err != nil && !errors.Is(err, tftypes.ErrInvalidStep) {
+			diags.AddAttributeError(
+				attributePath,
+				summary,
+				"An unexpected error was encountered trying to read an attribute from the "+dataName+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return false, false, diags
+		}
+
+		if err != nil {
+			if len(currentPath.Steps()) == 0 {
+				return true, false, diags
+			}
+
+			continue
+		}
+
+		value, ok := rawValue.(tftypes.Value)
+
+		if !ok {
+			diags.AddAttributeError(
+				attributePath,
+				summary,
+				"An unexpected error was encountered trying to read an attribute from the "+dataName+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("got non-tftypes.Value result %v", rawValue),
+			)
+			return false, false, diags
+		}
+
+		if !value.IsKnown() {
+			return false, true, diags
+		}
+
+		// The value itself, or an unreachable value beneath a known ancestor,
+		// such as an attribute of a null object.
+		return value.IsNull() || !currentPath.Equal(attributePath), false, diags
+	}
+}
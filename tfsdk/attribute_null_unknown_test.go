@@ -0,0 +1,174 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAttributeNullOrUnknown(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"known":   tftypes.String,
+			"list":    tftypes.List{ElementType: nestedType},
+			"null":    tftypes.String,
+			"nested":  nestedType,
+			"unknown": tftypes.String,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"known": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"list": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"null": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+			"unknown": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+	raw := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"known": tftypes.NewValue(tftypes.String, "test"),
+		"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "first"),
+			}),
+			tftypes.NewValue(nestedType, tftypes.UnknownValue),
+		}),
+		"null":    tftypes.NewValue(tftypes.String, nil),
+		"nested":  tftypes.NewValue(nestedType, nil),
+		"unknown": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	testCases := map[string]struct {
+		raw             tftypes.Value
+		path            path.Path
+		expectedNull    bool
+		expectedUnknown bool
+		expectedDiags   diag.Diagnostics
+	}{
+		"known": {
+			raw:  raw,
+			path: path.Root("known"),
+		},
+		"null": {
+			raw:          raw,
+			path:         path.Root("null"),
+			expectedNull: true,
+		},
+		"unknown": {
+			raw:             raw,
+			path:            path.Root("unknown"),
+			expectedUnknown: true,
+		},
+		"null-parent": {
+			raw:          raw,
+			path:         path.Root("nested").AtName("name"),
+			expectedNull: true,
+		},
+		"unknown-parent": {
+			raw:             raw,
+			path:            path.Root("list").AtListIndex(1).AtName("name"),
+			expectedUnknown: true,
+		},
+		"list-element": {
+			raw:  raw,
+			path: path.Root("list").AtListIndex(0).AtName("name"),
+		},
+		"list-element-missing": {
+			raw:          raw,
+			path:         path.Root("list").AtListIndex(2).AtName("name"),
+			expectedNull: true,
+		},
+		"null-root": {
+			raw:          tftypes.NewValue(schemaType, nil),
+			path:         path.Root("known"),
+			expectedNull: true,
+		},
+		"unknown-root": {
+			raw:             tftypes.NewValue(schemaType, tftypes.UnknownValue),
+			path:            path.Root("known"),
+			expectedUnknown: true,
+		},
+		"invalid-path": {
+			raw:  raw,
+			path: path.Root("missing"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"Configuration Read Error",
+					"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := Config{
+				Raw:    tc.raw,
+				Schema: schema,
+			}
+
+			gotNull, diags := config.AttributeIsNull(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if gotNull != tc.expectedNull {
+				t.Errorf("expected null %t, got %t", tc.expectedNull, gotNull)
+			}
+
+			gotUnknown, diags := config.AttributeIsUnknown(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if gotUnknown != tc.expectedUnknown {
+				t.Errorf("expected unknown %t, got %t", tc.expectedUnknown, gotUnknown)
+			}
+		})
+	}
+}
@@ -4,10 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
@@ -214,7 +215,7 @@ func RequiresReplaceIf(f RequiresReplaceIfFunc, description, markdownDescription
 
 // RequiresReplaceIfFunc is a conditional function used in the RequiresReplaceIf
 // plan modifier to determine whether the attribute requires replacement.
-type RequiresReplaceIfFunc func(ctx context.Context, state, config attr.Value, path *tftypes.AttributePath) (bool, diag.Diagnostics)
+type RequiresReplaceIfFunc func(ctx context.Context, state, config attr.Value, path path.Path) (bool, diag.Diagnostics)
 
 // RequiresReplaceIfModifier is an AttributePlanModifier that sets RequiresReplace
 // on the attribute if the conditional function returns true.
@@ -402,13 +403,169 @@ func (r UseStateForUnknownModifier) MarkdownDescription(ctx context.Context) str
 	return "Once set, the value of this attribute in state will not change."
 }
 
+// DefaultFunc returns the default value for an attribute. The value must be
+// of the attribute type. The request includes the ProviderData, so defaults
+// can be read from external sources, such as local files or a configuration
+// service, using provider data. Wrap the function with
+// CachedDefaultFunc to only resolve the value once.
+type DefaultFunc func(ctx context.Context, req ModifyAttributePlanRequest) (attr.Value, diag.Diagnostics)
+
+// DefaultFromFunc returns an AttributePlanModifier which sets the planned
+// value of a Computed attribute to the value returned by `f` when the
+// attribute is not configured. The description and markdownDescription
+// should describe the source of the default value.
+func DefaultFromFunc(f DefaultFunc, description, markdownDescription string) AttributePlanModifier {
+	return DefaultFromFuncModifier{
+		f:                   f,
+		description:         description,
+		markdownDescription: markdownDescription,
+	}
+}
+
+// DefaultFromFuncModifier is an AttributePlanModifier that sets the planned
+// value of an unconfigured attribute to the value returned by its DefaultFunc.
+type DefaultFromFuncModifier struct {
+	f                   DefaultFunc
+	description         string
+	markdownDescription string
+}
+
+// Modify sets the attribute's plan to the value returned by `f` if the
+// resource is not being destroyed and the attribute's config is null.
+func (r DefaultFromFuncModifier) Modify(ctx context.Context, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	if req.AttributeConfig == nil || resp.AttributePlan == nil {
+		// shouldn't happen, but let's not panic if it does
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, there is nothing to default
+		return
+	}
+
+	configRaw, err := req.AttributeConfig.ToTerraformValue(ctx)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.AttributePath,
+			"Error converting config value",
+			fmt.Sprintf("An unexpected error was encountered converting a %s to its equivalent Terraform representation. This is always a bug in the provider.\n\nError: %s", req.AttributeConfig.Type(ctx), err),
+		)
+		return
+	}
+
+	// only unconfigured attributes receive a default
+	if !configRaw.IsNull() {
+		return
+	}
+
+	val, diags := r.f(ctx, req)
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() || val == nil {
+		return
+	}
+
+	tfsdklog.Debug(ctx, "setting attribute plan to default value", "path", req.AttributePath)
+	resp.AttributePlan = val
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (r DefaultFromFuncModifier) Description(ctx context.Context) string {
+	return r.description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (r DefaultFromFuncModifier) MarkdownDescription(ctx context.Context) string {
+	return r.markdownDescription
+}
+
+// ConfigDefaultFunc returns the default value for an attribute derived from
+// the configured value of another attribute, which may be null. The returned
+// value must be of the attribute type.
+type ConfigDefaultFunc func(ctx context.Context, configValue attr.Value) (attr.Value, diag.Diagnostics)
+
+// DefaultFromConfig returns an AttributePlanModifier which sets the planned
+// value of a Computed attribute to the value returned by `f` when the
+// attribute is not configured, such as deriving a display name from a name.
+// The function receives the configured value at `path`. When that value is
+// unknown, the planned value is left unknown and `f` is not called. For
+// defaults which depend on multiple attributes, use DefaultFromFunc and read
+// the request Config instead.
+func DefaultFromConfig(path path.Path, f ConfigDefaultFunc, description, markdownDescription string) AttributePlanModifier {
+	return DefaultFromFunc(
+		func(ctx context.Context, req ModifyAttributePlanRequest) (attr.Value, diag.Diagnostics) {
+			configValue, diags := req.Config.getAttributeValue(ctx, path)
+
+			if diags.HasError() || configValue == nil {
+				return nil, diags
+			}
+
+			configRaw, err := configValue.ToTerraformValue(ctx)
+			if err != nil {
+				diags.AddAttributeError(path,
+					"Error converting config value",
+					fmt.Sprintf("An unexpected error was encountered converting a %s to its equivalent Terraform representation. This is always a bug in the provider.\n\nError: %s", configValue.Type(ctx), err),
+				)
+				return nil, diags
+			}
+
+			// the default cannot be known until the value it is derived
+			// from is known
+			if !configRaw.IsKnown() {
+				return nil, diags
+			}
+
+			val, fDiags := f(ctx, configValue)
+			diags.Append(fDiags...)
+
+			return val, diags
+		},
+		description,
+		markdownDescription,
+	)
+}
+
+// CachedDefaultFunc returns a DefaultFunc which calls `f` until it returns a
+// value without error diagnostics, then returns that value and any warning
+// diagnostics for all further calls. It is safe for concurrent use.
+//
+// As schemas may be created for every request, the returned function should
+// be created once, such as during provider configuration, rather than in
+// GetSchema.
+func CachedDefaultFunc(f DefaultFunc) DefaultFunc {
+	var (
+		cached bool
+		diags  diag.Diagnostics
+		mu     sync.Mutex
+		val    attr.Value
+	)
+
+	return func(ctx context.Context, req ModifyAttributePlanRequest) (attr.Value, diag.Diagnostics) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if cached {
+			return val, diags
+		}
+
+		newVal, newDiags := f(ctx, req)
+
+		if newDiags.HasError() {
+			return newVal, newDiags
+		}
+
+		cached, diags, val = true, newDiags, newVal
+
+		return val, diags
+	}
+}
+
 // ModifyAttributePlanRequest represents a request for the provider to modify an
 // attribute value, or mark it as requiring replacement, at plan time. An
 // instance of this request struct is supplied as an argument to the Modify
 // function of an attribute's plan modifier(s).
 type ModifyAttributePlanRequest struct {
 	// AttributePath is the path of the attribute.
-	AttributePath *tftypes.AttributePath
+	AttributePath path.Path
 
 	// Config is the configuration the user supplied for the resource.
 	Config Config
@@ -430,6 +587,13 @@ type ModifyAttributePlanRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData set by the Provider's Configure
+	// method, or nil if the provider has not been configured yet. It can
+	// be used to access provider data, such as API clients, during plan
+	// modification. Implementations should type assert it to the concrete
+	// type set by Configure.
+	ProviderData interface{}
 }
 
 // ModifyAttributePlanResponse represents a response to a
@@ -2,11 +2,13 @@ package tfsdk
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -121,7 +123,7 @@ func TestUseStateForUnknownModifier(t *testing.T) {
 			}
 
 			req := ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath(),
+				AttributePath: path.Empty(),
 				Config: Config{
 					Schema: schema,
 					Raw: tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
@@ -168,7 +170,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 		state        State
 		plan         Plan
 		config       Config
-		path         *tftypes.AttributePath
+		path         path.Path
 		expectedPlan attr.Value
 		expectedRR   bool
 	}
@@ -228,7 +230,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "bar"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
 		},
@@ -254,7 +256,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 				Schema: schema,
 				Raw:    tftypes.NewValue(schema.TerraformType(context.Background()), nil),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: nil,
 			expectedRR:   false,
 		},
@@ -283,7 +285,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "bar"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Value: "bar"},
 			expectedRR:   true,
 		},
@@ -312,7 +314,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, nil),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
 		},
@@ -340,7 +342,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "quux"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   true,
 		},
@@ -368,7 +370,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "quux"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
 		},
@@ -403,7 +405,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, "quux"),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			expectedPlan: types.String{Unknown: true},
 			expectedRR:   false,
 		},
@@ -439,7 +441,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 					"optional":          tftypes.NewValue(tftypes.String, nil),
 				}),
 			},
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
 		},
@@ -516,7 +518,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -630,7 +632,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -736,7 +738,7 @@ func TestRequiresReplaceModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -818,7 +820,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 		plan         Plan
 		config       Config
 		priorRR      bool
-		path         *tftypes.AttributePath
+		path         path.Path
 		ifReturn     bool
 		expectedPlan attr.Value
 		expectedRR   bool
@@ -880,7 +882,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
@@ -908,7 +910,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				Raw:    tftypes.NewValue(schema.TerraformType(context.Background()), nil),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: nil,
 			expectedRR:   false,
@@ -939,7 +941,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "bar"},
 			expectedRR:   true,
@@ -971,7 +973,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 			},
 			priorRR:      false,
 			ifReturn:     true,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
 		},
@@ -1001,7 +1003,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   true,
@@ -1032,7 +1034,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     false,
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   false,
@@ -1064,7 +1066,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      true,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     false,
 			expectedPlan: types.String{Value: "quux"},
 			expectedRR:   true,
@@ -1094,7 +1096,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: types.String{Value: "foo"},
 			expectedRR:   false,
@@ -1131,7 +1133,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional-computed"),
+			path:         path.Root("optional-computed"),
 			ifReturn:     true,
 			expectedPlan: types.String{Unknown: true},
 			expectedRR:   false,
@@ -1169,7 +1171,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				}),
 			},
 			priorRR:      false,
-			path:         tftypes.NewAttributePath().WithAttributeName("optional"),
+			path:         path.Root("optional"),
 			ifReturn:     true,
 			expectedPlan: types.String{Null: true},
 			expectedRR:   true,
@@ -1247,7 +1249,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -1362,7 +1364,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -1469,7 +1471,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 						}),
 				}),
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("block"),
+			path: path.Root("block"),
 			expectedPlan: types.List{
 				ElemType: types.ObjectType{
 					AttrTypes: map[string]attr.Type{
@@ -1529,7 +1531,7 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 				AttributePlan:   req.AttributePlan,
 				RequiresReplace: tc.priorRR,
 			}
-			modifier := RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+			modifier := RequiresReplaceIf(func(ctx context.Context, state, config attr.Value, path path.Path) (bool, diag.Diagnostics) {
 				return tc.ifReturn, nil
 			}, "", "")
 
@@ -1546,3 +1548,313 @@ func TestRequiresReplaceIfModifier(t *testing.T) {
 		})
 	}
 }
+
+func TestDefaultFromFuncModifier(t *testing.T) {
+	t.Parallel()
+
+	type testProviderData struct {
+		defaultValue string
+	}
+
+	defaultFunc := func(ctx context.Context, req ModifyAttributePlanRequest) (attr.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		providerData, ok := req.ProviderData.(*testProviderData)
+
+		if !ok {
+			diags.AddAttributeError(req.AttributePath, "Unexpected Provider Data", "unexpected provider data type")
+			return nil, diags
+		}
+
+		return types.String{Value: providerData.defaultValue}, diags
+	}
+
+	type testCase struct {
+		plan          attr.Value
+		config        attr.Value
+		destroy       bool
+		providerData  interface{}
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"nil-plan": {
+			// this honestly just shouldn't happen, but let's be
+			// sure we're not going to panic if it does
+			plan:         nil,
+			config:       types.String{Null: true},
+			providerData: &testProviderData{defaultValue: "default"},
+			expected:     nil,
+		},
+		"null-config": {
+			plan:         types.String{Unknown: true},
+			config:       types.String{Null: true},
+			providerData: &testProviderData{defaultValue: "default"},
+			expected:     types.String{Value: "default"},
+		},
+		"known-config": {
+			plan:         types.String{Value: "configured"},
+			config:       types.String{Value: "configured"},
+			providerData: &testProviderData{defaultValue: "default"},
+			expected:     types.String{Value: "configured"},
+		},
+		"unknown-config": {
+			plan:         types.String{Unknown: true},
+			config:       types.String{Unknown: true},
+			providerData: &testProviderData{defaultValue: "default"},
+			expected:     types.String{Unknown: true},
+		},
+		"destroy": {
+			plan:         types.String{Null: true},
+			config:       types.String{Null: true},
+			destroy:      true,
+			providerData: &testProviderData{defaultValue: "default"},
+			expected:     types.String{Null: true},
+		},
+		"error": {
+			plan:     types.String{Unknown: true},
+			config:   types.String{Null: true},
+			expected: types.String{Unknown: true},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("a"),
+					"Unexpected Provider Data",
+					"unexpected provider data type",
+				),
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := Schema{
+				Attributes: map[string]Attribute{
+					"a": {
+						Type:     types.StringType,
+						Optional: true,
+						Computed: true,
+					},
+				},
+			}
+
+			configVal := tftypes.NewValue(tftypes.String, nil)
+			if tc.config != nil {
+				val, err := tc.config.ToTerraformValue(context.Background())
+				if err != nil {
+					t.Fatal(err)
+				}
+				configVal = val
+			}
+
+			planRaw := tftypes.NewValue(schema.TerraformType(context.Background()), nil)
+			if !tc.destroy {
+				planVal := tftypes.NewValue(tftypes.String, nil)
+				if tc.plan != nil {
+					val, err := tc.plan.ToTerraformValue(context.Background())
+					if err != nil {
+						t.Fatal(err)
+					}
+					planVal = val
+				}
+				planRaw = tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
+					"a": planVal,
+				})
+			}
+
+			req := ModifyAttributePlanRequest{
+				AttributePath: path.Root("a"),
+				Config: Config{
+					Schema: schema,
+					Raw: tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
+						"a": configVal,
+					}),
+				},
+				Plan: Plan{
+					Schema: schema,
+					Raw:    planRaw,
+				},
+				AttributeConfig: tc.config,
+				AttributePlan:   tc.plan,
+				ProviderData:    tc.providerData,
+			}
+			resp := &ModifyAttributePlanResponse{
+				AttributePlan: req.AttributePlan,
+			}
+			modifier := DefaultFromFunc(defaultFunc, "Defaults to the provider default.", "Defaults to the provider default.")
+
+			modifier.Modify(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics diff (-wanted, +got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.expected, resp.AttributePlan); diff != "" {
+				t.Errorf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCachedDefaultFunc(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	f := CachedDefaultFunc(func(ctx context.Context, req ModifyAttributePlanRequest) (attr.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		calls++
+
+		if calls == 1 {
+			diags.AddError("Temporary Error", "temporary error")
+			return nil, diags
+		}
+
+		return types.Int64{Value: int64(calls)}, diags
+	})
+
+	_, diags := f(context.Background(), ModifyAttributePlanRequest{})
+
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostics on first call")
+	}
+
+	for i := 0; i < 2; i++ {
+		got, diags := f(context.Background(), ModifyAttributePlanRequest{})
+
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %s", diags)
+		}
+
+		if diff := cmp.Diff(got, types.Int64{Value: 2}); diff != "" {
+			t.Errorf("unexpected difference: %s", diff)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestDefaultFromConfig(t *testing.T) {
+	t.Parallel()
+
+	defaultFunc := func(ctx context.Context, configValue attr.Value) (attr.Value, diag.Diagnostics) {
+		var diags diag.Diagnostics
+
+		name, ok := configValue.(types.String)
+
+		if !ok {
+			diags.AddError("Unexpected Value", "unexpected value type")
+			return nil, diags
+		}
+
+		if name.Null {
+			return nil, diags
+		}
+
+		return types.String{Value: strings.ToUpper(name.Value)}, diags
+	}
+
+	type testCase struct {
+		name          tftypes.Value
+		config        attr.Value
+		plan          attr.Value
+		expected      attr.Value
+		expectedDiags diag.Diagnostics
+	}
+
+	tests := map[string]testCase{
+		"null-config": {
+			name:     tftypes.NewValue(tftypes.String, "example"),
+			config:   types.String{Null: true},
+			plan:     types.String{Unknown: true},
+			expected: types.String{Value: "EXAMPLE"},
+		},
+		"known-config": {
+			name:     tftypes.NewValue(tftypes.String, "example"),
+			config:   types.String{Value: "configured"},
+			plan:     types.String{Value: "configured"},
+			expected: types.String{Value: "configured"},
+		},
+		"null-source": {
+			name:     tftypes.NewValue(tftypes.String, nil),
+			config:   types.String{Null: true},
+			plan:     types.String{Unknown: true},
+			expected: types.String{Unknown: true},
+		},
+		"unknown-source": {
+			name:     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			config:   types.String{Null: true},
+			plan:     types.String{Unknown: true},
+			expected: types.String{Unknown: true},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := Schema{
+				Attributes: map[string]Attribute{
+					"display_name": {
+						Type:     types.StringType,
+						Optional: true,
+						Computed: true,
+					},
+					"name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			}
+
+			configVal, err := tc.config.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			planVal, err := tc.plan.ToTerraformValue(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := ModifyAttributePlanRequest{
+				AttributePath: path.Root("display_name"),
+				Config: Config{
+					Schema: schema,
+					Raw: tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
+						"display_name": configVal,
+						"name":         tc.name,
+					}),
+				},
+				Plan: Plan{
+					Schema: schema,
+					Raw: tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
+						"display_name": planVal,
+						"name":         tc.name,
+					}),
+				},
+				AttributeConfig: tc.config,
+				AttributePlan:   tc.plan,
+			}
+			resp := &ModifyAttributePlanResponse{
+				AttributePlan: req.AttributePlan,
+			}
+			modifier := DefaultFromConfig(path.Root("name"), defaultFunc, "Defaults to the upper case name.", "Defaults to the upper case `name`.")
+
+			modifier.Modify(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("Unexpected diagnostics diff (-wanted, +got): %s", diff)
+			}
+			if diff := cmp.Diff(tc.expected, resp.AttributePlan); diff != "" {
+				t.Errorf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
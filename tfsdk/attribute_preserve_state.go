@@ -0,0 +1,62 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// preserveStateValues returns a tftypes.Transform function which replaces the
+// values of PreserveState attributes with their non-null prior state values.
+func preserveStateValues(ctx context.Context, priorState tftypes.Value, resourceSchema Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
+	return func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		// we are only modifying attributes, not the entire resource
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+
+		// element positions can differ between the prior state and the
+		// new value, so only attributes outside collections are preserved
+		for _, step := range path.Steps() {
+			if _, ok := step.(tftypes.AttributeName); !ok {
+				return val, nil
+			}
+		}
+
+		attribute, err := resourceSchema.attributeAtTerraformPath(path)
+
+		if err != nil {
+			if errors.Is(err, ErrPathInsideAtomicAttribute) || errors.Is(err, ErrPathIsBlock) {
+				return val, nil
+			}
+
+			tfsdklog.Error(ctx, "couldn't find attribute in resource schema", "path", path)
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in resource schema: %w", err)
+		}
+
+		if !attribute.PreserveState {
+			return val, nil
+		}
+
+		priorVal, _, err := tftypes.WalkAttributePath(priorState, path)
+
+		if err != nil {
+			tfsdklog.Trace(ctx, "attribute not found in prior state, not preserving", "path", path)
+			return val, nil
+		}
+
+		priorValue, ok := priorVal.(tftypes.Value)
+
+		if !ok || priorValue.IsNull() {
+			tfsdklog.Trace(ctx, "attribute null in prior state, not preserving", "path", path)
+			return val, nil
+		}
+
+		tfsdklog.Debug(ctx, "preserving prior state value of attribute", "path", path)
+
+		return priorValue, nil
+	}
+}
@@ -0,0 +1,274 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPreserveStateValues(t *testing.T) {
+	t.Parallel()
+
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"password": {
+				Type:          types.StringType,
+				Computed:      true,
+				PreserveState: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"token": {
+						Type:          types.StringType,
+						Computed:      true,
+						PreserveState: true,
+					},
+				}),
+				Optional: true,
+			},
+			"list": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"token": {
+						Type:          types.StringType,
+						Computed:      true,
+						PreserveState: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+	}
+	schemaType := schema.TerraformType(context.Background())
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"token": tftypes.String,
+		},
+	}
+	listType := tftypes.List{ElementType: nestedType}
+
+	newValue := func(name, password, nestedToken, listToken interface{}) tftypes.Value {
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"password": tftypes.NewValue(tftypes.String, password),
+			"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"token": tftypes.NewValue(tftypes.String, nestedToken),
+			}),
+			"list": tftypes.NewValue(listType, []tftypes.Value{
+				tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"token": tftypes.NewValue(tftypes.String, listToken),
+				}),
+			}),
+		})
+	}
+
+	testCases := map[string]struct {
+		priorState tftypes.Value
+		value      tftypes.Value
+		expected   tftypes.Value
+	}{
+		"preserved": {
+			priorState: newValue("old", "initial", "initial-token", "initial-list-token"),
+			value:      newValue("new", "refreshed", "refreshed-token", "refreshed-list-token"),
+			expected:   newValue("new", "initial", "initial-token", "refreshed-list-token"),
+		},
+		"unknown": {
+			priorState: newValue("old", "initial", "initial-token", "initial-list-token"),
+			value:      newValue("new", tftypes.UnknownValue, tftypes.UnknownValue, tftypes.UnknownValue),
+			expected:   newValue("new", "initial", "initial-token", tftypes.UnknownValue),
+		},
+		"prior-null": {
+			priorState: newValue("old", nil, nil, nil),
+			value:      newValue("new", "refreshed", "refreshed-token", "refreshed-list-token"),
+			expected:   newValue("new", "refreshed", "refreshed-token", "refreshed-list-token"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tftypes.Transform(tc.value, preserveStateValues(context.Background(), tc.priorState, schema))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+// testPreserveStateResource has a PreserveState password attribute, which
+// Read refreshes along with the name.
+type testPreserveStateResource struct {
+	testResourceWithMetadata
+}
+
+func (r testPreserveStateResource) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	return Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"password": {
+				Type:          types.StringType,
+				Computed:      true,
+				PreserveState: true,
+			},
+		},
+	}, nil
+}
+
+func (r testPreserveStateResource) Read(ctx context.Context, _ ReadResourceRequest, resp *ReadResourceResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttributes(ctx, []AttributeWrite{
+		{Path: path.Root("name"), Value: "refreshed"},
+		{Path: path.Root("password"), Value: "refreshed"},
+	})...)
+}
+
+func testPreserveStateServer() tfprotov6.ProviderServer {
+	return NewProtocol6ServerWithOptions(testServeProviderWithResources{
+		testServeProvider: &testServeProvider{},
+		resources: []func() ResourceWithMetadata{
+			func() ResourceWithMetadata {
+				return testPreserveStateResource{
+					testResourceWithMetadata: testResourceWithMetadata{typeName: "test_preserve_state"},
+				}
+			},
+		},
+	}, ServeOpts{})
+}
+
+func testPreserveStateValue(t *testing.T, id, name, password interface{}) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":       tftypes.String,
+			"name":     tftypes.String,
+			"password": tftypes.String,
+		},
+	}
+
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, id),
+		"name":     tftypes.NewValue(tftypes.String, name),
+		"password": tftypes.NewValue(tftypes.String, password),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return &value
+}
+
+func TestServerReadResourcePreserveState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		priorState       *tfprotov6.DynamicValue
+		expectedNewState *tfprotov6.DynamicValue
+	}{
+		"preserved": {
+			priorState:       testPreserveStateValue(t, "test-id", "initial", "initial"),
+			expectedNewState: testPreserveStateValue(t, "test-id", "refreshed", "initial"),
+		},
+		"prior-null": {
+			priorState:       testPreserveStateValue(t, "test-id", "initial", nil),
+			expectedNewState: testPreserveStateValue(t, "test-id", "refreshed", "refreshed"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testPreserveStateServer().ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: tc.priorState,
+				TypeName:     "test_preserve_state",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", got.Diagnostics)
+			}
+
+			if diff := cmp.Diff(got.NewState, tc.expectedNewState); diff != "" {
+				t.Errorf("unexpected new state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerPlanResourceChangePreserveState(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		priorState           *tfprotov6.DynamicValue
+		config               *tfprotov6.DynamicValue
+		expectedPlannedState *tfprotov6.DynamicValue
+	}{
+		"update": {
+			priorState:           testPreserveStateValue(t, "test-id", "initial", "initial"),
+			config:               testPreserveStateValue(t, nil, "updated", nil),
+			expectedPlannedState: testPreserveStateValue(t, tftypes.UnknownValue, "updated", "initial"),
+		},
+		"create": {
+			priorState:           testPreserveStateValue(t, nil, nil, nil),
+			config:               testPreserveStateValue(t, nil, "created", nil),
+			expectedPlannedState: testPreserveStateValue(t, tftypes.UnknownValue, "created", tftypes.UnknownValue),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testPreserveStateServer().PlanResourceChange(context.Background(), &tfprotov6.PlanResourceChangeRequest{
+				Config:           tc.config,
+				PriorState:       tc.priorState,
+				ProposedNewState: tc.config,
+				TypeName:         "test_preserve_state",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got.Diagnostics) > 0 {
+				t.Fatalf("unexpected diagnostics: %v", got.Diagnostics)
+			}
+
+			if diff := cmp.Diff(got.PlannedState, tc.expectedPlannedState); diff != "" {
+				t.Errorf("unexpected planned state difference: %s", diff)
+			}
+		})
+	}
+}
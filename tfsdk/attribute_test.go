@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -454,7 +455,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 	}{
 		"config-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -511,7 +512,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 			expectedResp: ModifySchemaPlanResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Configuration Read Error",
 						"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -538,7 +539,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"config-error-previous-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -606,7 +607,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 						"This was a previous error",
 					),
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Configuration Read Error",
 						"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -633,7 +634,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"plan-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -690,7 +691,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 			expectedResp: ModifySchemaPlanResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Plan Read Error",
 						"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -717,7 +718,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"plan-error-previous-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -785,7 +786,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 						"This was a previous error",
 					),
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Plan Read Error",
 						"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -812,7 +813,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"state-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -869,7 +870,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 			expectedResp: ModifySchemaPlanResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"State Read Error",
 						"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -896,7 +897,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"state-error-previous-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -964,7 +965,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 						"This was a previous error",
 					),
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"State Read Error",
 						"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -991,7 +992,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"no-plan-modifiers": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1067,7 +1068,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"attribute-plan": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1159,7 +1160,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"attribute-plan-previous-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1264,7 +1265,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"requires-replacement": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1345,14 +1346,14 @@ func TestAttributeModifyPlan(t *testing.T) {
 						},
 					},
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"requires-replacement-previous-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1446,14 +1447,14 @@ func TestAttributeModifyPlan(t *testing.T) {
 						},
 					},
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"requires-replacement-passthrough": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1537,14 +1538,14 @@ func TestAttributeModifyPlan(t *testing.T) {
 						},
 					},
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"requires-replacement-unset": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1632,7 +1633,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"warnings": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1733,7 +1734,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"warnings-previous-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1845,7 +1846,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -1943,7 +1944,7 @@ func TestAttributeModifyPlan(t *testing.T) {
 		},
 		"error-previous-error": {
 			req: ModifyAttributePlanRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2050,6 +2051,146 @@ func TestAttributeModifyPlan(t *testing.T) {
 				},
 			},
 		},
+		"attribute-single-nested-null": {
+			req: ModifyAttributePlanRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attr": tftypes.String,
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"nested_attr": tftypes.String,
+							},
+						}, nil),
+					}),
+					Schema: Schema{
+						Attributes: map[string]Attribute{
+							"test": {
+								Attributes: SingleNestedAttributes(map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+										PlanModifiers: []AttributePlanModifier{
+											testErrorDiagModifier{},
+										},
+									},
+								}),
+								Optional: true,
+							},
+						},
+					},
+				},
+				Plan: Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attr": tftypes.String,
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"nested_attr": tftypes.String,
+							},
+						}, nil),
+					}),
+					Schema: Schema{
+						Attributes: map[string]Attribute{
+							"test": {
+								Attributes: SingleNestedAttributes(map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+										PlanModifiers: []AttributePlanModifier{
+											testErrorDiagModifier{},
+										},
+									},
+								}),
+								Optional: true,
+							},
+						},
+					},
+				},
+				State: State{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attr": tftypes.String,
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"nested_attr": tftypes.String,
+							},
+						}, nil),
+					}),
+					Schema: Schema{
+						Attributes: map[string]Attribute{
+							"test": {
+								Attributes: SingleNestedAttributes(map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+										PlanModifiers: []AttributePlanModifier{
+											testErrorDiagModifier{},
+										},
+									},
+								}),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: ModifySchemaPlanResponse{},
+			expectedResp: ModifySchemaPlanResponse{
+				Plan: Plan{
+					Raw: tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"test": tftypes.Object{
+								AttributeTypes: map[string]tftypes.Type{
+									"nested_attr": tftypes.String,
+								},
+							},
+						},
+					}, map[string]tftypes.Value{
+						"test": tftypes.NewValue(tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"nested_attr": tftypes.String,
+							},
+						}, nil),
+					}),
+					Schema: Schema{
+						Attributes: map[string]Attribute{
+							"test": {
+								Attributes: SingleNestedAttributes(map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+										PlanModifiers: []AttributePlanModifier{
+											testErrorDiagModifier{},
+										},
+									},
+								}),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -2083,7 +2224,7 @@ func TestAttributeValidate(t *testing.T) {
 	}{
 		"no-attributes-or-type": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2104,7 +2245,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Invalid Attribute Definition",
 						"Attribute must define either Attributes or Type. This is always a problem with the provider and should be reported to the provider developer.",
 					),
@@ -2113,7 +2254,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"both-attributes-and-type": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2141,7 +2282,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Invalid Attribute Definition",
 						"Attribute cannot define both Attributes and Type. This is always a problem with the provider and should be reported to the provider developer.",
 					),
@@ -2150,7 +2291,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"missing-required-optional-and-computed": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2171,7 +2312,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Invalid Attribute Definition",
 						"Attribute missing Required, Optional, or Computed definition. This is always a problem with the provider and should be reported to the provider developer.",
 					),
@@ -2180,7 +2321,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"config-error": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2202,7 +2343,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeErrorDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Configuration Read Error",
 						"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 							"can't use tftypes.String<\"testvalue\"> as value of List with ElementType types.primitive, can only use tftypes.String values",
@@ -2212,7 +2353,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"no-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2235,7 +2376,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"deprecation-message-known": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2258,7 +2399,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Attribute Deprecated",
 						"Use something else instead.",
 					),
@@ -2267,7 +2408,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"deprecation-message-null": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2291,7 +2432,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"deprecation-message-unknown": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2314,7 +2455,7 @@ func TestAttributeValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Attribute Deprecated",
 						"Use something else instead.",
 					),
@@ -2323,7 +2464,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"warnings": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2355,7 +2496,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"errors": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2387,7 +2528,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"type-with-validate-error": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2408,13 +2549,13 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
-					testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+					testtypes.TestErrorDiagnostic(path.Root("test")),
 				},
 			},
 		},
 		"type-with-validate-warning": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(tftypes.Object{
 						AttributeTypes: map[string]tftypes.Type{
@@ -2435,13 +2576,13 @@ func TestAttributeValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
-					testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+					testtypes.TestWarningDiagnostic(path.Root("test")),
 				},
 			},
 		},
 		"nested-attr-list-no-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2498,7 +2639,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-list-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2560,9 +2701,74 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-list-sensitive": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "testvalue"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: Schema{
+						Attributes: map[string]Attribute{
+							"test": {
+								Attributes: ListNestedAttributes(map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+										Validators: []AttributeValidator{
+											testSensitiveAttributeValidator{},
+										},
+									},
+								}, ListNestedAttributesOptions{}),
+								Required:  true,
+								Sensitive: true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					testErrorDiagnostic1,
+				},
+			},
+		},
 		"nested-attr-map-no-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2619,7 +2825,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-map-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2683,7 +2889,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-set-no-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2740,7 +2946,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-set-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2804,7 +3010,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-single-no-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2848,7 +3054,7 @@ func TestAttributeValidate(t *testing.T) {
 		},
 		"nested-attr-single-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2896,6 +3102,50 @@ func TestAttributeValidate(t *testing.T) {
 				},
 			},
 		},
+		"nested-attr-single-null-validation": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+							},
+						}, map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Object{
+									AttributeTypes: map[string]tftypes.Type{
+										"nested_attr": tftypes.String,
+									},
+								},
+								nil,
+							),
+						},
+					),
+					Schema: Schema{
+						Attributes: map[string]Attribute{
+							"test": {
+								Attributes: SingleNestedAttributes(map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+										Validators: []AttributeValidator{
+											testErrorAttributeValidator{},
+										},
+									},
+								}),
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -5,7 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // AttributeValidator describes reusable Attribute validation functionality.
@@ -29,13 +29,18 @@ type AttributeValidator interface {
 // ValidateAttributeRequest repesents a request for
 type ValidateAttributeRequest struct {
 	// AttributePath contains the path of the attribute.
-	AttributePath *tftypes.AttributePath
+	AttributePath path.Path
 
 	// AttributeConfig contains the value of the attribute in the configuration.
 	AttributeConfig attr.Value
 
 	// Config contains the entire configuration of the data source, provider, or resource.
 	Config Config
+
+	// AttributeSensitive is true when the attribute, or an attribute it is
+	// nested under, is Sensitive. Diagnostics must not include the value
+	// of a sensitive attribute.
+	AttributeSensitive bool
 }
 
 // ValidateAttributeResponse represents a response to a
@@ -64,3 +64,21 @@ func (v testWarningAttributeValidator) Validate(ctx context.Context, req Validat
 		resp.Diagnostics.Append(testWarningDiagnostic2)
 	}
 }
+
+type testSensitiveAttributeValidator struct {
+	AttributeValidator
+}
+
+func (v testSensitiveAttributeValidator) Description(ctx context.Context) string {
+	return "validation that returns an error when the attribute is sensitive"
+}
+
+func (v testSensitiveAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testSensitiveAttributeValidator) Validate(ctx context.Context, req ValidateAttributeRequest, resp *ValidateAttributeResponse) {
+	if req.AttributeSensitive {
+		resp.Diagnostics.Append(testErrorDiagnostic1)
+	}
+}
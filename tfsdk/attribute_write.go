@@ -0,0 +1,153 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// AttributeWrite is a Go value to set at a path with State.SetAttributes or
+// Plan.SetAttributes.
+type AttributeWrite struct {
+	// Path is the attribute path to set.
+	Path path.Path
+
+	// Value is the Go value to set at Path, as with the val argument of
+	// SetAttribute.
+	Value interface{}
+}
+
+// attributeWriter is the data written by setAttributes, implemented by
+// *State and *Plan.
+type attributeWriter interface {
+	// attributeWriteValue converts the Go value to the Terraform value to
+	// write at the path.
+	attributeWriteValue(ctx context.Context, path path.Path, val interface{}) (tftypes.Value, diag.Diagnostics)
+
+	// pathExists returns true if the path can be reached.
+	pathExists(ctx context.Context, path path.Path) (bool, diag.Diagnostics)
+
+	// setAttributeValue sets the Terraform value at the path, adding any
+	// parent values as necessary.
+	setAttributeValue(ctx context.Context, path path.Path, tfVal tftypes.Value) diag.Diagnostics
+
+	// replaceAttributeValues replaces the values at existing paths
+	// together.
+	replaceAttributeValues(replacements []valueReplacement, paths []path.Path) diag.Diagnostics
+}
+
+// setAttributes implements State.SetAttributes and Plan.SetAttributes.
+func setAttributes(ctx context.Context, w attributeWriter, writes []AttributeWrite) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var pending []valueReplacement
+	var pendingPaths []path.Path
+
+	// pendingKeys and pendingParentKeys are the String() of each pending
+	// path and of each of their parent paths, to detect writes overlapping
+	// pending writes without comparing every pending path.
+	pendingKeys := make(map[string]struct{}, len(writes))
+	pendingParentKeys := make(map[string]struct{}, len(writes))
+
+	flush := func() bool {
+		if len(pending) == 0 {
+			return true
+		}
+
+		diags.Append(w.replaceAttributeValues(pending, pendingPaths)...)
+
+		if diags.HasError() {
+			return false
+		}
+
+		pending = nil
+		pendingPaths = nil
+		pendingKeys = make(map[string]struct{}, len(writes))
+		pendingParentKeys = make(map[string]struct{}, len(writes))
+
+		return true
+	}
+
+	for _, write := range writes {
+		tfVal, tfValDiags := w.attributeWriteValue(ctx, write.Path, write.Value)
+		diags.Append(tfValDiags...)
+
+		if diags.HasError() {
+			flush()
+
+			return diags
+		}
+
+		if pathOverlapsKeys(write.Path, pendingKeys, pendingParentKeys) && !flush() {
+			return diags
+		}
+
+		exists, pathExistsDiags := w.pathExists(ctx, write.Path)
+		diags.Append(pathExistsDiags...)
+
+		if diags.HasError() {
+			flush()
+
+			return diags
+		}
+
+		if !exists {
+			// Adding a value rebuilds its parent values from the data, so
+			// any pending writes are applied first.
+			if !flush() {
+				return diags
+			}
+
+			diags.Append(w.setAttributeValue(ctx, write.Path, tfVal)...)
+
+			if diags.HasError() {
+				return diags
+			}
+
+			continue
+		}
+
+		pending = append(pending, valueReplacement{
+			path:  totftypes.AttributePath(write.Path),
+			value: tfVal,
+		})
+		pendingPaths = append(pendingPaths, write.Path)
+		pendingKeys[write.Path.String()] = struct{}{}
+
+		for parentPath := write.Path.ParentPath(); len(parentPath.Steps()) > 0; parentPath = parentPath.ParentPath() {
+			pendingParentKeys[parentPath.String()] = struct{}{}
+		}
+	}
+
+	flush()
+
+	return diags
+}
+
+// pathOverlapsKeys returns true if the path, or any of its parent paths, is
+// in keys, or if the path is in parentKeys. The empty path overlaps every
+// key.
+func pathOverlapsKeys(p path.Path, keys map[string]struct{}, parentKeys map[string]struct{}) bool {
+	if len(keys) == 0 {
+		return false
+	}
+
+	if len(p.Steps()) == 0 {
+		return true
+	}
+
+	if _, ok := parentKeys[p.String()]; ok {
+		return true
+	}
+
+	for ; len(p.Steps()) > 0; p = p.ParentPath() {
+		if _, ok := keys[p.String()]; ok {
+			return true
+		}
+	}
+
+	return false
+}
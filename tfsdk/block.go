@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -43,12 +44,14 @@ type Block struct {
 	MarkdownDescription string
 
 	// MaxItems is the maximum number of blocks that can be present in a
-	// practitioner configuration.
+	// practitioner configuration. Exceeding it returns an error diagnostic
+	// during validation.
 	MaxItems int64
 
 	// MinItems is the minimum number of blocks that must be present in a
 	// practitioner configuration. Setting to 1 or above effectively marks
-	// this configuration as required.
+	// this configuration as required. Configuring fewer blocks returns an
+	// error diagnostic during validation.
 	MinItems int64
 
 	// NestingMode indicates the block kind.
@@ -136,7 +139,7 @@ func (b Block) attributeType() attr.Type {
 		attrType.AttrTypes[attrName] = attr.attributeType()
 	}
 
-	for blockName, block := range b.Attributes {
+	for blockName, block := range b.Blocks {
 		attrType.AttrTypes[blockName] = block.attributeType()
 	}
 
@@ -232,10 +235,11 @@ func (b Block) modifyPlan(ctx context.Context, req ModifyAttributePlanRequest, r
 		for idx := range l.Elems {
 			for name, attr := range b.Attributes {
 				attrReq := ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -244,10 +248,11 @@ func (b Block) modifyPlan(ctx context.Context, req ModifyAttributePlanRequest, r
 
 			for name, block := range b.Blocks {
 				blockReq := ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -283,10 +288,11 @@ func (b Block) modifyPlan(ctx context.Context, req ModifyAttributePlanRequest, r
 
 			for name, attr := range b.Attributes {
 				attrReq := ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -295,10 +301,11 @@ func (b Block) modifyPlan(ctx context.Context, req ModifyAttributePlanRequest, r
 
 			for name, block := range b.Blocks {
 				blockReq := ModifyAttributePlanRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 					Plan:          resp.Plan,
 					ProviderMeta:  req.ProviderMeta,
+					ProviderData:  req.ProviderData,
 					State:         req.State,
 				}
 
@@ -435,10 +442,14 @@ func (b Block) validate(ctx context.Context, req ValidateAttributeRequest, resp
 			return
 		}
 
+		if !l.Unknown {
+			b.validateItems(req.AttributePath, len(l.Elems), resp)
+		}
+
 		for idx := range l.Elems {
 			for name, attr := range b.Attributes {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
@@ -452,7 +463,7 @@ func (b Block) validate(ctx context.Context, req ValidateAttributeRequest, resp
 
 			for name, block := range b.Blocks {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyInt(idx).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtListIndex(idx).AtName(name),
 					Config:        req.Config,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
@@ -478,6 +489,10 @@ func (b Block) validate(ctx context.Context, req ValidateAttributeRequest, resp
 			return
 		}
 
+		if !s.Unknown {
+			b.validateItems(req.AttributePath, len(s.Elems), resp)
+		}
+
 		for _, value := range s.Elems {
 			tfValue, err := value.ToTerraformValue(ctx)
 			if err != nil {
@@ -493,7 +508,7 @@ func (b Block) validate(ctx context.Context, req ValidateAttributeRequest, resp
 
 			for name, attr := range b.Attributes {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
@@ -507,7 +522,7 @@ func (b Block) validate(ctx context.Context, req ValidateAttributeRequest, resp
 
 			for name, block := range b.Blocks {
 				nestedAttrReq := ValidateAttributeRequest{
-					AttributePath: req.AttributePath.WithElementKeyValue(tfValue).WithAttributeName(name),
+					AttributePath: req.AttributePath.AtSetValue(tfValue).AtName(name),
 					Config:        req.Config,
 				}
 				nestedAttrResp := &ValidateAttributeResponse{
@@ -553,6 +568,27 @@ func (b Block) validate(ctx context.Context, req ValidateAttributeRequest, resp
 	}
 }
 
+// validateItems checks the number of configured blocks against MinItems and
+// MaxItems. Terraform also enforces these when decoding the configuration,
+// however blocks generated with dynamic blocks are only checked here.
+func (b Block) validateItems(path path.Path, items int, resp *ValidateAttributeResponse) {
+	if b.MinItems > 0 && int64(items) < b.MinItems {
+		resp.Diagnostics.AddAttributeError(
+			path,
+			"Insufficient Blocks",
+			fmt.Sprintf("Block %s must have at least %d configured blocks, got: %d", path, b.MinItems, items),
+		)
+	}
+
+	if b.MaxItems > 0 && int64(items) > b.MaxItems {
+		resp.Diagnostics.AddAttributeError(
+			path,
+			"Too Many Blocks",
+			fmt.Sprintf("Block %s must have at most %d configured blocks, got: %d", path, b.MaxItems, items),
+		)
+	}
+}
+
 type nestedBlock struct {
 	Block
 }
@@ -8,11 +8,90 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+func TestBlockAttributeType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		block    Block
+		expected attr.Type
+	}{
+		"list": {
+			block: Block{
+				Attributes: map[string]Attribute{
+					"test_attribute": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				NestingMode: BlockNestingModeList,
+			},
+			expected: types.ListType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test_attribute": types.StringType,
+					},
+				},
+			},
+		},
+		"set-nested-blocks": {
+			block: Block{
+				Attributes: map[string]Attribute{
+					"test_attribute": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"test_block": {
+						Attributes: map[string]Attribute{
+							"test_nested_attribute": {
+								Type:     types.BoolType,
+								Optional: true,
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+				},
+				NestingMode: BlockNestingModeSet,
+			},
+			expected: types.SetType{
+				ElemType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"test_attribute": types.StringType,
+						"test_block": types.ListType{
+							ElemType: types.ObjectType{
+								AttrTypes: map[string]attr.Type{
+									"test_nested_attribute": types.BoolType,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.block.attributeType()
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBlockModifyPlan(t *testing.T) {
 	t.Parallel()
 
@@ -105,7 +184,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		state  string
 	}
 
-	modifyAttributePlanRequest := func(attrPath *tftypes.AttributePath, schema Schema, values modifyAttributePlanValues) ModifyAttributePlanRequest {
+	modifyAttributePlanRequest := func(attrPath path.Path, schema Schema, values modifyAttributePlanValues) ModifyAttributePlanRequest {
 		return ModifyAttributePlanRequest{
 			AttributePath: attrPath,
 			Config: Config{
@@ -130,7 +209,7 @@ func TestBlockModifyPlan(t *testing.T) {
 	}{
 		"no-plan-modifiers": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, nil),
 				modifyAttributePlanValues{
 					config: "testvalue",
@@ -148,7 +227,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-modified": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					testBlockPlanModifierNullList{},
 				}, nil),
@@ -170,7 +249,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-modified-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					testBlockPlanModifierNullList{},
 				}, nil),
@@ -205,7 +284,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-requires-replacement": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					RequiresReplace(),
 				}, nil),
@@ -223,14 +302,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						RequiresReplace(),
 					}, nil),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"block-requires-replacement-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					RequiresReplace(),
 				}, nil),
@@ -261,14 +340,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						RequiresReplace(),
 					}, nil),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"block-requires-replacement-passthrough": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					RequiresReplace(),
 					testBlockPlanModifierNullList{},
@@ -288,14 +367,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						testBlockPlanModifierNullList{},
 					}, nil),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test"),
+				RequiresReplace: path.Paths{
+					path.Root("test"),
 				},
 			},
 		},
 		"block-requires-replacement-unset": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					RequiresReplace(),
 					testRequiresReplaceFalseModifier{},
@@ -319,7 +398,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-warnings": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					testWarningDiagModifier{},
 					testWarningDiagModifier{},
@@ -352,7 +431,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-warnings-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					testWarningDiagModifier{},
 					testWarningDiagModifier{},
@@ -396,7 +475,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					testErrorDiagModifier{},
 					testErrorDiagModifier{},
@@ -426,7 +505,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"block-error-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema([]AttributePlanModifier{
 					testErrorDiagModifier{},
 					testErrorDiagModifier{},
@@ -467,7 +546,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-modified": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					testAttrPlanValueModifierOne{},
 					testAttrPlanValueModifierTwo{},
@@ -491,7 +570,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-modified-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					testAttrPlanValueModifierOne{},
 					testAttrPlanValueModifierTwo{},
@@ -528,7 +607,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-requires-replacement": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					RequiresReplace(),
 				}),
@@ -546,14 +625,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						RequiresReplace(),
 					}),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr"),
+				RequiresReplace: path.Paths{
+					path.Root("test").AtListIndex(0).AtName("nested_attr"),
 				},
 			},
 		},
 		"nested-attribute-requires-replacement-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					RequiresReplace(),
 				}),
@@ -584,14 +663,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						RequiresReplace(),
 					}),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr"),
+				RequiresReplace: path.Paths{
+					path.Root("test").AtListIndex(0).AtName("nested_attr"),
 				},
 			},
 		},
 		"nested-attribute-requires-replacement-passthrough": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					RequiresReplace(),
 					testAttrPlanValueModifierOne{},
@@ -611,14 +690,14 @@ func TestBlockModifyPlan(t *testing.T) {
 						testAttrPlanValueModifierOne{},
 					}),
 				},
-				RequiresReplace: []*tftypes.AttributePath{
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr"),
+				RequiresReplace: path.Paths{
+					path.Root("test").AtListIndex(0).AtName("nested_attr"),
 				},
 			},
 		},
 		"nested-attribute-requires-replacement-unset": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					RequiresReplace(),
 					testRequiresReplaceFalseModifier{},
@@ -642,7 +721,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-warnings": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					testWarningDiagModifier{},
 					testWarningDiagModifier{},
@@ -675,7 +754,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-warnings-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					testWarningDiagModifier{},
 					testWarningDiagModifier{},
@@ -719,7 +798,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					testErrorDiagModifier{},
 					testErrorDiagModifier{},
@@ -749,7 +828,7 @@ func TestBlockModifyPlan(t *testing.T) {
 		},
 		"nested-attribute-error-previous-error": {
 			req: modifyAttributePlanRequest(
-				tftypes.NewAttributePath().WithAttributeName("test"),
+				path.Root("test"),
 				schema(nil, []AttributePlanModifier{
 					testErrorDiagModifier{},
 					testErrorDiagModifier{},
@@ -1284,7 +1363,7 @@ func TestBlockValidate(t *testing.T) {
 	}{
 		"deprecation-message-known": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1341,7 +1420,7 @@ func TestBlockValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Block Deprecated",
 						"Use something else instead.",
 					),
@@ -1350,7 +1429,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"deprecation-message-null": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1397,7 +1476,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"deprecation-message-unknown": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1443,7 +1522,7 @@ func TestBlockValidate(t *testing.T) {
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
 					diag.NewAttributeWarningDiagnostic(
-						tftypes.NewAttributePath().WithAttributeName("test"),
+						path.Root("test"),
 						"Block Deprecated",
 						"Use something else instead.",
 					),
@@ -1452,7 +1531,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"warnings": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1518,7 +1597,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"errors": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1584,7 +1663,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"nested-attr-warnings": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1650,7 +1729,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"nested-attr-errors": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1716,7 +1795,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"nested-attr-type-with-validate-error": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1771,13 +1850,13 @@ func TestBlockValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
-					testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr")),
+					testtypes.TestErrorDiagnostic(path.Root("test").AtListIndex(0).AtName("nested_attr")),
 				},
 			},
 		},
 		"nested-attr-type-with-validate-warning": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1832,13 +1911,13 @@ func TestBlockValidate(t *testing.T) {
 			},
 			resp: ValidateAttributeResponse{
 				Diagnostics: diag.Diagnostics{
-					testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("nested_attr")),
+					testtypes.TestWarningDiagnostic(path.Root("test").AtListIndex(0).AtName("nested_attr")),
 				},
 			},
 		},
 		"list-no-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1895,7 +1974,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"list-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -1959,7 +2038,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"set-no-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2016,7 +2095,7 @@ func TestBlockValidate(t *testing.T) {
 		},
 		"set-validation": {
 			req: ValidateAttributeRequest{
-				AttributePath: tftypes.NewAttributePath().WithAttributeName("test"),
+				AttributePath: path.Root("test"),
 				Config: Config{
 					Raw: tftypes.NewValue(
 						tftypes.Object{
@@ -2078,6 +2157,383 @@ func TestBlockValidate(t *testing.T) {
 				},
 			},
 		},
+		"list-max-items": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "one"),
+										},
+									),
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "two"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: Schema{
+						Blocks: map[string]Block{
+							"test": {
+								Attributes: map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								MaxItems:    1,
+								NestingMode: BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Too Many Blocks",
+						`Block test must have at most 1 configured blocks, got: 2`,
+					),
+				},
+			},
+		},
+		"list-min-items": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{},
+							),
+						},
+					),
+					Schema: Schema{
+						Blocks: map[string]Block{
+							"test": {
+								Attributes: map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								MinItems:    1,
+								NestingMode: BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Insufficient Blocks",
+						`Block test must have at least 1 configured blocks, got: 0`,
+					),
+				},
+			},
+		},
+		"list-items-valid": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "one"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: Schema{
+						Blocks: map[string]Block{
+							"test": {
+								Attributes: map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								MaxItems:    1,
+								MinItems:    1,
+								NestingMode: BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+		},
+		"list-items-unknown": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.List{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								tftypes.UnknownValue,
+							),
+						},
+					),
+					Schema: Schema{
+						Blocks: map[string]Block{
+							"test": {
+								Attributes: map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								MinItems:    1,
+								NestingMode: BlockNestingModeList,
+							},
+						},
+					},
+				},
+			},
+		},
+		"set-max-items": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Set{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Set{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "one"),
+										},
+									),
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "two"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: Schema{
+						Blocks: map[string]Block{
+							"test": {
+								Attributes: map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								MaxItems:    1,
+								NestingMode: BlockNestingModeSet,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Too Many Blocks",
+						`Block test must have at most 1 configured blocks, got: 2`,
+					),
+				},
+			},
+		},
+		"set-min-items": {
+			req: ValidateAttributeRequest{
+				AttributePath: path.Root("test"),
+				Config: Config{
+					Raw: tftypes.NewValue(
+						tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"test": tftypes.Set{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+							},
+						},
+						map[string]tftypes.Value{
+							"test": tftypes.NewValue(
+								tftypes.Set{
+									ElementType: tftypes.Object{
+										AttributeTypes: map[string]tftypes.Type{
+											"nested_attr": tftypes.String,
+										},
+									},
+								},
+								[]tftypes.Value{
+									tftypes.NewValue(
+										tftypes.Object{
+											AttributeTypes: map[string]tftypes.Type{
+												"nested_attr": tftypes.String,
+											},
+										},
+										map[string]tftypes.Value{
+											"nested_attr": tftypes.NewValue(tftypes.String, "one"),
+										},
+									),
+								},
+							),
+						},
+					),
+					Schema: Schema{
+						Blocks: map[string]Block{
+							"test": {
+								Attributes: map[string]Attribute{
+									"nested_attr": {
+										Type:     types.StringType,
+										Required: true,
+									},
+								},
+								MinItems:    2,
+								NestingMode: BlockNestingModeSet,
+							},
+						},
+					},
+				},
+			},
+			resp: ValidateAttributeResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Insufficient Blocks",
+						`Block test must have at least 2 configured blocks, got: 1`,
+					),
+				},
+			},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,51 @@
+package tfsdk
+
+import (
+	"context"
+	"sync"
+)
+
+// OnCancel arranges for f to be called in its own goroutine once ctx is
+// done. The contexts of requests are done when Terraform interrupts the
+// provider, such as when a practitioner interrupts terraform apply, so f can
+// be used to stop work which does not accept a context, such as by closing
+// a connection or aborting a remote operation.
+//
+// The returned stop function prevents f from being called, returning true
+// if it did so and false if f was already called or stopped. Request
+// contexts are also done after the request completes, so stop should be
+// deferred once the work is registered:
+//
+//	stop := tfsdk.OnCancel(ctx, func() { conn.Close() })
+//	defer stop()
+func OnCancel(ctx context.Context, f func()) (stop func() bool) {
+	var once sync.Once
+	stopped := make(chan struct{})
+	claim := func() bool {
+		claimed := false
+		once.Do(func() {
+			claimed = true
+		})
+		return claimed
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			if claim() {
+				f()
+			}
+		case <-stopped:
+		}
+	}()
+
+	return func() bool {
+		if !claim() {
+			return false
+		}
+
+		close(stopped)
+
+		return true
+	}
+}
@@ -0,0 +1,57 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestOnCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	called := make(chan struct{})
+
+	stop := OnCancel(ctx, func() {
+		close(called)
+	})
+
+	cancel()
+
+	select {
+	case <-called:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for function to be called")
+	}
+
+	if stop() {
+		t.Error("expected stop after call to return false")
+	}
+}
+
+func TestOnCancelStop(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	called := make(chan struct{})
+
+	stop := OnCancel(ctx, func() {
+		close(called)
+	})
+
+	if !stop() {
+		t.Error("expected first stop to return true")
+	}
+
+	if stop() {
+		t.Error("expected second stop to return false")
+	}
+
+	cancel()
+
+	select {
+	case <-called:
+		t.Error("expected function not to be called after stop")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,95 @@
+package tfsdk
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// Clock is a source of time, which framework functionality involving time,
+// such as timeouts, uses instead of the time package directly. Providers
+// can substitute an implementation, such as one which advances instantly,
+// so unit tests are deterministic.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// After waits for the duration to elapse and then sends the current
+	// time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+}
+
+// Rand is a source of pseudo-random numbers, which framework functionality
+// involving randomness, such as the jitter of Retry, uses instead of the
+// math/rand package directly. A *rand.Rand from the math/rand package
+// satisfies this interface, such as rand.New(rand.NewSource(1)) for
+// deterministic tests.
+type Rand interface {
+	// Float64 returns a pseudo-random number in [0.0,1.0).
+	Float64() float64
+
+	// Int63n returns a non-negative pseudo-random number in [0,n).
+	Int63n(n int64) int64
+}
+
+type clockContextKey struct{}
+
+type randContextKey struct{}
+
+// ContextWithClock returns a new context including the Clock, which is
+// returned by ClockFromContext. This is intended for calling provider
+// functionality directly in unit testing, otherwise use ServeOpts.
+func ContextWithClock(ctx context.Context, clock Clock) context.Context {
+	return context.WithValue(ctx, clockContextKey{}, clock)
+}
+
+// ClockFromContext returns the Clock from the context, if set via
+// ServeOpts or ContextWithClock, otherwise a Clock based on the time
+// package.
+func ClockFromContext(ctx context.Context) Clock {
+	if clock, ok := ctx.Value(clockContextKey{}).(Clock); ok && clock != nil {
+		return clock
+	}
+
+	return systemClock{}
+}
+
+// ContextWithRand returns a new context including the Rand, which is
+// returned by RandFromContext. This is intended for calling provider
+// functionality directly in unit testing, otherwise use ServeOpts.
+func ContextWithRand(ctx context.Context, r Rand) context.Context {
+	return context.WithValue(ctx, randContextKey{}, r)
+}
+
+// RandFromContext returns the Rand from the context, if set via ServeOpts
+// or ContextWithRand, otherwise a Rand based on the top-level math/rand
+// functions, which are safe for concurrent use.
+func RandFromContext(ctx context.Context) Rand {
+	if r, ok := ctx.Value(randContextKey{}).(Rand); ok && r != nil {
+		return r
+	}
+
+	return systemRand{}
+}
+
+// systemClock is a Clock implemented by the time package.
+type systemClock struct{}
+
+func (c systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (c systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// systemRand is a Rand implemented by the top-level math/rand functions.
+type systemRand struct{}
+
+func (r systemRand) Float64() float64 {
+	return rand.Float64()
+}
+
+func (r systemRand) Int63n(n int64) int64 {
+	return rand.Int63n(n)
+}
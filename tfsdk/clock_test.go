@@ -0,0 +1,78 @@
+package tfsdk
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+type testClock struct {
+	now time.Time
+}
+
+func (c testClock) Now() time.Time {
+	return c.now
+}
+
+func (c testClock) After(d time.Duration) <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- c.now.Add(d)
+	return ch
+}
+
+func TestClockFromContext(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := ClockFromContext(context.Background()).(systemClock); !ok {
+		t.Errorf("expected systemClock by default")
+	}
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := ContextWithClock(context.Background(), testClock{now: now})
+	clock := ClockFromContext(ctx)
+
+	if got := clock.Now(); !got.Equal(now) {
+		t.Errorf("expected %s, got %s", now, got)
+	}
+
+	if got := <-clock.After(time.Hour); !got.Equal(now.Add(time.Hour)) {
+		t.Errorf("expected %s, got %s", now.Add(time.Hour), got)
+	}
+}
+
+func TestRandFromContext(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := RandFromContext(context.Background()).(systemRand); !ok {
+		t.Errorf("expected systemRand by default")
+	}
+
+	expected := rand.New(rand.NewSource(1)).Int63n(100)
+	ctx := ContextWithRand(context.Background(), rand.New(rand.NewSource(1)))
+
+	if got := RandFromContext(ctx).Int63n(100); got != expected {
+		t.Errorf("expected %d, got %d", expected, got)
+	}
+}
+
+func TestServerRegisterContextClockRand(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := &server{
+		clock: testClock{now: now},
+		rand:  rand.New(rand.NewSource(1)),
+	}
+
+	ctx, done := s.registerContext(context.Background())
+	defer done()
+
+	if got := ClockFromContext(ctx).Now(); !got.Equal(now) {
+		t.Errorf("expected %s, got %s", now, got)
+	}
+
+	if _, ok := RandFromContext(ctx).(*rand.Rand); !ok {
+		t.Errorf("expected *rand.Rand from server")
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -19,12 +21,23 @@ type Config struct {
 
 // Get populates the struct passed as `target` with the entire config.
 func (c Config) Get(ctx context.Context, target interface{}) diag.Diagnostics {
-	return reflect.Into(ctx, c.Schema.AttributeType(), c.Raw, target, reflect.Options{})
+	return reflect.Into(ctx, c.Schema.AttributeType(), c.Raw, target, reflect.Options{}, path.Empty())
+}
+
+// GetWithOptions populates the struct passed as `target` with the entire
+// config, like Get. The options allow null and unknown values to be read into
+// Go types which cannot represent them, such as string, as their empty value
+// rather than returning an error.
+func (c Config) GetWithOptions(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return reflect.Into(ctx, c.Schema.AttributeType(), c.Raw, target, opts.reflectOptions(), path.Empty())
 }
 
 // GetAttribute retrieves the attribute found at `path` and populates the
-// `target` with the value.
-func (c Config) GetAttribute(ctx context.Context, path *tftypes.AttributePath, target interface{}) diag.Diagnostics {
+// `target` with the value. A nested object or list element, such as an
+// element of a list nested attribute, can be read directly into a struct
+// tagged with the nested attribute names. Diagnostics for nested values are
+// returned at their full path, such as `disks[0].size`.
+func (c Config) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
 	attrValue, diags := c.getAttributeValue(ctx, path)
 
 	if diags.HasError() {
@@ -41,22 +54,50 @@ func (c Config) GetAttribute(ctx context.Context, path *tftypes.AttributePath, t
 		return diags
 	}
 
-	valueAsDiags := ValueAs(ctx, attrValue, target)
+	diags.Append(valueAs(ctx, attrValue, target, path)...)
 
-	// ValueAs does not have path information for its Diagnostics.
-	for idx, valueAsDiag := range valueAsDiags {
-		valueAsDiags[idx] = diag.WithPath(path, valueAsDiag)
-	}
+	return diags
+}
 
-	diags.Append(valueAsDiags...)
+// AttributeIsNull returns true if the value at `path` is null, without
+// converting it into an attr.Value. Values which cannot be reached, such as
+// an attribute of a null object, are null unless a parent value is unknown.
+func (c Config) AttributeIsNull(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	isNull, _, diags := attributeNullOrUnknown(ctx, c.Schema, c.Raw, path, "Configuration Read Error", "configuration")
 
-	return diags
+	return isNull, diags
+}
+
+// AttributeIsUnknown returns true if the value at `path` is unknown, or
+// cannot be reached because a parent value is unknown, without converting it
+// into an attr.Value.
+func (c Config) AttributeIsUnknown(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	_, isUnknown, diags := attributeNullOrUnknown(ctx, c.Schema, c.Raw, path, "Configuration Read Error", "configuration")
+
+	return isUnknown, diags
+}
+
+// PathMatches returns all paths in the configuration matching the path
+// expression, such as every element of a list nested attribute. An error
+// diagnostic is returned if the expression does not follow the schema.
+// Relative expressions are resolved from the root of the schema.
+func (c Config) PathMatches(ctx context.Context, expression path.Expression) (path.Paths, diag.Diagnostics) {
+	return pathMatches(ctx, c.Schema, c.Raw, expression)
+}
+
+// GetAttributes retrieves the values found at each of the paths with a
+// single walk of the configuration, keyed by the String() of each path.
+// Consumers should assert the type of each returned value with the desired
+// attr.Type. This avoids walking the whole configuration for each path when
+// many attributes are inspected, such as in plan modifiers.
+func (c Config) GetAttributes(ctx context.Context, paths path.Paths) (map[string]attr.Value, diag.Diagnostics) {
+	return getAttributeValues(ctx, c.Schema, c.Raw, paths, "Configuration Read Error", "configuration")
 }
 
 // getAttributeValue retrieves the attribute found at `path` and returns it as an
 // attr.Value. Consumers should assert the type of the returned value with the
 // desired attr.Type.
-func (c Config) getAttributeValue(ctx context.Context, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func (c Config) getAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attrType, err := c.Schema.AttributeTypeAtPath(path)
@@ -112,8 +153,8 @@ func (c Config) getAttributeValue(ctx context.Context, path *tftypes.AttributePa
 	return attrValue, diags
 }
 
-func (c Config) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(c.Raw, path)
+func (c Config) terraformValueAtPath(path path.Path) (tftypes.Value, error) {
+	rawValue, remaining, err := tftypes.WalkAttributePath(c.Raw, totftypes.AttributePath(path))
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -108,7 +109,7 @@ func TestConfigGet_testTypes(t *testing.T) {
 			expected: testConfigGetData{
 				Name: testtypes.String{String: types.String{Value: ""}, CreatedBy: testtypes.StringTypeWithValidateError{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			config: Config{
@@ -131,7 +132,7 @@ func TestConfigGet_testTypes(t *testing.T) {
 			expected: testConfigGetData{
 				Name: testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -251,7 +252,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			expected: new(testtypes.String),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
 						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
 						TargetType: reflect.TypeOf(testtypes.String{}),
@@ -282,7 +283,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			expected: new(bool),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagIntoIncompatibleType{
 						Val:        tftypes.NewValue(tftypes.String, "namevalue"),
 						TargetType: reflect.TypeOf(false),
@@ -311,7 +312,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      new(testtypes.String),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			config: Config{
@@ -333,7 +334,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      &testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 		"Computed-Computed-object": {
 			config: Config{
@@ -355,7 +356,7 @@ func TestConfigGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      &testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -364,7 +365,7 @@ func TestConfigGetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.config.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("name"), tc.target)
+			diags := tc.config.GetAttribute(context.Background(), path.Root("name"), tc.target)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -382,7 +383,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 
 	type testCase struct {
 		config        Config
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -409,7 +410,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: nil,
 		},
 		"WithAttributeName-nonexistent": {
@@ -430,11 +431,11 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: nil,
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("other"),
+					path.Root("other"),
 					"Configuration Read Error",
 					"An unexpected error was encountered trying to read an attribute from the configuration. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
@@ -471,7 +472,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
@@ -507,7 +508,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -551,7 +552,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
@@ -608,7 +609,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.Object{
 				Null:      true,
 				AttrTypes: map[string]attr.Type{"value": types.StringType},
@@ -663,7 +664,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
@@ -732,7 +733,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
@@ -809,7 +810,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
@@ -842,7 +843,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
@@ -878,7 +879,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
@@ -913,7 +914,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -957,7 +958,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
@@ -1009,7 +1010,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Object-WithAttributeName": {
@@ -1050,7 +1051,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
@@ -1083,7 +1084,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
@@ -1119,7 +1120,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
@@ -1163,13 +1164,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
@@ -1221,13 +1222,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
@@ -1296,13 +1297,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
@@ -1379,13 +1380,13 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName": {
@@ -1425,7 +1426,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
@@ -1467,7 +1468,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-String-null": {
@@ -1494,7 +1495,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-String-unknown": {
@@ -1521,7 +1522,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Unknown: true},
 		},
 		"WithAttributeName-String-value": {
@@ -1548,7 +1549,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Value: "value"},
 		},
 		"AttrTypeWithValidateError": {
@@ -1575,9 +1576,9 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      nil,
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			config: Config{
@@ -1603,9 +1604,9 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      testtypes.String{String: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeInt64WithValidateError-nested-missing-in-config": {
 			config: Config{
@@ -1632,7 +1633,7 @@ func TestConfigGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("parent").WithAttributeName("test"),
+			path:          path.Root("parent").AtName("test"),
 			expected:      types.Int64{Null: true},
 			expectedDiags: nil,
 		},
@@ -0,0 +1,98 @@
+package tfsdk
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// correlationIDLogKey is the log field containing the correlation ID.
+const correlationIDLogKey = "tf_correlation_id"
+
+type correlationIDContextKey struct{}
+
+// CorrelationIDFromContext returns the unique identifier generated by the
+// framework for the RPC being served, or an empty string outside of an RPC.
+// Providers can send the identifier to their API, such as in a request
+// header, so API logs can be matched with framework logs and, if enabled via
+// ServeOpts, diagnostics shown to practitioners.
+func CorrelationIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDContextKey{}).(string)
+
+	return id
+}
+
+// contextWithCorrelationID returns a new context including a newly generated
+// correlation ID, which is also added to framework logs.
+func contextWithCorrelationID(ctx context.Context) context.Context {
+	id, err := newCorrelationID()
+
+	if err != nil {
+		tfsdklog.Warn(ctx, "unable to generate correlation ID", "error", err)
+		return ctx
+	}
+
+	ctx = context.WithValue(ctx, correlationIDContextKey{}, id)
+
+	return tfsdklog.With(ctx, correlationIDLogKey, id)
+}
+
+// newCorrelationID returns a random version 4 UUID.
+func newCorrelationID() (string, error) {
+	b := make([]byte, 16)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:]), nil
+}
+
+// diagnosticsWithCorrelationID returns the diagnostics with the correlation
+// ID from the context appended to each detail.
+func diagnosticsWithCorrelationID(ctx context.Context, diags diag.Diagnostics) diag.Diagnostics {
+	id := CorrelationIDFromContext(ctx)
+
+	if id == "" || len(diags) == 0 {
+		return diags
+	}
+
+	result := make(diag.Diagnostics, 0, len(diags))
+
+	for _, d := range diags {
+		var newDiag diag.Diagnostic
+
+		detail := d.Detail() + "\n\nCorrelation ID: " + id
+
+		switch d.Severity() {
+		case diag.SeverityWarning:
+			newDiag = diag.NewWarningDiagnostic(d.Summary(), detail)
+		default:
+			newDiag = diag.NewErrorDiagnostic(d.Summary(), detail)
+		}
+
+		if pathDiag, ok := d.(diag.DiagnosticWithPath); ok {
+			newDiag = diag.WithPath(pathDiag.Path(), newDiag)
+		}
+
+		result = append(result, newDiag)
+	}
+
+	return result
+}
+
+// correlateDiagnostics returns the diagnostics with the correlation ID
+// appended to each detail, if enabled via ServeOpts.
+func (s *server) correlateDiagnostics(ctx context.Context, diags diag.Diagnostics) diag.Diagnostics {
+	if !s.correlationIDInDiagnostics {
+		return diags
+	}
+
+	return diagnosticsWithCorrelationID(ctx, diags)
+}
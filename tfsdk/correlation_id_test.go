@@ -0,0 +1,92 @@
+package tfsdk
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestCorrelationIDFromContext(t *testing.T) {
+	t.Parallel()
+
+	if got := CorrelationIDFromContext(context.Background()); got != "" {
+		t.Errorf("expected no correlation ID, got %q", got)
+	}
+
+	s := &server{}
+	firstCtx, firstDone := s.registerContext(context.Background())
+	defer firstDone()
+	secondCtx, secondDone := s.registerContext(context.Background())
+	defer secondDone()
+
+	first := CorrelationIDFromContext(firstCtx)
+	second := CorrelationIDFromContext(secondCtx)
+
+	uuidRegexp := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	if !uuidRegexp.MatchString(first) {
+		t.Errorf("expected UUID correlation ID, got %q", first)
+	}
+
+	if first == second {
+		t.Errorf("expected unique correlation IDs, got %q twice", first)
+	}
+}
+
+func TestServerCorrelateDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(context.Background(), correlationIDContextKey{}, "test-id")
+	path := path.Root("test")
+	diags := diag.Diagnostics{
+		diag.NewErrorDiagnostic("Error Summary", "Error detail."),
+		diag.NewAttributeWarningDiagnostic(path, "Warning Summary", "Warning detail."),
+	}
+
+	testCases := map[string]struct {
+		server   *server
+		ctx      context.Context
+		expected diag.Diagnostics
+	}{
+		"disabled": {
+			server:   &server{},
+			ctx:      ctx,
+			expected: diags,
+		},
+		"enabled": {
+			server: &server{
+				correlationIDInDiagnostics: true,
+			},
+			ctx: ctx,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error Summary", "Error detail.\n\nCorrelation ID: test-id"),
+				diag.NewAttributeWarningDiagnostic(path, "Warning Summary", "Warning detail.\n\nCorrelation ID: test-id"),
+			},
+		},
+		"enabled-no-correlation-id": {
+			server: &server{
+				correlationIDInDiagnostics: true,
+			},
+			ctx:      context.Background(),
+			expected: diags,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.server.correlateDiagnostics(tc.ctx, diags)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
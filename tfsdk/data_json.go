@@ -0,0 +1,112 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/attrjson"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ json.Marshaler   = Config{}
+	_ json.Unmarshaler = &Config{}
+	_ json.Marshaler   = Plan{}
+	_ json.Unmarshaler = &Plan{}
+	_ json.Marshaler   = State{}
+	_ json.Unmarshaler = &State{}
+)
+
+// MarshalJSON returns the configuration data in the attrjson representation,
+// such as for test fixtures and bug reports. The Schema is not included.
+func (c Config) MarshalJSON() ([]byte, error) {
+	return schemaDataMarshalJSON(context.Background(), c.Schema, c.Raw)
+}
+
+// UnmarshalJSON sets the configuration data from the attrjson
+// representation. The Schema must already be set, since it determines the
+// types of the data.
+func (c *Config) UnmarshalJSON(data []byte) error {
+	raw, err := schemaDataUnmarshalJSON(context.Background(), c.Schema, data)
+
+	if err != nil {
+		return err
+	}
+
+	c.Raw = raw
+
+	return nil
+}
+
+// MarshalJSON returns the plan data in the attrjson representation, such as
+// for test fixtures and bug reports. The Schema is not included.
+func (p Plan) MarshalJSON() ([]byte, error) {
+	return schemaDataMarshalJSON(context.Background(), p.Schema, p.Raw)
+}
+
+// UnmarshalJSON sets the plan data from the attrjson representation. The
+// Schema must already be set, since it determines the types of the data.
+func (p *Plan) UnmarshalJSON(data []byte) error {
+	raw, err := schemaDataUnmarshalJSON(context.Background(), p.Schema, data)
+
+	if err != nil {
+		return err
+	}
+
+	p.Raw = raw
+
+	return nil
+}
+
+// MarshalJSON returns the state data in the attrjson representation, such as
+// for test fixtures and bug reports. The Schema is not included.
+func (s State) MarshalJSON() ([]byte, error) {
+	return schemaDataMarshalJSON(context.Background(), s.Schema, s.Raw)
+}
+
+// UnmarshalJSON sets the state data from the attrjson representation. The
+// Schema must already be set, since it determines the types of the data.
+func (s *State) UnmarshalJSON(data []byte) error {
+	raw, err := schemaDataUnmarshalJSON(context.Background(), s.Schema, data)
+
+	if err != nil {
+		return err
+	}
+
+	s.Raw = raw
+
+	return nil
+}
+
+// schemaDataMarshalJSON returns the attrjson representation of raw, whose
+// type must match the schema. Unset data is represented as null.
+func schemaDataMarshalJSON(ctx context.Context, schema Schema, raw tftypes.Value) ([]byte, error) {
+	if raw.Type() == nil {
+		return []byte("null"), nil
+	}
+
+	value, err := schema.AttributeType().ValueFromTerraform(ctx, raw)
+
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert data with schema: %w", err)
+	}
+
+	return attrjson.Marshal(ctx, value)
+}
+
+// schemaDataUnmarshalJSON returns the data in the attrjson representation as
+// a value of the schema type.
+func schemaDataUnmarshalJSON(ctx context.Context, schema Schema, data []byte) (tftypes.Value, error) {
+	if len(schema.Attributes) == 0 && len(schema.Blocks) == 0 {
+		return tftypes.Value{}, fmt.Errorf("cannot unmarshal JSON without a Schema")
+	}
+
+	value, err := attrjson.Unmarshal(ctx, schema.AttributeType(), data)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return value.ToTerraformValue(ctx)
+}
@@ -0,0 +1,124 @@
+package tfsdk
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateJSON(t *testing.T) {
+	t.Parallel()
+
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"tags": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"tags": tftypes.List{ElementType: tftypes.String},
+		},
+	}
+
+	testCases := map[string]struct {
+		state    State
+		expected string
+	}{
+		"known": {
+			state: State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "first"),
+					}),
+				}),
+				Schema: schema,
+			},
+			expected: `{"id":"test-id","tags":["first"]}`,
+		},
+		"null-and-unknown": {
+			state: State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"tags": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				}),
+				Schema: schema,
+			},
+			expected: `{"id":{"$unknown":true},"tags":null}`,
+		},
+		"null": {
+			state: State{
+				Raw:    tftypes.NewValue(schemaType, nil),
+				Schema: schema,
+			},
+			expected: `null`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(tc.state)
+
+			if err != nil {
+				t.Fatalf("unexpected marshal error: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), tc.expected); diff != "" {
+				t.Errorf("unexpected marshal difference: %s", diff)
+			}
+
+			roundTrip := State{
+				Schema: schema,
+			}
+
+			if err := json.Unmarshal(got, &roundTrip); err != nil {
+				t.Fatalf("unexpected unmarshal error: %s", err)
+			}
+
+			if diff := cmp.Diff(roundTrip.Raw, tc.state.Raw); diff != "" {
+				t.Errorf("unexpected unmarshal difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestConfigUnmarshalJSONMissingSchema(t *testing.T) {
+	t.Parallel()
+
+	var config Config
+
+	err := json.Unmarshal([]byte(`{"id":"test"}`), &config)
+
+	if err == nil || err.Error() != "cannot unmarshal JSON without a Schema" {
+		t.Fatalf("expected missing schema error, got: %v", err)
+	}
+}
+
+func TestPlanJSONUnset(t *testing.T) {
+	t.Parallel()
+
+	got, err := json.Marshal(Plan{})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(got) != "null" {
+		t.Errorf("expected null, got: %s", got)
+	}
+}
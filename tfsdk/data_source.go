@@ -11,6 +11,10 @@ import (
 // return an instance of it in the map returned by Provider.GetDataSources.
 type DataSourceType interface {
 	// GetSchema returns the schema for this data source.
+	//
+	// The schema is only constructed when first needed and is then reused
+	// for the lifetime of the provider server, unless an error diagnostic
+	// is returned. The schema must not change between calls.
 	GetSchema(context.Context) (Schema, diag.Diagnostics)
 
 	// NewDataSource instantiates a new DataSource of this DataSourceType.
@@ -26,3 +30,19 @@ type DataSource interface {
 	// ReadDataSourceResponse.
 	Read(context.Context, ReadDataSourceRequest, *ReadDataSourceResponse)
 }
+
+// DataSourceWithConfigure represents a data source instance with a Configure
+// function.
+type DataSourceWithConfigure interface {
+	DataSource
+
+	// Configure is called after NewDataSource, before any other method of
+	// the data source, with the DataSourceData set by the Provider's
+	// Configure method. This allows data sources to receive an API client,
+	// for example, without depending on the concrete type of the Provider.
+	//
+	// ConfigureDataSourceRequest.ProviderData is nil when the provider has
+	// not been configured yet, such as during configuration validation, so
+	// implementations must not return an error in that case.
+	Configure(context.Context, ConfigureDataSourceRequest, *ConfigureDataSourceResponse)
+}
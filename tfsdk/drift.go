@@ -0,0 +1,115 @@
+package tfsdk
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// DriftOptions configures DriftedPaths.
+type DriftOptions struct {
+	// Log enables logging each drifted path with the provider logger at
+	// the info level. Only the paths are logged, never the values, so
+	// Sensitive values are not exposed.
+	Log bool
+}
+
+// DriftedPaths returns every path where val, the resource data freshly read
+// from the remote API, differs from the prior state. Paths are sorted by
+// their steps with list indexes compared numerically, so list[2] sorts
+// before list[10]. The val must be a Go value the state can be set to, such
+// as a struct with `tfsdk` tags mapping to the schema. Changes within sets
+// are reported as a change of the entire set, since set elements cannot be
+// correlated between the prior state and val. It returns no paths when
+// there is no prior state, such as when the resource is being imported.
+//
+// This is intended for Read implementations which report or react to
+// changes made outside Terraform before setting the new state.
+func (r ReadResourceRequest) DriftedPaths(ctx context.Context, val interface{}, opts DriftOptions) (path.Paths, diag.Diagnostics) {
+	newState := State{
+		Raw:    tftypes.NewValue(r.State.Schema.TerraformType(ctx), nil),
+		Schema: r.State.Schema,
+	}
+
+	diags := newState.Set(ctx, val)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	paths, driftDiags := driftedPaths(ctx, r.State.Raw, newState.Raw)
+
+	diags.Append(driftDiags...)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if opts.Log {
+		for _, p := range paths {
+			tflog.Info(ctx, "Detected resource drift", "path", p.String())
+		}
+	}
+
+	return paths, diags
+}
+
+// driftedPaths returns the sorted paths of the deepest differences between
+// the prior and newly read state values.
+func driftedPaths(_ context.Context, prior, current tftypes.Value) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if prior.IsNull() || current.IsNull() {
+		return nil, nil
+	}
+
+	var paths path.Paths
+
+	err := diffValues(tftypes.NewAttributePath(), prior, current, func(p *tftypes.AttributePath, _ tftypes.Value) {
+		paths = append(paths, fromtftypes.AttributePath(p))
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Drift Detection Error",
+			"An unexpected error was encountered trying to compare the prior state and the read resource data. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		return pathStepsLess(paths[i].Steps(), paths[j].Steps())
+	})
+
+	return paths, diags
+}
+
+// pathStepsLess returns true if a sorts before b. Steps are compared in
+// order, with list indexes compared numerically and other steps compared by
+// their string representation, and a path sorts before the paths beneath it.
+func pathStepsLess(a, b path.PathSteps) bool {
+	for idx := 0; idx < len(a) && idx < len(b); idx++ {
+		aIndex, aOk := a[idx].(path.PathStepElementKeyInt)
+		bIndex, bOk := b[idx].(path.PathStepElementKeyInt)
+
+		if aOk && bOk {
+			if aIndex != bIndex {
+				return aIndex < bIndex
+			}
+
+			continue
+		}
+
+		if aStep, bStep := a[idx].String(), b[idx].String(); aStep != bStep {
+			return aStep < bStep
+		}
+	}
+
+	return len(a) < len(b)
+}
@@ -0,0 +1,215 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReadResourceRequestDriftedPaths(t *testing.T) {
+	t.Parallel()
+
+	type nestedModel struct {
+		Name types.String `tfsdk:"name"`
+	}
+
+	type resourceModel struct {
+		ID     types.String      `tfsdk:"id"`
+		Name   types.String      `tfsdk:"name"`
+		Nested nestedModel       `tfsdk:"nested"`
+		Tags   map[string]string `tfsdk:"tags"`
+	}
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"name":   tftypes.String,
+			"nested": nestedType,
+			"tags":   tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+	priorState := State{
+		Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "test-id"),
+			"name": tftypes.NewValue(tftypes.String, "test-name"),
+			"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "test-nested"),
+			}),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, "value"),
+			}),
+		}),
+		Schema: schema,
+	}
+	model := resourceModel{
+		ID:     types.String{Value: "test-id"},
+		Name:   types.String{Value: "test-name"},
+		Nested: nestedModel{Name: types.String{Value: "test-nested"}},
+		Tags:   map[string]string{"key": "value"},
+	}
+
+	testCases := map[string]struct {
+		state         State
+		val           interface{}
+		opts          DriftOptions
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"no-drift": {
+			state: priorState,
+			val:   model,
+		},
+		"drift": {
+			state: priorState,
+			val: resourceModel{
+				ID:     types.String{Value: "test-id"},
+				Name:   types.String{Value: "changed"},
+				Nested: nestedModel{Name: types.String{Value: "changed"}},
+				Tags:   map[string]string{"key": "value", "other": "value"},
+			},
+			expected: path.Paths{
+				path.Root("name"),
+				path.Root("nested").AtName("name"),
+				path.Root("tags").AtMapKey("other"),
+			},
+		},
+		"drift-log": {
+			state: priorState,
+			val: resourceModel{
+				ID:     types.String{Value: "test-id"},
+				Name:   types.String{Value: "changed"},
+				Nested: nestedModel{Name: types.String{Value: "test-nested"}},
+				Tags:   map[string]string{},
+			},
+			opts: DriftOptions{Log: true},
+			expected: path.Paths{
+				path.Root("name"),
+				path.Root("tags").AtMapKey("key"),
+			},
+		},
+		"null-prior-state": {
+			state: State{
+				Raw:    tftypes.NewValue(schemaType, nil),
+				Schema: schema,
+			},
+			val: model,
+		},
+		"nil-val": {
+			state: priorState,
+			val:   nil,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"State Read Error",
+					"An unexpected error was encountered trying to write the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"cannot set nil as entire state; to remove a resource from state, call State.RemoveResource, instead",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := ReadResourceRequest{
+				State: tc.state,
+			}
+
+			got, diags := req.DriftedPaths(context.Background(), tc.val, tc.opts)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDriftedPathsOrder(t *testing.T) {
+	t.Parallel()
+
+	listType := tftypes.List{ElementType: tftypes.String}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list": listType,
+			"name": tftypes.String,
+		},
+	}
+	value := func(name string, changed ...int) tftypes.Value {
+		elems := make([]tftypes.Value, 0, 12)
+
+		for idx := 0; idx < 12; idx++ {
+			elem := "unchanged"
+
+			for _, changedIdx := range changed {
+				if idx == changedIdx {
+					elem = "changed"
+				}
+			}
+
+			elems = append(elems, tftypes.NewValue(tftypes.String, elem))
+		}
+
+		return tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"list": tftypes.NewValue(listType, elems),
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+
+	got, diags := driftedPaths(context.Background(), value("unchanged"), value("changed", 11, 2, 10))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	expected := path.Paths{
+		path.Root("list").AtListIndex(2),
+		path.Root("list").AtListIndex(10),
+		path.Root("list").AtListIndex(11),
+		path.Root("name"),
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
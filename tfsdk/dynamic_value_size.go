@@ -0,0 +1,71 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// DefaultMaxDynamicValueSize is the default ServeOpts.MaxDynamicValueSize,
+// which is the 256MB maximum size of gRPC messages exchanged between
+// Terraform CLI and providers.
+const DefaultMaxDynamicValueSize = 256 << 20
+
+// dynamicValueSizeWarningRatio is the fraction of the maximum size above
+// which a warning diagnostic is returned.
+const dynamicValueSizeWarningRatio = 0.75
+
+// checkDynamicValueSize returns an error diagnostic if the encoded value is
+// larger than the maximum size, in which case the caller must not return
+// the value, since Terraform would fail to receive the response without
+// any diagnostics. It returns a warning diagnostic if the value is
+// approaching the maximum size.
+//
+// Only the single value is measured. The rest of the response, such as
+// private state and diagnostics, also counts towards the message size, so a
+// value under the maximum size may still not be received.
+func (s *server) checkDynamicValueSize(ctx context.Context, description string, value *tfprotov6.DynamicValue) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if value == nil || s.maxDynamicValueSize < 0 {
+		return diags
+	}
+
+	maxSize := s.maxDynamicValueSize
+
+	if maxSize == 0 {
+		maxSize = DefaultMaxDynamicValueSize
+	}
+
+	size := len(value.MsgPack)
+
+	if size == 0 {
+		size = len(value.JSON)
+	}
+
+	advice := "Large values, such as documents, can be stored compressed by setting the attribute Codec to GzipBase64Codec(), or omitted from state."
+
+	switch {
+	case size > maxSize:
+		tfsdklog.Error(ctx, "value exceeds maximum size", "description", description, "size", size, "max_size", maxSize)
+
+		diags.AddError(
+			"Value Too Large",
+			fmt.Sprintf("The %s is %d bytes, which is larger than the %d byte limit of provider responses Terraform accepts, so it cannot be returned. %s\n\n", description, size, maxSize, advice)+
+				"This is always an error in the provider. Please report this to the provider developer.",
+		)
+	case float64(size) > float64(maxSize)*dynamicValueSizeWarningRatio:
+		tfsdklog.Warn(ctx, "value approaching maximum size", "description", description, "size", size, "max_size", maxSize)
+
+		diags.AddWarning(
+			"Value Approaching Size Limit",
+			fmt.Sprintf("The %s is %d bytes, which is approaching the %d byte limit of provider responses Terraform accepts. %s\n\n", description, size, maxSize, advice)+
+				"Please report this to the provider developer.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,192 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestServerApplyResourceChangeMaxDynamicValueSize(t *testing.T) {
+	t.Parallel()
+
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	planned, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "created"),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	prior, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	size := len(planned.MsgPack)
+
+	testCases := map[string]struct {
+		maxSize           int
+		expectedSummaries []string
+		expectedNewState  *tfprotov6.DynamicValue
+	}{
+		"default": {
+			expectedNewState: &planned,
+		},
+		"disabled": {
+			maxSize:          -1,
+			expectedNewState: &planned,
+		},
+		"under": {
+			maxSize:          size * 2,
+			expectedNewState: &planned,
+		},
+		"approaching": {
+			maxSize:           size,
+			expectedSummaries: []string{"Value Approaching Size Limit"},
+			expectedNewState:  &planned,
+		},
+		"over": {
+			maxSize:           size - 1,
+			expectedSummaries: []string{"Value Too Large"},
+			expectedNewState:  &prior,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := testServeProviderWithResources{
+				testServeProvider: &testServeProvider{},
+				resources: []func() ResourceWithMetadata{
+					func() ResourceWithMetadata {
+						return testPostApplyResource{
+							testResourceWithMetadata: testResourceWithMetadata{typeName: "test_post_apply"},
+						}
+					},
+				},
+			}
+			testServer := NewProtocol6ServerWithOptions(provider, ServeOpts{MaxDynamicValueSize: tc.maxSize})
+
+			got, err := testServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				Config:       &planned,
+				PlannedState: &planned,
+				PriorState:   &prior,
+				TypeName:     "test_post_apply",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var summaries []string
+
+			for _, d := range got.Diagnostics {
+				summaries = append(summaries, d.Summary)
+			}
+
+			if diff := cmp.Diff(summaries, tc.expectedSummaries); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.NewState, tc.expectedNewState); diff != "" {
+				t.Errorf("unexpected new state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerImportResourceStateMaxDynamicValueSize(t *testing.T) {
+	t.Parallel()
+
+	imported, err := tfprotov6.NewDynamicValue(testServeResourceTypeImportStateTftype, tftypes.NewValue(testServeResourceTypeImportStateTftype, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "test"),
+		"optional_string": tftypes.NewValue(tftypes.String, nil),
+		"required_string": tftypes.NewValue(tftypes.String, nil),
+	}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	size := len(imported.MsgPack)
+
+	testCases := map[string]struct {
+		maxSize           int
+		expectedSummaries []string
+		expectedImported  []*tfprotov6.ImportedResource
+	}{
+		"default": {
+			expectedImported: []*tfprotov6.ImportedResource{
+				{
+					State:    &imported,
+					TypeName: "test_import_state",
+				},
+			},
+		},
+		"approaching": {
+			maxSize:           size,
+			expectedSummaries: []string{"Value Approaching Size Limit"},
+			expectedImported: []*tfprotov6.ImportedResource{
+				{
+					State:    &imported,
+					TypeName: "test_import_state",
+				},
+			},
+		},
+		"over": {
+			maxSize:           size - 1,
+			expectedSummaries: []string{"Value Too Large"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &testServeProvider{
+				importStateFunc: func(ctx context.Context, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+					ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+				},
+			}
+			testServer := NewProtocol6ServerWithOptions(provider, ServeOpts{MaxDynamicValueSize: tc.maxSize})
+
+			got, err := testServer.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+				ID:       "test",
+				TypeName: "test_import_state",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var summaries []string
+
+			for _, d := range got.Diagnostics {
+				summaries = append(summaries, d.Summary)
+			}
+
+			if diff := cmp.Diff(summaries, tc.expectedSummaries); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got.ImportedResources, tc.expectedImported); diff != "" {
+				t.Errorf("unexpected imported resources difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package tfsdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// frameworkLogSubsystem is the tfsdklog subsystem which logs the phases of
+// request handling. Its level is set by the TF_LOG_SDK_FRAMEWORK
+// environment variable, and defaults to the TF_LOG_SDK level.
+const frameworkLogSubsystem = "framework"
+
+const (
+	frameworkLogKeyPhase      = "tf_phase"
+	frameworkLogKeyOperation  = "tf_operation"
+	frameworkLogKeyMethod     = "tf_method"
+	frameworkLogKeyTypeName   = "tf_type_name"
+	frameworkLogKeyDurationMs = "tf_duration_ms"
+	frameworkLogKeyHasErrors  = "tf_has_errors"
+)
+
+// contextWithFrameworkLogger returns the context with the framework log
+// subsystem logger, which includes the arguments of the SDK logger of the
+// context, such as the correlation ID.
+func contextWithFrameworkLogger(ctx context.Context) context.Context {
+	return tfsdklog.NewSubsystem(ctx, frameworkLogSubsystem, tfsdklog.WithLevelFromEnv("TF_LOG_SDK", "FRAMEWORK"))
+}
+
+// phase returns the request handling phase of the span, such as "plan" or
+// "apply", for grouping logs.
+func (s TraceSpan) phase() string {
+	switch s.Method {
+	case InterceptedMethodCreateResource, InterceptedMethodUpdateResource, InterceptedMethodDeleteResource:
+		return "apply"
+	case InterceptedMethodReadResource, InterceptedMethodReadDataSource:
+		return "read"
+	}
+
+	switch s.Operation {
+	case OperationGetProviderSchema:
+		return "schema"
+	case OperationValidateProviderConfig, OperationValidateResourceConfig, OperationValidateDataResourceConfig:
+		return "validate"
+	case OperationConfigureProvider:
+		return "configure"
+	case OperationUpgradeResourceState:
+		return "upgrade"
+	case OperationReadResource, OperationReadDataSource:
+		return "read"
+	case OperationPlanResourceChange:
+		return "plan"
+	case OperationApplyResourceChange:
+		return "apply"
+	case OperationImportResourceState:
+		return "import"
+	}
+
+	return string(s.Operation)
+}
+
+// logPhaseStart logs the start of the span to the framework log subsystem,
+// returning a function which logs its end with its duration, measured with
+// the Clock of the request.
+func logPhaseStart(ctx context.Context, span TraceSpan) func(diag.Diagnostics) {
+	args := []interface{}{
+		frameworkLogKeyPhase, span.phase(),
+		frameworkLogKeyTypeName, span.TypeName,
+	}
+
+	if span.Method != "" {
+		args = append(args, frameworkLogKeyMethod, string(span.Method))
+	} else {
+		args = append(args, frameworkLogKeyOperation, string(span.Operation))
+	}
+
+	tfsdklog.SubsystemTrace(ctx, frameworkLogSubsystem, "Starting "+span.Name(), args...)
+
+	clock := ClockFromContext(ctx)
+	start := clock.Now()
+
+	return func(diags diag.Diagnostics) {
+		tfsdklog.SubsystemDebug(ctx, frameworkLogSubsystem, "Finished "+span.Name(), append(args,
+			frameworkLogKeyDurationMs, clock.Now().Sub(start).Milliseconds(),
+			frameworkLogKeyHasErrors, diags.HasError(),
+		)...)
+	}
+}
+
+// logSchemaFetch logs the duration of a GetSchema call, which only happens
+// once per type, to the framework log subsystem. The start must be from
+// ClockFromContext.
+func logSchemaFetch(ctx context.Context, typeName string, start time.Time, diags diag.Diagnostics) {
+	tfsdklog.SubsystemDebug(ctx, frameworkLogSubsystem, "Fetched schema",
+		frameworkLogKeyPhase, "schema",
+		frameworkLogKeyTypeName, typeName,
+		frameworkLogKeyDurationMs, ClockFromContext(ctx).Now().Sub(start).Milliseconds(),
+		frameworkLogKeyHasErrors, diags.HasError(),
+	)
+}
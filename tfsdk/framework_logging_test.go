@@ -0,0 +1,55 @@
+package tfsdk
+
+import (
+	"testing"
+)
+
+func TestTraceSpanPhase(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		span     TraceSpan
+		expected string
+	}{
+		"get-provider-schema": {
+			span:     TraceSpan{Operation: OperationGetProviderSchema},
+			expected: "schema",
+		},
+		"validate-resource-config": {
+			span:     TraceSpan{Operation: OperationValidateResourceConfig, TypeName: "test_one"},
+			expected: "validate",
+		},
+		"plan-resource-change": {
+			span:     TraceSpan{Operation: OperationPlanResourceChange, TypeName: "test_one"},
+			expected: "plan",
+		},
+		"apply-resource-change": {
+			span:     TraceSpan{Operation: OperationApplyResourceChange, TypeName: "test_one"},
+			expected: "apply",
+		},
+		"create-resource": {
+			span:     TraceSpan{Method: InterceptedMethodCreateResource, TypeName: "test_one"},
+			expected: "apply",
+		},
+		"read-data-source": {
+			span:     TraceSpan{Method: InterceptedMethodReadDataSource, TypeName: "test_one"},
+			expected: "read",
+		},
+		"unknown-operation": {
+			span:     TraceSpan{Operation: Operation("GetMetadata")},
+			expected: "GetMetadata",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.span.phase(); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// getAttributeValues retrieves the values found at each of the paths of raw
+// with a single walk of the data, rather than walking it once per path. The
+// returned map is keyed by the String() of each path. Values which cannot be
+// reached, such as attributes of a null object, are returned as null values
+// of the attribute type.
+//
+// The summary and dataName describe the raw data in diagnostics, such as
+// "State Read Error" and "state".
+func getAttributeValues(ctx context.Context, schema Schema, raw tftypes.Value, paths path.Paths, summary string, dataName string) (map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attrTypes := make([]attr.Type, len(paths))
+	expressions := make([]path.Expression, len(paths))
+
+	for idx, attributePath := range paths {
+		attrType, err := schema.AttributeTypeAtPath(attributePath)
+
+		if err != nil {
+			diags.AddAttributeError(
+				attributePath,
+				summary,
+				"An unexpected error was encountered trying to read an attribute from the "+dataName+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"error getting attribute type in schema: "+err.Error(),
+			)
+			continue
+		}
+
+		attrTypes[idx] = attrType
+		expressions[idx] = attributePath.Expression()
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	tfValues := make(map[int]tftypes.Value, len(paths))
+
+	// A null value, such as the state of a resource being created, has no
+	// values beneath it to walk.
+	if raw.IsNull() {
+		raw = tftypes.NewValue(schema.TerraformType(ctx), nil)
+	}
+
+	err := tftypes.Walk(raw, func(tfPath *tftypes.AttributePath, tfValue tftypes.Value) (bool, error) {
+		valuePath := fromtftypes.AttributePath(tfPath)
+		next := false
+
+		for idx, expression := range expressions {
+			if expression.Matches(valuePath) {
+				tfValues[idx] = tfValue
+			}
+
+			if expression.MatchesParent(valuePath) {
+				next = true
+			}
+		}
+
+		return next, nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			summary,
+			"An unexpected error was encountered trying to read attributes from the "+dataName+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	result := make(map[string]attr.Value, len(paths))
+
+	for idx, attributePath := range paths {
+		attrType := attrTypes[idx]
+		tfValue, ok := tfValues[idx]
+
+		if !ok {
+			tfValue = tftypes.NewValue(attrType.TerraformType(ctx), nil)
+		}
+
+		if attrTypeWithValidate, ok := attrType.(attr.TypeWithValidate); ok {
+			validateDiags := attrTypeWithValidate.Validate(ctx, tfValue, attributePath)
+
+			diags.Append(validateDiags...)
+
+			if validateDiags.HasError() {
+				continue
+			}
+		}
+
+		attrValue, err := attrType.ValueFromTerraform(ctx, tfValue)
+
+		if err != nil {
+			diags.AddAttributeError(
+				attributePath,
+				summary,
+				"An unexpected error was encountered trying to read an attribute from the "+dataName+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			continue
+		}
+
+		result[attributePath.String()] = attrValue
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return result, diags
+}
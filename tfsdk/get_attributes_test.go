@@ -0,0 +1,155 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateGetAttributes(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"list":   tftypes.List{ElementType: nestedType},
+			"nested": nestedType,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"list": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+	raw := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "first"),
+			}),
+		}),
+		"nested": tftypes.NewValue(nestedType, nil),
+	})
+
+	testCases := map[string]struct {
+		raw           tftypes.Value
+		paths         path.Paths
+		expected      map[string]attr.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"empty": {
+			raw:      raw,
+			expected: map[string]attr.Value{},
+		},
+		"multiple": {
+			raw: raw,
+			paths: path.Paths{
+				path.Root("id"),
+				path.Root("list").AtListIndex(0).AtName("name"),
+				path.Root("list").AtListIndex(0),
+			},
+			expected: map[string]attr.Value{
+				"id":           types.String{Unknown: true},
+				"list[0].name": types.String{Value: "first"},
+				"list[0]": types.Object{
+					Attrs: map[string]attr.Value{
+						"name": types.String{Value: "first"},
+					},
+					AttrTypes: map[string]attr.Type{
+						"name": types.StringType,
+					},
+				},
+			},
+		},
+		"unreachable": {
+			raw: raw,
+			paths: path.Paths{
+				path.Root("list").AtListIndex(1).AtName("name"),
+				path.Root("nested").AtName("name"),
+			},
+			expected: map[string]attr.Value{
+				"list[1].name": types.String{Null: true},
+				"nested.name":  types.String{Null: true},
+			},
+		},
+		"null-state": {
+			raw: tftypes.NewValue(schemaType, nil),
+			paths: path.Paths{
+				path.Root("id"),
+			},
+			expected: map[string]attr.Value{
+				"id": types.String{Null: true},
+			},
+		},
+		"invalid-path": {
+			raw: raw,
+			paths: path.Paths{
+				path.Root("id"),
+				path.Root("missing"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"State Read Error",
+					"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"error getting attribute type in schema: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := State{
+				Raw:    tc.raw,
+				Schema: schema,
+			}
+
+			got, diags := state.GetAttributes(context.Background(), tc.paths)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
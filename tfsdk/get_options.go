@@ -0,0 +1,29 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+)
+
+// GetOptions is a collection of toggles to control the behavior of
+// GetWithOptions.
+type GetOptions struct {
+	// UnhandledNullAsEmpty controls what happens when a null value needs
+	// to be put in a type that has no way to preserve that distinction,
+	// such as a string. When set to true, the type's empty value will be
+	// used. When set to false, an error will be returned.
+	UnhandledNullAsEmpty bool
+
+	// UnhandledUnknownAsEmpty controls what happens when an unknown value
+	// needs to be put in a type that has no way to preserve that
+	// distinction, such as a string. When set to true, the type's empty
+	// value will be used. When set to false, an error will be returned.
+	UnhandledUnknownAsEmpty bool
+}
+
+// reflectOptions returns the reflection options for the GetOptions.
+func (o GetOptions) reflectOptions() reflect.Options {
+	return reflect.Options{
+		UnhandledNullAsEmpty:    o.UnhandledNullAsEmpty,
+		UnhandledUnknownAsEmpty: o.UnhandledUnknownAsEmpty,
+	}
+}
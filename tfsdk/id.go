@@ -0,0 +1,103 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IDAttributeName is the name of the attribute which, by convention,
+// uniquely identifies a resource.
+const IDAttributeName = "id"
+
+// IDAttribute returns the conventional definition of the "id" attribute,
+// which should be added to a resource Schema as IDAttributeName. The value
+// is a string computed by the provider during Create and preserved in plans
+// afterwards via the UseStateForUnknown plan modifier.
+//
+// When the provider is served with ServeOpts ValidateCreatedID enabled, an
+// error is returned after a Create without error diagnostics if it does not
+// set a non-empty value for this attribute.
+func IDAttribute() Attribute {
+	return Attribute{
+		Type:        types.StringType,
+		Computed:    true,
+		Description: "The identifier of the resource.",
+		PlanModifiers: AttributePlanModifiers{
+			UseStateForUnknown(),
+		},
+	}
+}
+
+// idAttributePath returns the path to the attribute named IDAttributeName.
+func idAttributePath() path.Path {
+	return path.Root(IDAttributeName)
+}
+
+// GetID returns the value of the attribute named IDAttributeName. An empty
+// string is returned if the value or entire data is null, or the value is
+// unknown.
+func (s State) GetID(ctx context.Context) (string, diag.Diagnostics) {
+	if s.Raw.IsNull() {
+		return "", nil
+	}
+
+	var id types.String
+
+	diags := s.GetAttribute(ctx, idAttributePath(), &id)
+
+	return id.Value, diags
+}
+
+// SetID sets the value of the attribute named IDAttributeName.
+func (s *State) SetID(ctx context.Context, id string) diag.Diagnostics {
+	return s.SetAttribute(ctx, idAttributePath(), id)
+}
+
+// GetID returns the value of the attribute named IDAttributeName. An empty
+// string is returned if the value or entire data is null, or the value is
+// unknown.
+func (p Plan) GetID(ctx context.Context) (string, diag.Diagnostics) {
+	if p.Raw.IsNull() {
+		return "", nil
+	}
+
+	var id types.String
+
+	diags := p.GetAttribute(ctx, idAttributePath(), &id)
+
+	return id.Value, diags
+}
+
+// validateCreatedID returns an error if the schema declares an attribute
+// named IDAttributeName and the state after Create does not contain a
+// non-empty value for it.
+func validateCreatedID(ctx context.Context, state State) diag.Diagnostics {
+	if _, ok := state.Schema.Attributes[IDAttributeName]; !ok {
+		return nil
+	}
+
+	// The resource was not created, which is reported by other diagnostics.
+	if state.Raw.IsNull() {
+		return nil
+	}
+
+	id, diags := state.GetID(ctx)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if id == "" {
+		diags.AddAttributeError(
+			idAttributePath(),
+			"Missing Resource Identifier",
+			"The resource Create method did not set a value for the \""+IDAttributeName+"\" attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Create must set a non-empty identifier, such as with the State SetID method.",
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,166 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateID(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			IDAttributeName: IDAttribute(),
+		},
+	}
+	state := State{
+		Raw:    tftypes.NewValue(schema.TerraformType(ctx), nil),
+		Schema: schema,
+	}
+
+	id, diags := state.GetID(ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if id != "" {
+		t.Errorf("expected empty id, got %q", id)
+	}
+
+	if diags := state.SetID(ctx, "test-id"); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	id, diags = state.GetID(ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if id != "test-id" {
+		t.Errorf("expected id %q, got %q", "test-id", id)
+	}
+
+	plan := Plan{
+		Raw:    state.Raw,
+		Schema: schema,
+	}
+
+	id, diags = plan.GetID(ctx)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if id != "test-id" {
+		t.Errorf("expected plan id %q, got %q", "test-id", id)
+	}
+}
+
+func TestValidateCreatedID(t *testing.T) {
+	t.Parallel()
+
+	idSchema := Schema{
+		Attributes: map[string]Attribute{
+			IDAttributeName: IDAttribute(),
+		},
+	}
+	idType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			IDAttributeName: tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		state         State
+		expectedDiags diag.Diagnostics
+	}{
+		"no-id-attribute": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.String,
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Type:     types.StringType,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+		"null-state": {
+			state: State{
+				Raw:    tftypes.NewValue(idType, nil),
+				Schema: idSchema,
+			},
+		},
+		"id-set": {
+			state: State{
+				Raw: tftypes.NewValue(idType, map[string]tftypes.Value{
+					IDAttributeName: tftypes.NewValue(tftypes.String, "test-id"),
+				}),
+				Schema: idSchema,
+			},
+		},
+		"id-empty": {
+			state: State{
+				Raw: tftypes.NewValue(idType, map[string]tftypes.Value{
+					IDAttributeName: tftypes.NewValue(tftypes.String, ""),
+				}),
+				Schema: idSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(IDAttributeName),
+					"Missing Resource Identifier",
+					"The resource Create method did not set a value for the \"id\" attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Create must set a non-empty identifier, such as with the State SetID method.",
+				),
+			},
+		},
+		"id-null": {
+			state: State{
+				Raw: tftypes.NewValue(idType, map[string]tftypes.Value{
+					IDAttributeName: tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: idSchema,
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root(IDAttributeName),
+					"Missing Resource Identifier",
+					"The resource Create method did not set a value for the \"id\" attribute. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Create must set a non-empty identifier, such as with the State SetID method.",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := validateCreatedID(context.Background(), tc.state)
+
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
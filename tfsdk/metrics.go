@@ -0,0 +1,80 @@
+package tfsdk
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Metrics records a Measurement of the handling of each RPC and each call of
+// the Create, Read, Update, and Delete methods of resources and the Read
+// method of data sources, which allows exporting counters and timers, such
+// as Prometheus metrics from long-running debug servers and test harnesses.
+// Record is called concurrently, so implementations must be safe for
+// concurrent use.
+//
+// For example, Prometheus metrics can be recorded with:
+//
+//	type prometheusMetrics struct {
+//		durations   *prometheus.HistogramVec
+//		diagnostics *prometheus.CounterVec
+//	}
+//
+//	func (m prometheusMetrics) Record(ctx context.Context, measurement tfsdk.Measurement) {
+//		name, typeName := measurement.Span.Name(), measurement.Span.TypeName
+//
+//		m.durations.WithLabelValues(name, typeName).Observe(measurement.Duration.Seconds())
+//		m.diagnostics.WithLabelValues(name, typeName, "error").Add(float64(measurement.ErrorCount))
+//		m.diagnostics.WithLabelValues(name, typeName, "warning").Add(float64(measurement.WarningCount))
+//	}
+type Metrics interface {
+	// Record is called when the handling of each RPC or method call is
+	// complete.
+	Record(context.Context, Measurement)
+}
+
+// Measurement describes the handling of an RPC or a method call.
+type Measurement struct {
+	// Span is the RPC or method call, which is also the span started by
+	// any Tracer.
+	Span TraceSpan
+
+	// Duration is how long handling the RPC or method call took, measured
+	// with the Clock of the request.
+	Duration time.Duration
+
+	// ErrorCount and WarningCount are the number of error and warning
+	// diagnostics returned by the RPC or method call.
+	ErrorCount   int
+	WarningCount int
+}
+
+// startMeasurement returns a function which records the Measurement of the
+// span with the Metrics of the server, if any.
+func (s *server) startMeasurement(ctx context.Context, span TraceSpan) func(context.Context, diag.Diagnostics) {
+	if s.metrics == nil {
+		return func(context.Context, diag.Diagnostics) {}
+	}
+
+	clock := ClockFromContext(ctx)
+	start := clock.Now()
+
+	return func(ctx context.Context, diags diag.Diagnostics) {
+		measurement := Measurement{
+			Span:     span,
+			Duration: clock.Now().Sub(start),
+		}
+
+		for _, d := range diags {
+			switch d.Severity() {
+			case diag.SeverityError:
+				measurement.ErrorCount++
+			case diag.SeverityWarning:
+				measurement.WarningCount++
+			}
+		}
+
+		s.metrics.Record(ctx, measurement)
+	}
+}
@@ -0,0 +1,136 @@
+package tfsdk
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testMetrics records every Measurement.
+type testMetrics struct {
+	mu           sync.Mutex
+	measurements []Measurement
+}
+
+func (m *testMetrics) Record(_ context.Context, measurement Measurement) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.measurements = append(m.measurements, measurement)
+}
+
+// testSlowCreateResource is a testPostApplyResource whose Create takes one
+// second of the request Clock.
+type testSlowCreateResource struct {
+	testPostApplyResource
+}
+
+func (r testSlowCreateResource) Create(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+	<-ClockFromContext(ctx).After(time.Second)
+
+	r.testPostApplyResource.Create(ctx, req, resp)
+}
+
+func TestServerMetrics(t *testing.T) {
+	t.Parallel()
+
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		fail                 bool
+		expectedMeasurements []Measurement
+	}{
+		"success": {
+			expectedMeasurements: []Measurement{
+				{
+					Span:     TraceSpan{Method: InterceptedMethodCreateResource, TypeName: "test_post_apply"},
+					Duration: time.Second,
+				},
+				{
+					Span:     TraceSpan{Operation: OperationApplyResourceChange, TypeName: "test_post_apply"},
+					Duration: time.Second,
+				},
+			},
+		},
+		"error": {
+			fail: true,
+			expectedMeasurements: []Measurement{
+				{
+					Span:       TraceSpan{Method: InterceptedMethodCreateResource, TypeName: "test_post_apply"},
+					Duration:   time.Second,
+					ErrorCount: 1,
+				},
+				{
+					Span:       TraceSpan{Operation: OperationApplyResourceChange, TypeName: "test_post_apply"},
+					Duration:   time.Second,
+					ErrorCount: 1,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			metrics := &testMetrics{}
+			provider := testServeProviderWithResources{
+				testServeProvider: &testServeProvider{},
+				resources: []func() ResourceWithMetadata{
+					func() ResourceWithMetadata {
+						return testSlowCreateResource{
+							testPostApplyResource: testPostApplyResource{
+								testResourceWithMetadata: testResourceWithMetadata{typeName: "test_post_apply"},
+								fail:                     tc.fail,
+							},
+						}
+					},
+				},
+			}
+			testServer := NewProtocol6ServerWithOptions(provider, ServeOpts{
+				Clock:   &testAdvancingClock{now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},
+				Metrics: metrics,
+			})
+
+			planned, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "created"),
+			}))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			prior, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, nil))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = testServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				Config:       &planned,
+				PlannedState: &planned,
+				PriorState:   &prior,
+				TypeName:     "test_post_apply",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(metrics.measurements, tc.expectedMeasurements); diff != "" {
+				t.Errorf("unexpected measurements difference: %s", diff)
+			}
+		})
+	}
+}
@@ -109,6 +109,11 @@ func (n nestedAttributes) AttributeType() attr.Type {
 // SingleNestedAttributes nests `attributes` under another attribute, only
 // allowing one instance of that group of attributes to appear in the
 // configuration.
+//
+// Each nested attribute keeps its own Required, Optional, and Computed
+// behaviors, which only apply when the object itself is configured. When the
+// object is null or unknown, nested attribute validators and plan modifiers
+// are not called. A null object can be read into a nil pointer to a struct.
 func SingleNestedAttributes(attributes map[string]Attribute) NestedAttributes {
 	return singleNestedAttributes{
 		nestedAttributes(attributes),
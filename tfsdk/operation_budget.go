@@ -0,0 +1,130 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrOperationBudgetExceeded is returned by Retry when the operation budget
+// is exhausted before the operation succeeds.
+var ErrOperationBudgetExceeded = errors.New("operation budget exceeded")
+
+// minRetryInterval is the shortest interval between Retry attempts, so a
+// zero interval does not call the operation in a busy loop.
+const minRetryInterval = 100 * time.Millisecond
+
+type operationBudgetContextKey struct{}
+
+// operationBudget is the deadline of an operation, based on the Clock of the
+// context where it was created.
+type operationBudget struct {
+	deadline time.Time
+}
+
+// ContextWithOperationBudget returns a new context with an operation budget
+// of the given duration, such as a practitioner configured timeout. Nested
+// helpers, such as Retry, share the budget rather than each applying their
+// own. If the context already has a budget, the lesser remaining duration
+// is used, so nested operations cannot outlive their parent.
+//
+// The budget is measured using ClockFromContext. When the clock is based on
+// the time package, the returned context is also canceled when the budget
+// is exhausted, otherwise only helpers consuming the budget observe it.
+func ContextWithOperationBudget(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	clock := ClockFromContext(ctx)
+	deadline := clock.Now().Add(d)
+
+	if parent, ok := ctx.Value(operationBudgetContextKey{}).(operationBudget); ok && parent.deadline.Before(deadline) {
+		deadline = parent.deadline
+	}
+
+	ctx = context.WithValue(ctx, operationBudgetContextKey{}, operationBudget{deadline: deadline})
+
+	if _, ok := clock.(systemClock); ok {
+		return context.WithDeadline(ctx, deadline)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// OperationBudgetRemaining returns the remaining duration of the operation
+// budget in the context, which is zero once exhausted, and whether the
+// context has a budget.
+func OperationBudgetRemaining(ctx context.Context) (time.Duration, bool) {
+	budget, ok := ctx.Value(operationBudgetContextKey{}).(operationBudget)
+
+	if !ok {
+		return 0, false
+	}
+
+	remaining := budget.deadline.Sub(ClockFromContext(ctx).Now())
+
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	return remaining, true
+}
+
+// RetryFunc is an attempt of an operation called by Retry. It returns true
+// with an error if the operation should be attempted again.
+type RetryFunc func(ctx context.Context) (retry bool, err error)
+
+// Retry calls `f` until it succeeds, returns an error without requesting
+// a retry, the operation budget of the context is exhausted, or the context
+// is done. Attempts are separated by the interval, which is at least 100ms,
+// plus a jitter of up to a quarter of the interval from RandFromContext, so
+// concurrent operations retrying the same API spread out. The wait is
+// shortened to the remaining operation budget and measured using
+// ClockFromContext. Without an operation budget, attempts continue until
+// the context is done.
+//
+// If the budget is exhausted, the returned error wraps
+// ErrOperationBudgetExceeded and includes the error of the last attempt.
+func Retry(ctx context.Context, interval time.Duration, f RetryFunc) error {
+	clock := ClockFromContext(ctx)
+	r := RandFromContext(ctx)
+
+	if interval < minRetryInterval {
+		interval = minRetryInterval
+	}
+
+	for {
+		retry, err := f(ctx)
+
+		if !retry {
+			return err
+		}
+
+		wait := interval + time.Duration(r.Int63n(int64(interval/4)))
+		remaining, hasBudget := OperationBudgetRemaining(ctx)
+
+		if hasBudget {
+			if remaining <= 0 {
+				return retryError(ErrOperationBudgetExceeded, err)
+			}
+
+			if remaining < wait {
+				wait = remaining
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return retryError(ctx.Err(), err)
+		case <-clock.After(wait):
+		}
+	}
+}
+
+// retryError returns the reason Retry stopped, including the error of the last
+// attempt, if any.
+func retryError(reason error, lastErr error) error {
+	if lastErr == nil {
+		return reason
+	}
+
+	return fmt.Errorf("%w, last error: %s", reason, lastErr)
+}
@@ -0,0 +1,183 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// testAdvancingClock is a Clock where After advances the current time
+// instantly.
+type testAdvancingClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *testAdvancingClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *testAdvancingClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	ch := make(chan time.Time, 1)
+	ch <- c.now
+
+	return ch
+}
+
+// testFixedRand is a Rand which always returns the same fraction of the
+// range.
+type testFixedRand struct {
+	fraction float64
+}
+
+func (r testFixedRand) Float64() float64 {
+	return r.fraction
+}
+
+func (r testFixedRand) Int63n(n int64) int64 {
+	return int64(r.fraction * float64(n))
+}
+
+func TestContextWithOperationBudget(t *testing.T) {
+	t.Parallel()
+
+	clock := &testAdvancingClock{now: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	ctx := ContextWithClock(context.Background(), clock)
+
+	if _, ok := OperationBudgetRemaining(ctx); ok {
+		t.Fatalf("expected no operation budget")
+	}
+
+	ctx, cancel := ContextWithOperationBudget(ctx, 30*time.Minute)
+	defer cancel()
+
+	if remaining, _ := OperationBudgetRemaining(ctx); remaining != 30*time.Minute {
+		t.Errorf("expected 30m remaining, got %s", remaining)
+	}
+
+	<-clock.After(10 * time.Minute)
+
+	// A nested budget cannot exceed the remaining parent budget.
+	nestedCtx, nestedCancel := ContextWithOperationBudget(ctx, time.Hour)
+	defer nestedCancel()
+
+	if remaining, _ := OperationBudgetRemaining(nestedCtx); remaining != 20*time.Minute {
+		t.Errorf("expected 20m remaining, got %s", remaining)
+	}
+
+	<-clock.After(time.Hour)
+
+	if remaining, _ := OperationBudgetRemaining(nestedCtx); remaining != 0 {
+		t.Errorf("expected no remaining budget, got %s", remaining)
+	}
+
+	if nestedCtx.Err() != nil {
+		t.Errorf("expected test clock budget to not cancel context")
+	}
+}
+
+func TestRetry(t *testing.T) {
+	t.Parallel()
+
+	errTemporary := errors.New("temporary error")
+
+	testCases := map[string]struct {
+		budget        time.Duration
+		interval      time.Duration
+		rand          Rand
+		succeedAfter  int
+		expectedCalls []time.Duration
+		expectedErr   string
+	}{
+		"success": {
+			budget:        time.Minute,
+			interval:      15 * time.Second,
+			rand:          testFixedRand{},
+			succeedAfter:  3,
+			expectedCalls: []time.Duration{0, 15 * time.Second, 30 * time.Second},
+		},
+		"budget-exceeded": {
+			budget:        time.Minute,
+			interval:      15 * time.Second,
+			rand:          testFixedRand{},
+			succeedAfter:  100,
+			expectedCalls: []time.Duration{0, 15 * time.Second, 30 * time.Second, 45 * time.Second, time.Minute},
+			expectedErr:   "operation budget exceeded, last error: temporary error",
+		},
+		"jitter": {
+			budget:       time.Minute,
+			interval:     16 * time.Second,
+			rand:         testFixedRand{fraction: 0.5},
+			succeedAfter: 100,
+			// Each wait is the 16s interval with a 2s jitter, which is
+			// half of the 4s maximum.
+			expectedCalls: []time.Duration{0, 18 * time.Second, 36 * time.Second, 54 * time.Second, time.Minute},
+			expectedErr:   "operation budget exceeded, last error: temporary error",
+		},
+		"zero-interval": {
+			budget:        300 * time.Millisecond,
+			rand:          testFixedRand{},
+			succeedAfter:  100,
+			expectedCalls: []time.Duration{0, 100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond},
+			expectedErr:   "operation budget exceeded, last error: temporary error",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+			clock := &testAdvancingClock{now: start}
+			ctx := ContextWithRand(ContextWithClock(context.Background(), clock), tc.rand)
+			ctx, cancel := ContextWithOperationBudget(ctx, tc.budget)
+			defer cancel()
+
+			var calls []time.Duration
+
+			err := Retry(ctx, tc.interval, func(ctx context.Context) (bool, error) {
+				calls = append(calls, clock.Now().Sub(start))
+
+				if len(calls) < tc.succeedAfter {
+					return true, errTemporary
+				}
+
+				return false, nil
+			})
+
+			if diff := cmp.Diff(calls, tc.expectedCalls); diff != "" {
+				t.Errorf("unexpected calls difference: %s", diff)
+			}
+
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != tc.expectedErr {
+				t.Errorf("expected error %q, got %v", tc.expectedErr, err)
+			}
+
+			if !errors.Is(err, ErrOperationBudgetExceeded) {
+				t.Errorf("expected ErrOperationBudgetExceeded, got %v", err)
+			}
+		})
+	}
+}
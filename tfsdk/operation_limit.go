@@ -0,0 +1,55 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// operationLimit bounds the number of concurrent resource and data source
+// method calls of a server.
+type operationLimit chan struct{}
+
+// newOperationLimit returns a limit of max concurrent calls, or nil for no
+// limit when max is not positive.
+func newOperationLimit(max int) operationLimit {
+	if max <= 0 {
+		return nil
+	}
+
+	return make(operationLimit, max)
+}
+
+// acquire waits until a call may start, returning a function which must be
+// called when the call is complete to release it. It returns an error
+// diagnostic if the context is canceled while waiting, such as by
+// StopProvider.
+func (l operationLimit) acquire(ctx context.Context, method InterceptedMethod, typeName string) (func(), diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if l == nil {
+		return func() {}, diags
+	}
+
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, diags
+	default:
+	}
+
+	tfsdklog.Trace(ctx, "waiting for concurrent operations to complete", "method", string(method), "type_name", typeName, "max_concurrent_operations", cap(l))
+
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, diags
+	case <-ctx.Done():
+		diags.AddError(
+			"Operation Canceled",
+			fmt.Sprintf("The %s operation of %s was canceled while waiting for other operations to complete, since the provider allows %d concurrent operations: %s", method, typeName, cap(l), ctx.Err()),
+		)
+
+		return func() {}, diags
+	}
+}
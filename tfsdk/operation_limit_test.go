@@ -0,0 +1,114 @@
+package tfsdk
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestServerInterceptMaxConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		maxConcurrentOperations int
+		calls                   int
+		expectedMax             int32
+	}{
+		"unlimited": {
+			calls:       3,
+			expectedMax: 3,
+		},
+		"limited": {
+			maxConcurrentOperations: 2,
+			calls:                   5,
+			expectedMax:             2,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := newServer(&testServeProvider{}, ServeOpts{MaxConcurrentOperations: tc.maxConcurrentOperations})
+
+			var running, max int32
+			var wg sync.WaitGroup
+			started := make(chan struct{}, tc.calls)
+			unblock := make(chan struct{})
+
+			for i := 0; i < tc.calls; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					s.intercept(context.Background(), InterceptedMethodReadResource, "test_one", nil, func(_ context.Context) diag.Diagnostics {
+						current := atomic.AddInt32(&running, 1)
+						defer atomic.AddInt32(&running, -1)
+
+						for {
+							previous := atomic.LoadInt32(&max)
+
+							if current <= previous || atomic.CompareAndSwapInt32(&max, previous, current) {
+								break
+							}
+						}
+
+						started <- struct{}{}
+						<-unblock
+
+						return nil
+					})
+				}()
+			}
+
+			// Every call blocks until the expected number are running.
+			for i := int32(0); i < tc.expectedMax; i++ {
+				<-started
+			}
+
+			close(unblock)
+			wg.Wait()
+
+			if max != tc.expectedMax {
+				t.Errorf("expected %d concurrent calls, got %d", tc.expectedMax, max)
+			}
+		})
+	}
+}
+
+func TestOperationLimitAcquireCanceled(t *testing.T) {
+	t.Parallel()
+
+	limit := newOperationLimit(1)
+
+	release, diags := limit.acquire(context.Background(), InterceptedMethodCreateResource, "test_one")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, diags = limit.acquire(ctx, InterceptedMethodCreateResource, "test_one")
+
+	if !diags.HasError() {
+		t.Fatalf("expected error diagnostic")
+	}
+
+	release()
+
+	release, diags = limit.acquire(context.Background(), InterceptedMethodCreateResource, "test_one")
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	release()
+}
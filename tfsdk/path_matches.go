@@ -0,0 +1,109 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// pathMatches returns the paths of all values in raw matching the
+// expression. An error diagnostic is returned if the expression does not
+// follow the schema, while an expression which follows the schema but has no
+// matching values, such as elements of a null list, returns no paths.
+func pathMatches(ctx context.Context, schema Schema, raw tftypes.Value, expression path.Expression) (path.Paths, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resolvedExpression := expression.Resolve()
+
+	if !expressionStepsMatchType(resolvedExpression.Steps(), schema.TerraformType(ctx)) {
+		diags.AddError(
+			"Invalid Path Expression for Schema",
+			"The provider attempted to match a path expression which does not follow the schema in structure or types. "+
+				"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Path Expression: "+expression.String(),
+		)
+
+		return nil, diags
+	}
+
+	var paths path.Paths
+
+	err := tftypes.Walk(raw, func(tfPath *tftypes.AttributePath, _ tftypes.Value) (bool, error) {
+		valuePath := fromtftypes.AttributePath(tfPath)
+
+		if resolvedExpression.Matches(valuePath) {
+			paths.Append(valuePath)
+
+			return false, nil
+		}
+
+		return resolvedExpression.MatchesParent(valuePath), nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Path Expression Match Error",
+			"An unexpected error was encountered trying to match a path expression. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return paths, diags
+}
+
+// expressionStepsMatchType returns true if each of the resolved expression
+// steps can be applied to the type, such as an element key step to a list.
+func expressionStepsMatchType(steps path.ExpressionSteps, typ tftypes.Type) bool {
+	step, remainingSteps := steps.NextStep()
+
+	if step == nil {
+		return true
+	}
+
+	switch s := step.(type) {
+	case path.ExpressionStepAttributeNameExact:
+		objectType, ok := typ.(tftypes.Object)
+
+		if !ok {
+			return false
+		}
+
+		attributeType, ok := objectType.AttributeTypes[string(s)]
+
+		if !ok {
+			return false
+		}
+
+		return expressionStepsMatchType(remainingSteps, attributeType)
+	case path.ExpressionStepElementKeyIntAny, path.ExpressionStepElementKeyIntExact:
+		listType, ok := typ.(tftypes.List)
+
+		if !ok {
+			return false
+		}
+
+		return expressionStepsMatchType(remainingSteps, listType.ElementType)
+	case path.ExpressionStepElementKeyStringAny, path.ExpressionStepElementKeyStringExact:
+		mapType, ok := typ.(tftypes.Map)
+
+		if !ok {
+			return false
+		}
+
+		return expressionStepsMatchType(remainingSteps, mapType.ElementType)
+	case path.ExpressionStepElementKeyValueAny, path.ExpressionStepElementKeyValueExact:
+		setType, ok := typ.(tftypes.Set)
+
+		if !ok {
+			return false
+		}
+
+		return expressionStepsMatchType(remainingSteps, setType.ElementType)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,168 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConfigPathMatches(t *testing.T) {
+	t.Parallel()
+
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"name": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"size": {
+						Type:     types.Int64Type,
+						Optional: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"zones": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+	}
+	diskType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+			"size": tftypes.Number,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"disks": tftypes.List{ElementType: diskType},
+			"tags":  tftypes.Map{ElementType: tftypes.String},
+			"zones": tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+	raw := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"disks": tftypes.NewValue(tftypes.List{ElementType: diskType}, []tftypes.Value{
+			tftypes.NewValue(diskType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "first"),
+				"size": tftypes.NewValue(tftypes.Number, 10),
+			}),
+			tftypes.NewValue(diskType, map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "second"),
+				"size": tftypes.NewValue(tftypes.Number, nil),
+			}),
+		}),
+		"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"env": tftypes.NewValue(tftypes.String, "test"),
+		}),
+		"zones": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+	})
+
+	testCases := map[string]struct {
+		expression    path.Expression
+		expected      path.Paths
+		expectedDiags diag.Diagnostics
+	}{
+		"root": {
+			expression: path.MatchRoot("disks"),
+			expected: path.Paths{
+				path.Root("disks"),
+			},
+		},
+		"any-list-index": {
+			expression: path.MatchRoot("disks").AtAnyListIndex(),
+			expected: path.Paths{
+				path.Root("disks").AtListIndex(0),
+				path.Root("disks").AtListIndex(1),
+			},
+		},
+		"any-list-index-nested": {
+			expression: path.MatchRoot("disks").AtAnyListIndex().AtName("size"),
+			expected: path.Paths{
+				path.Root("disks").AtListIndex(0).AtName("size"),
+				path.Root("disks").AtListIndex(1).AtName("size"),
+			},
+		},
+		"exact-list-index-nested": {
+			expression: path.MatchRoot("disks").AtListIndex(1).AtName("name"),
+			expected: path.Paths{
+				path.Root("disks").AtListIndex(1).AtName("name"),
+			},
+		},
+		"missing-list-index": {
+			expression: path.MatchRoot("disks").AtListIndex(2),
+		},
+		"parent": {
+			expression: path.MatchRoot("disks").AtAnyListIndex().AtName("size").AtParent().AtName("name"),
+			expected: path.Paths{
+				path.Root("disks").AtListIndex(0).AtName("name"),
+				path.Root("disks").AtListIndex(1).AtName("name"),
+			},
+		},
+		"any-map-key": {
+			expression: path.MatchRoot("tags").AtAnyMapKey(),
+			expected: path.Paths{
+				path.Root("tags").AtMapKey("env"),
+			},
+		},
+		"any-set-value-null": {
+			expression: path.MatchRoot("zones").AtAnySetValue(),
+		},
+		"invalid-attribute": {
+			expression: path.MatchRoot("missing"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The provider attempted to match a path expression which does not follow the schema in structure or types. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Path Expression: missing",
+				),
+			},
+		},
+		"invalid-step-type": {
+			expression: path.MatchRoot("tags").AtAnyListIndex(),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Path Expression for Schema",
+					"The provider attempted to match a path expression which does not follow the schema in structure or types. "+
+						"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Path Expression: tags[*]",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := Config{
+				Raw:    raw,
+				Schema: schema,
+			}
+
+			got, diags := config.PathMatches(context.Background(), tc.expression)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -19,12 +21,23 @@ type Plan struct {
 
 // Get populates the struct passed as `target` with the entire plan.
 func (p Plan) Get(ctx context.Context, target interface{}) diag.Diagnostics {
-	return reflect.Into(ctx, p.Schema.AttributeType(), p.Raw, target, reflect.Options{})
+	return reflect.Into(ctx, p.Schema.AttributeType(), p.Raw, target, reflect.Options{}, path.Empty())
+}
+
+// GetWithOptions populates the struct passed as `target` with the entire
+// plan, like Get. The options allow null and unknown values to be read into
+// Go types which cannot represent them, such as string, as their empty value
+// rather than returning an error.
+func (p Plan) GetWithOptions(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return reflect.Into(ctx, p.Schema.AttributeType(), p.Raw, target, opts.reflectOptions(), path.Empty())
 }
 
 // GetAttribute retrieves the attribute found at `path` and populates the
-// `target` with the value.
-func (p Plan) GetAttribute(ctx context.Context, path *tftypes.AttributePath, target interface{}) diag.Diagnostics {
+// `target` with the value. A nested object or list element, such as an
+// element of a list nested attribute, can be read directly into a struct
+// tagged with the nested attribute names. Diagnostics for nested values are
+// returned at their full path, such as `disks[0].size`.
+func (p Plan) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
 	attrValue, diags := p.getAttributeValue(ctx, path)
 
 	if diags.HasError() {
@@ -41,22 +54,50 @@ func (p Plan) GetAttribute(ctx context.Context, path *tftypes.AttributePath, tar
 		return diags
 	}
 
-	valueAsDiags := ValueAs(ctx, attrValue, target)
+	diags.Append(valueAs(ctx, attrValue, target, path)...)
 
-	// ValueAs does not have path information for its Diagnostics.
-	for idx, valueAsDiag := range valueAsDiags {
-		valueAsDiags[idx] = diag.WithPath(path, valueAsDiag)
-	}
+	return diags
+}
 
-	diags.Append(valueAsDiags...)
+// AttributeIsNull returns true if the value at `path` is null, without
+// converting it into an attr.Value. Values which cannot be reached, such as
+// an attribute of a null object, are null unless a parent value is unknown.
+func (p Plan) AttributeIsNull(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	isNull, _, diags := attributeNullOrUnknown(ctx, p.Schema, p.Raw, path, "Plan Read Error", "plan")
 
-	return diags
+	return isNull, diags
+}
+
+// AttributeIsUnknown returns true if the value at `path` is unknown, or
+// cannot be reached because a parent value is unknown, without converting it
+// into an attr.Value.
+func (p Plan) AttributeIsUnknown(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	_, isUnknown, diags := attributeNullOrUnknown(ctx, p.Schema, p.Raw, path, "Plan Read Error", "plan")
+
+	return isUnknown, diags
+}
+
+// PathMatches returns all paths in the plan matching the path
+// expression, such as every element of a list nested attribute. An error
+// diagnostic is returned if the expression does not follow the schema.
+// Relative expressions are resolved from the root of the schema.
+func (p Plan) PathMatches(ctx context.Context, expression path.Expression) (path.Paths, diag.Diagnostics) {
+	return pathMatches(ctx, p.Schema, p.Raw, expression)
+}
+
+// GetAttributes retrieves the values found at each of the paths with a
+// single walk of the plan, keyed by the String() of each path. Consumers
+// should assert the type of each returned value with the desired attr.Type.
+// This avoids walking the whole plan for each path when many attributes
+// are inspected, such as in plan modifiers.
+func (p Plan) GetAttributes(ctx context.Context, paths path.Paths) (map[string]attr.Value, diag.Diagnostics) {
+	return getAttributeValues(ctx, p.Schema, p.Raw, paths, "Plan Read Error", "plan")
 }
 
 // getAttributeValue retrieves the attribute found at `path` and returns it as an
 // attr.Value. Consumers should assert the type of the returned value with the
 // desired attr.Type.
-func (p Plan) getAttributeValue(ctx context.Context, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func (p Plan) getAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attrType, err := p.Schema.AttributeTypeAtPath(path)
@@ -117,7 +158,7 @@ func (p Plan) getAttributeValue(ctx context.Context, path *tftypes.AttributePath
 // should be a struct whose values have one of the attr.Value types. Each field
 // must be tagged with the corresponding schema field.
 func (p *Plan) Set(ctx context.Context, val interface{}) diag.Diagnostics {
-	newPlanAttrValue, diags := reflect.FromValue(ctx, p.Schema.AttributeType(), val, tftypes.NewAttributePath())
+	newPlanAttrValue, diags := reflect.FromValue(ctx, p.Schema.AttributeType(), val, path.Empty())
 	if diags.HasError() {
 		return diags
 	}
@@ -144,7 +185,36 @@ func (p *Plan) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 // paths as necessary.
 //
 // Lists can only have the next element added according to the current length.
-func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, val interface{}) diag.Diagnostics {
+func (p *Plan) SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfVal, tfValDiags := p.attributeWriteValue(ctx, path, val)
+	diags.Append(tfValDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(p.setAttributeValue(ctx, path, tfVal)...)
+
+	return diags
+}
+
+// SetAttributes sets each of the writes in order, as if by calling
+// SetAttribute for each, stopping at the first write with an error. The
+// writes before the write with an error remain set.
+//
+// Writes to paths which already have a value are applied together, so each
+// parent value is rebuilt once rather than once per write. This avoids
+// copying the other attributes of an object for each write when many
+// attributes are set, such as in ModifyPlan.
+func (p *Plan) SetAttributes(ctx context.Context, writes []AttributeWrite) diag.Diagnostics {
+	return setAttributes(ctx, p, writes)
+}
+
+// attributeWriteValue converts the Go value to the Terraform value to write
+// at `path`, validating it with the attribute type in the schema.
+func (p Plan) attributeWriteValue(ctx context.Context, path path.Path, val interface{}) (tftypes.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attrType, err := p.Schema.AttributeTypeAtPath(path)
@@ -155,14 +225,14 @@ func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, va
 			"Plan Write Error",
 			"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	newVal, newValDiags := reflect.FromValue(ctx, attrType, val, path)
 	diags.Append(newValDiags...)
 
 	if diags.HasError() {
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	tfVal, err := newVal.ToTerraformValue(ctx)
@@ -173,27 +243,132 @@ func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, va
 			"Plan Write Error",
 			"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	if attrTypeWithValidate, ok := attrType.(attr.TypeWithValidate); ok {
 		diags.Append(attrTypeWithValidate.Validate(ctx, tfVal, path)...)
 
 		if diags.HasError() {
-			return diags
+			return tftypes.Value{}, diags
 		}
 	}
 
-	transformFunc, transformFuncDiags := p.setAttributeTransformFunc(ctx, path, tfVal, nil)
-	diags.Append(transformFuncDiags...)
+	return tfVal, diags
+}
+
+// setAttributeValue sets the Terraform value at `path`, adding any parent
+// values as necessary.
+func (p *Plan) setAttributeValue(ctx context.Context, path path.Path, tfVal tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	replacePath, replaceVal, replaceDiags := p.setAttributeReplacement(ctx, path, tfVal, nil)
+	diags.Append(replaceDiags...)
 
 	if diags.HasError() {
 		return diags
 	}
 
-	p.Raw, err = tftypes.Transform(p.Raw, transformFunc)
+	newRaw, err := replaceValueAtPath(p.Raw, totftypes.AttributePath(replacePath), replaceVal)
+	if err != nil {
+		err = fmt.Errorf("Cannot transform plan: %w", err)
+		diags.AddAttributeError(
+			path,
+			"Plan Write Error",
+			"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	p.Raw = newRaw
+
+	return diags
+}
+
+// replaceAttributeValues replaces the values at existing paths together.
+// The first path is used for the diagnostic of an error.
+func (p *Plan) replaceAttributeValues(replacements []valueReplacement, paths []path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newRaw, err := replaceValuesAtPaths(p.Raw, replacements)
+	if err != nil {
+		err = fmt.Errorf("Cannot transform plan: %w", err)
+		diags.AddAttributeError(
+			paths[0],
+			"Plan Write Error",
+			"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	p.Raw = newRaw
+
+	return diags
+}
+
+// RemoveAttribute sets the attribute at `path` to a null value of the type
+// defined by the schema.
+//
+// The attribute path must be valid with the current schema. If the attribute
+// path does not have a value, such as an attribute of a null parent object or
+// a list index beyond the current length, the plan is not modified. List
+// elements are set to null rather than being removed from the list.
+func (p *Plan) RemoveAttribute(ctx context.Context, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attrType, err := p.Schema.AttributeTypeAtPath(path)
+	if err != nil {
+		err = fmt.Errorf("error getting attribute type in schema: %w", err)
+		diags.AddAttributeError(
+			path,
+			"Plan Write Error",
+			"An unexpected error was encountered trying to remove an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	exists, pathExistsDiags := p.pathExists(ctx, path)
+	diags.Append(pathExistsDiags...)
+
+	if diags.HasError() || !exists {
+		return diags
+	}
+
+	nullValue := tftypes.NewValue(attrType.TerraformType(ctx), nil)
+
+	p.Raw, err = replaceValueAtPath(p.Raw, totftypes.AttributePath(path), nullValue)
 	if err != nil {
 		err = fmt.Errorf("Cannot transform plan: %w", err)
+		diags.AddAttributeError(
+			path,
+			"Plan Write Error",
+			"An unexpected error was encountered trying to remove an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	return diags
+}
+
+// SetUnknown sets the attribute at `path` to an unknown value of the type
+// defined by the schema, such as a computed attribute which will be known
+// after the plan is applied. Parent values are created as with SetAttribute.
+func (p *Plan) SetUnknown(ctx context.Context, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attrType, err := p.Schema.AttributeTypeAtPath(path)
+	if err != nil {
+		err = fmt.Errorf("error getting attribute type in schema: %w", err)
+		diags.AddAttributeError(
+			path,
+			"Plan Write Error",
+			"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	unknownValue, err := attrType.ValueFromTerraform(ctx, tftypes.NewValue(attrType.TerraformType(ctx), tftypes.UnknownValue))
+	if err != nil {
 		diags.AddAttributeError(
 			path,
 			"Plan Write Error",
@@ -202,15 +377,83 @@ func (p *Plan) SetAttribute(ctx context.Context, path *tftypes.AttributePath, va
 		return diags
 	}
 
+	return p.SetAttribute(ctx, path, unknownValue)
+}
+
+// CopyFromState sets the attribute at `path` to the value of the same
+// attribute in the prior state, such as a computed attribute which is known
+// not to change during an update. If the prior state is null, such as when
+// the resource is being created, the plan is not modified.
+func (p *Plan) CopyFromState(ctx context.Context, state State, path path.Path) diag.Diagnostics {
+	if state.Raw.IsNull() {
+		return nil
+	}
+
+	stateValue, diags := state.getAttributeValue(ctx, path)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(p.SetAttribute(ctx, path, stateValue)...)
+
+	return diags
+}
+
+// MarkComputedNullsUnknown sets every Computed attribute which has a null
+// value in the plan to an unknown value, including nested attributes and
+// attributes in blocks. The framework does this automatically for computed
+// attributes which are not configured before calling ModifyPlan; this is
+// intended for plan modifications which have since set values to null.
+func (p *Plan) MarkComputedNullsUnknown(ctx context.Context) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if p.Raw.IsNull() {
+		return diags
+	}
+
+	var err error
+
+	p.Raw, err = tftypes.Transform(p.Raw, func(tfPath *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		// we are only modifying attributes, not the entire resource
+		if len(tfPath.Steps()) < 1 || !v.IsNull() {
+			return v, nil
+		}
+
+		attribute, err := p.Schema.attributeAtTerraformPath(tfPath)
+
+		if err != nil {
+			if errors.Is(err, ErrPathInsideAtomicAttribute) || errors.Is(err, ErrPathIsBlock) {
+				return v, nil
+			}
+
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in schema: %w", err)
+		}
+
+		if !attribute.Computed {
+			return v, nil
+		}
+
+		return tftypes.NewValue(v.Type(), tftypes.UnknownValue), nil
+	})
+	if err != nil {
+		err = fmt.Errorf("Cannot transform plan: %w", err)
+		diags.AddError(
+			"Plan Write Error",
+			"An unexpected error was encountered trying to mark computed attributes as unknown in the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
 	return diags
 }
 
 // pathExists walks the current state and returns true if the path can be reached.
 // The value at the path may be null or unknown.
-func (p Plan) pathExists(_ context.Context, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+func (p Plan) pathExists(_ context.Context, path path.Path) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	_, remaining, err := tftypes.WalkAttributePath(p.Raw, path)
+	_, remaining, err := tftypes.WalkAttributePath(p.Raw, totftypes.AttributePath(path))
 
 	if err != nil {
 		if errors.Is(err, tftypes.ErrInvalidStep) {
@@ -229,29 +472,25 @@ func (p Plan) pathExists(_ context.Context, path *tftypes.AttributePath) (bool,
 	return len(remaining.Steps()) == 0, diags
 }
 
-// setAttributeTransformFunc recursively creates a value based on the current
+// setAttributeReplacement recursively creates a value based on the current
 // Plan values along the path. If the value at the path does not yet exist,
 // this will perform recursion to add the child value to a parent value,
-// creating the parent value if necessary.
-func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.AttributePath, tfVal tftypes.Value, diags diag.Diagnostics) (transformFunc, diag.Diagnostics) {
+// creating the parent value if necessary. It returns the deepest existing
+// path and the value to replace at that path.
+func (p Plan) setAttributeReplacement(ctx context.Context, path path.Path, tfVal tftypes.Value, diags diag.Diagnostics) (path.Path, tftypes.Value, diag.Diagnostics) {
 	exists, pathExistsDiags := p.pathExists(ctx, path)
 	diags.Append(pathExistsDiags...)
 
 	if diags.HasError() {
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	if exists {
 		// Overwrite existing value
-		return func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
-			if p.Equal(path) {
-				return tfVal, nil
-			}
-			return v, nil
-		}, diags
+		return path, tfVal, diags
 	}
 
-	parentPath := path.WithoutLastStep()
+	parentPath := path.ParentPath()
 	parentAttrType, err := p.Schema.AttributeTypeAtPath(parentPath)
 
 	if err != nil {
@@ -261,7 +500,7 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 			"Plan Write Error",
 			"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	parentValue, err := p.terraformValueAtPath(parentPath)
@@ -272,7 +511,7 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 			"Plan Read Error",
 			"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	if parentValue.IsNull() || !parentValue.IsKnown() {
@@ -292,32 +531,32 @@ func (p Plan) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attri
 		diags.Append(parentValueDiags...)
 
 		if diags.HasError() {
-			return nil, diags
+			return path, tftypes.Value{}, diags
 		}
 	}
 
 	var childValueDiags diag.Diagnostics
-	childStep := path.LastStep()
+	childStep, _ := path.Steps().LastStep()
 	parentValue, childValueDiags = upsertChildValue(ctx, parentPath, parentValue, childStep, tfVal)
 	diags.Append(childValueDiags...)
 
 	if diags.HasError() {
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	if attrTypeWithValidate, ok := parentAttrType.(attr.TypeWithValidate); ok {
 		diags.Append(attrTypeWithValidate.Validate(ctx, parentValue, parentPath)...)
 
 		if diags.HasError() {
-			return nil, diags
+			return path, tftypes.Value{}, diags
 		}
 	}
 
-	return p.setAttributeTransformFunc(ctx, parentPath, parentValue, diags)
+	return p.setAttributeReplacement(ctx, parentPath, parentValue, diags)
 }
 
-func (p Plan) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(p.Raw, path)
+func (p Plan) terraformValueAtPath(path path.Path) (tftypes.Value, error) {
+	rawValue, remaining, err := tftypes.WalkAttributePath(p.Raw, totftypes.AttributePath(path))
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
@@ -0,0 +1,271 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/fromtftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// PlanChangeClass is an enum type of the reasons a planned value can differ
+// from the prior state value.
+type PlanChangeClass uint8
+
+const (
+	// PlanChangeClassUnknown is an invalid change class, used to catch when
+	// a change class is expected and not set.
+	PlanChangeClassUnknown PlanChangeClass = 0
+
+	// PlanChangeClassConfig is for changes where the practitioner configured
+	// a value which differs from the prior state, such as editing the
+	// configuration.
+	PlanChangeClassConfig PlanChangeClass = 1
+
+	// PlanChangeClassDrift is for changes where the value is not configured,
+	// but the plan differs from the prior state. This typically means the
+	// value was changed outside Terraform and detected during refresh, while
+	// the plan, such as through a default value plan modifier, restores it.
+	PlanChangeClassDrift PlanChangeClass = 2
+
+	// PlanChangeClassComputedUnknown is for changes where the planned value
+	// is unknown, such as a computed attribute which will be set when the
+	// plan is applied.
+	PlanChangeClassComputedUnknown PlanChangeClass = 3
+)
+
+// String returns a human readable name for the change class.
+func (c PlanChangeClass) String() string {
+	switch c {
+	case PlanChangeClassConfig:
+		return "config"
+	case PlanChangeClassDrift:
+		return "drift"
+	case PlanChangeClassComputedUnknown:
+		return "computed-unknown"
+	default:
+		return "unknown"
+	}
+}
+
+// PlanChange describes a single path where the plan differs from the prior
+// state.
+type PlanChange struct {
+	// Path is the location of the changed value. It is the deepest path at
+	// which the prior state and plan differ, except for set elements, which
+	// are reported as a change of the entire set.
+	Path path.Path
+
+	// Class is the reason for the change.
+	Class PlanChangeClass
+}
+
+// PlanChanges classifies every path where the plan differs from the prior
+// state. Changes are returned in lexical order of their path. It returns no
+// changes when the resource is being created or destroyed, since every value
+// would be changed.
+//
+// This is intended for ModifyPlan implementations which apply different
+// policies to practitioner-initiated changes and drift, such as only
+// requiring replacement for configuration changes.
+func (r ModifyResourcePlanRequest) PlanChanges(ctx context.Context) ([]PlanChange, diag.Diagnostics) {
+	return planChanges(ctx, r.Config.Raw, r.State.Raw, r.Plan.Raw)
+}
+
+// ChangedPaths returns every path where the plan differs from the prior
+// state, in lexical order. Unknown planned values are always reported as
+// changed. Changes within sets are reported as a change of the entire set,
+// since set elements cannot be correlated between the state and plan.
+//
+// This is intended for Update implementations which only send the changed
+// values to a remote API, rather than comparing every attribute by hand.
+func (r UpdateResourceRequest) ChangedPaths(ctx context.Context) (path.Paths, diag.Diagnostics) {
+	changes, diags := planChanges(ctx, r.Config.Raw, r.State.Raw, r.Plan.Raw)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	paths := make(path.Paths, 0, len(changes))
+
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+
+	return paths, diags
+}
+
+// planChanges classifies the differences between the state and plan values,
+// using the config value to determine whether the practitioner configured
+// each value.
+func planChanges(_ context.Context, config, state, plan tftypes.Value) ([]PlanChange, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if state.IsNull() || plan.IsNull() {
+		return nil, nil
+	}
+
+	var changes []PlanChange
+
+	err := diffValues(tftypes.NewAttributePath(), state, plan, func(path *tftypes.AttributePath, planValue tftypes.Value) {
+		changes = append(changes, PlanChange{
+			Path:  fromtftypes.AttributePath(path),
+			Class: classifyPlanChange(config, path, planValue),
+		})
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Plan Change Classification Error",
+			"An unexpected error was encountered trying to compare the prior state and plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path.String() < changes[j].Path.String()
+	})
+
+	return changes, diags
+}
+
+// classifyPlanChange returns the change class of the planned value at path.
+func classifyPlanChange(config tftypes.Value, path *tftypes.AttributePath, planValue tftypes.Value) PlanChangeClass {
+	if !planValue.IsKnown() {
+		return PlanChangeClassComputedUnknown
+	}
+
+	rawConfigValue, _, err := tftypes.WalkAttributePath(config, path)
+
+	// An element missing from the configuration, such as a removed map key,
+	// is a configuration change when the collection itself is configured.
+	if err != nil {
+		steps := path.Steps()
+
+		if len(steps) == 0 {
+			return PlanChangeClassDrift
+		}
+
+		if _, ok := steps[len(steps)-1].(tftypes.AttributeName); ok {
+			return PlanChangeClassDrift
+		}
+
+		return classifyPlanChange(config, path.WithoutLastStep(), planValue)
+	}
+
+	configValue, ok := rawConfigValue.(tftypes.Value)
+
+	if !ok || configValue.IsNull() {
+		return PlanChangeClassDrift
+	}
+
+	return PlanChangeClassConfig
+}
+
+// diffValues calls changed with the path and planned value of each of the
+// deepest differences between the state and plan values. A missing planned
+// value, such as a removed map key, is passed as a null value.
+func diffValues(path *tftypes.AttributePath, state, plan tftypes.Value, changed func(*tftypes.AttributePath, tftypes.Value)) error {
+	if state.Equal(plan) {
+		return nil
+	}
+
+	if !state.IsKnown() || state.IsNull() || !plan.IsKnown() || plan.IsNull() {
+		changed(path, plan)
+
+		return nil
+	}
+
+	typ := plan.Type()
+
+	switch {
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Tuple{}):
+		var stateElems, planElems []tftypes.Value
+
+		if err := state.As(&stateElems); err != nil {
+			return path.NewError(err)
+		}
+
+		if err := plan.As(&planElems); err != nil {
+			return path.NewError(err)
+		}
+
+		if len(stateElems) != len(planElems) {
+			changed(path, plan)
+
+			return nil
+		}
+
+		for idx := range planElems {
+			if err := diffValues(path.WithElementKeyInt(idx), stateElems[idx], planElems[idx], changed); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case typ.Is(tftypes.Map{}):
+		var stateElems, planElems map[string]tftypes.Value
+
+		if err := state.As(&stateElems); err != nil {
+			return path.NewError(err)
+		}
+
+		if err := plan.As(&planElems); err != nil {
+			return path.NewError(err)
+		}
+
+		for key := range stateElems {
+			if _, ok := planElems[key]; !ok {
+				changed(path.WithElementKeyString(key), tftypes.NewValue(typ.(tftypes.Map).ElementType, nil))
+			}
+		}
+
+		for key, planElem := range planElems {
+			stateElem, ok := stateElems[key]
+
+			if !ok {
+				changed(path.WithElementKeyString(key), planElem)
+
+				continue
+			}
+
+			if err := diffValues(path.WithElementKeyString(key), stateElem, planElem, changed); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case typ.Is(tftypes.Object{}):
+		var stateAttrs, planAttrs map[string]tftypes.Value
+
+		if err := state.As(&stateAttrs); err != nil {
+			return path.NewError(err)
+		}
+
+		if err := plan.As(&planAttrs); err != nil {
+			return path.NewError(err)
+		}
+
+		for name, planAttr := range planAttrs {
+			if err := diffValues(path.WithAttributeName(name), stateAttrs[name], planAttr, changed); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case typ.Is(tftypes.Set{}):
+		changed(path, plan)
+
+		return nil
+	case typ.Is(tftypes.Bool), typ.Is(tftypes.Number), typ.Is(tftypes.String):
+		changed(path, plan)
+
+		return nil
+	default:
+		return path.NewError(fmt.Errorf("unsupported type %s", typ))
+	}
+}
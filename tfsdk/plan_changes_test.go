@@ -0,0 +1,302 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestModifyResourcePlanRequestPlanChanges(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"list":     tftypes.List{ElementType: tftypes.String},
+			"map":      tftypes.Map{ElementType: tftypes.String},
+			"nested": tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				},
+			},
+			"optional": tftypes.String,
+			"set":      tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	// value returns an object of objectType, using the default value of each
+	// attribute unless overridden.
+	value := func(overrides map[string]tftypes.Value) tftypes.Value {
+		attrs := map[string]tftypes.Value{
+			"computed": tftypes.NewValue(tftypes.String, "computed"),
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+			"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, "value"),
+			}),
+			"nested": tftypes.NewValue(objectType.AttributeTypes["nested"], map[string]tftypes.Value{
+				"name": tftypes.NewValue(tftypes.String, "name"),
+			}),
+			"optional": tftypes.NewValue(tftypes.String, "optional"),
+			"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+		}
+
+		for name, override := range overrides {
+			attrs[name] = override
+		}
+
+		return tftypes.NewValue(objectType, attrs)
+	}
+
+	config := value(map[string]tftypes.Value{
+		"computed": tftypes.NewValue(tftypes.String, nil),
+		"optional": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	testCases := map[string]struct {
+		config   tftypes.Value
+		state    tftypes.Value
+		plan     tftypes.Value
+		expected []PlanChange
+	}{
+		"create": {
+			config: config,
+			state:  tftypes.NewValue(objectType, nil),
+			plan:   value(nil),
+		},
+		"destroy": {
+			config: tftypes.NewValue(objectType, nil),
+			state:  value(nil),
+			plan:   tftypes.NewValue(objectType, nil),
+		},
+		"no-changes": {
+			config: config,
+			state:  value(nil),
+			plan:   value(nil),
+		},
+		"config": {
+			config: value(map[string]tftypes.Value{
+				"computed": tftypes.NewValue(tftypes.String, nil),
+				"nested": tftypes.NewValue(objectType.AttributeTypes["nested"], map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "new"),
+				}),
+				"optional": tftypes.NewValue(tftypes.String, nil),
+			}),
+			state: value(nil),
+			plan: value(map[string]tftypes.Value{
+				"nested": tftypes.NewValue(objectType.AttributeTypes["nested"], map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "new"),
+				}),
+			}),
+			expected: []PlanChange{
+				{
+					Path:  path.Root("nested").AtName("name"),
+					Class: PlanChangeClassConfig,
+				},
+			},
+		},
+		"config-collections": {
+			config: value(map[string]tftypes.Value{
+				"computed": tftypes.NewValue(tftypes.String, nil),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"map":      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+				"optional": tftypes.NewValue(tftypes.String, nil),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+			state: value(nil),
+			plan: value(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+			expected: []PlanChange{
+				{
+					Path:  path.Root("list"),
+					Class: PlanChangeClassConfig,
+				},
+				{
+					Path:  path.Root("map").AtMapKey("key"),
+					Class: PlanChangeClassConfig,
+				},
+				{
+					Path:  path.Root("set"),
+					Class: PlanChangeClassConfig,
+				},
+			},
+		},
+		"drift": {
+			config: config,
+			state: value(map[string]tftypes.Value{
+				"optional": tftypes.NewValue(tftypes.String, "changed-remotely"),
+			}),
+			plan: value(nil),
+			expected: []PlanChange{
+				{
+					Path:  path.Root("optional"),
+					Class: PlanChangeClassDrift,
+				},
+			},
+		},
+		"computed-unknown": {
+			config: config,
+			state:  value(nil),
+			plan: value(map[string]tftypes.Value{
+				"computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			expected: []PlanChange{
+				{
+					Path:  path.Root("computed"),
+					Class: PlanChangeClassComputedUnknown,
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := ModifyResourcePlanRequest{
+				Config: Config{Raw: tc.config},
+				State:  State{Raw: tc.state},
+				Plan:   Plan{Raw: tc.plan},
+			}
+
+			got, diags := req.PlanChanges(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateResourceRequestChangedPaths(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"list":     tftypes.List{ElementType: tftypes.String},
+			"name":     tftypes.String,
+			"set":      tftypes.Set{ElementType: tftypes.String},
+		},
+	}
+
+	// value returns an object of objectType, using the default value of each
+	// attribute unless overridden.
+	value := func(overrides map[string]tftypes.Value) tftypes.Value {
+		attrs := map[string]tftypes.Value{
+			"computed": tftypes.NewValue(tftypes.String, "computed"),
+			"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+				tftypes.NewValue(tftypes.String, "two"),
+			}),
+			"name": tftypes.NewValue(tftypes.String, "name"),
+			"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "one"),
+			}),
+		}
+
+		for name, override := range overrides {
+			attrs[name] = override
+		}
+
+		return tftypes.NewValue(objectType, attrs)
+	}
+
+	testCases := map[string]struct {
+		state    tftypes.Value
+		plan     tftypes.Value
+		expected path.Paths
+	}{
+		"no-changes": {
+			state:    value(nil),
+			plan:     value(nil),
+			expected: path.Paths{},
+		},
+		"changes": {
+			state: value(nil),
+			plan: value(map[string]tftypes.Value{
+				"computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"list": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "one"),
+					tftypes.NewValue(tftypes.String, "three"),
+				}),
+				"name": tftypes.NewValue(tftypes.String, "new"),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "two"),
+				}),
+			}),
+			expected: path.Paths{
+				path.Root("computed"),
+				path.Root("list").AtListIndex(1),
+				path.Root("name"),
+				path.Root("set"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := UpdateResourceRequest{
+				Config: Config{Raw: tc.plan},
+				State:  State{Raw: tc.state},
+				Plan:   Plan{Raw: tc.plan},
+			}
+
+			got, diags := req.ChangedPaths(context.Background())
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanChangeClassString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[PlanChangeClass]string{
+		PlanChangeClassUnknown:         "unknown",
+		PlanChangeClassConfig:          "config",
+		PlanChangeClassDrift:           "drift",
+		PlanChangeClassComputedUnknown: "computed-unknown",
+	}
+
+	for class, expected := range testCases {
+		if got := class.String(); got != expected {
+			t.Errorf("expected %q for %d, got %q", expected, class, got)
+		}
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -108,7 +109,7 @@ func TestPlanGet_testTypes(t *testing.T) {
 			expected: testPlanGetDataTestTypes{
 				Name: testtypes.String{String: types.String{Value: ""}, CreatedBy: testtypes.StringTypeWithValidateError{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -131,7 +132,7 @@ func TestPlanGet_testTypes(t *testing.T) {
 			expected: testPlanGetDataTestTypes{
 				Name: testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -251,7 +252,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			expected: new(testtypes.String),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
 						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
 						TargetType: reflect.TypeOf(testtypes.String{}),
@@ -282,7 +283,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			expected: new(bool),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagIntoIncompatibleType{
 						Val:        tftypes.NewValue(tftypes.String, "namevalue"),
 						TargetType: reflect.TypeOf(false),
@@ -311,7 +312,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      new(testtypes.String),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -333,7 +334,7 @@ func TestPlanGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      &testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -342,7 +343,7 @@ func TestPlanGetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.plan.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("name"), tc.target)
+			diags := tc.plan.GetAttribute(context.Background(), path.Root("name"), tc.target)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -360,7 +361,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 
 	type testCase struct {
 		plan          Plan
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -387,7 +388,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: nil,
 		},
 		"WithAttributeName-nonexistent": {
@@ -408,11 +409,11 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: nil,
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("other"),
+					path.Root("other"),
 					"Plan Read Error",
 					"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"error getting attribute type in schema: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
@@ -449,7 +450,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
@@ -485,7 +486,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -529,7 +530,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
@@ -586,7 +587,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.Object{
 				Null:      true,
 				AttrTypes: map[string]attr.Type{"value": types.StringType},
@@ -641,7 +642,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
@@ -710,7 +711,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
@@ -787,7 +788,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
@@ -820,7 +821,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
@@ -856,7 +857,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
@@ -891,7 +892,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -935,7 +936,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
@@ -987,7 +988,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Object-WithAttributeName": {
@@ -1028,7 +1029,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
@@ -1061,7 +1062,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
@@ -1097,7 +1098,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
@@ -1141,13 +1142,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
@@ -1199,13 +1200,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
@@ -1274,13 +1275,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
@@ -1357,13 +1358,13 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName": {
@@ -1403,7 +1404,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
@@ -1445,7 +1446,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-String-null": {
@@ -1472,7 +1473,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-String-unknown": {
@@ -1499,7 +1500,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Unknown: true},
 		},
 		"WithAttributeName-String-value": {
@@ -1526,7 +1527,7 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Value: "value"},
 		},
 		"AttrTypeWithValidateError": {
@@ -1553,9 +1554,9 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      nil,
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -1581,9 +1582,9 @@ func TestPlanGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      testtypes.String{String: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 	}
 
@@ -1609,7 +1610,7 @@ func TestPlanPathExists(t *testing.T) {
 
 	type testCase struct {
 		plan          Plan
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      bool
 		expectedDiags diag.Diagnostics
 	}
@@ -1617,12 +1618,12 @@ func TestPlanPathExists(t *testing.T) {
 	testCases := map[string]testCase{
 		"empty-path": {
 			plan:     Plan{},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: false,
 		},
 		"empty-root": {
 			plan:     Plan{},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"root": {
@@ -1643,7 +1644,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"WithAttributeName": {
@@ -1664,7 +1665,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: true,
 		},
 		"WithAttributeName-mismatch": {
@@ -1685,7 +1686,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName": {
@@ -1720,7 +1721,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("nested"),
+			path:     path.Root("test").AtName("nested"),
 			expected: true,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-child": {
@@ -1755,7 +1756,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-parent": {
@@ -1776,7 +1777,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt": {
@@ -1805,7 +1806,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-child": {
@@ -1834,7 +1835,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+			path:     path.Root("test").AtListIndex(1),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-parent": {
@@ -1855,7 +1856,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString": {
@@ -1884,7 +1885,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path:     path.Root("test").AtMapKey("key"),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-child": {
@@ -1913,7 +1914,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-parent": {
@@ -1934,7 +1935,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue": {
@@ -1963,7 +1964,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-child": {
@@ -1992,7 +1993,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-parent": {
@@ -2013,7 +2014,7 @@ func TestPlanPathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 	}
@@ -2121,7 +2122,7 @@ func TestPlanSet(t *testing.T) {
 				Name: "newvalue",
 			},
 			expected:      tftypes.Value{},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -2147,7 +2148,7 @@ func TestPlanSet(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -2174,7 +2175,7 @@ func TestPlanSetAttribute(t *testing.T) {
 
 	type testCase struct {
 		plan          Plan
-		path          *tftypes.AttributePath
+		path          path.Path
 		val           interface{}
 		expected      tftypes.Value
 		expectedDiags diag.Diagnostics
@@ -2239,7 +2240,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2348,7 +2349,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(2),
+			path: path.Root("disks").AtListIndex(2),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2391,7 +2392,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 3 as list currently has 1 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -2446,7 +2447,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2536,7 +2537,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -2569,7 +2570,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -2608,7 +2609,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key2"),
+			path: path.Root("test").AtMapKey("key2"),
 			val:  "key2value",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -2657,7 +2658,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -2733,7 +2734,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -2840,7 +2841,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -2914,7 +2915,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -2958,7 +2959,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3042,7 +3043,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3126,7 +3127,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -3179,7 +3180,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3222,7 +3223,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  2,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3276,7 +3277,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 				Other     string `tfsdk:"other"`
@@ -3345,7 +3346,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk").WithAttributeName("interface"),
+			path: path.Root("scratch_disk").AtName("interface"),
 			val:  "NVME",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3399,7 +3400,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3483,7 +3484,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -3576,7 +3577,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyValue(tftypes.NewValue(tftypes.String, "three")),
+			path: path.Root("tags").AtSetValue(tftypes.NewValue(tftypes.String, "three")),
 			val:  "three",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3620,7 +3621,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3648,7 +3649,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath(),
+			path: path.Empty(),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3657,7 +3658,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath(),
+					path.Empty(),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\nexpected tftypes.Object[\"test\":tftypes.Bool], got tftypes.Bool",
 				),
@@ -3684,7 +3685,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3721,7 +3722,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3766,7 +3767,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path: path.Root("test").AtListIndex(0),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3784,7 +3785,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-List-Element": {
@@ -3825,7 +3826,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3905,7 +3906,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3928,7 +3929,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -3963,7 +3964,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4005,7 +4006,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("id")),
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtListIndex(0).AtName("id")),
 			},
 		},
 		"write-Map": {
@@ -4033,7 +4034,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -4080,7 +4081,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4098,7 +4099,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Map-Element": {
@@ -4126,7 +4127,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4169,7 +4170,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4187,7 +4188,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key")),
+				testtypes.TestWarningDiagnostic(path.Root("test").AtMapKey("key")),
 			},
 		},
 		"write-Number": {
@@ -4211,7 +4212,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  1,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4252,7 +4253,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 			}{
@@ -4301,7 +4302,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4356,7 +4357,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4434,7 +4435,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4452,7 +4453,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Set-Element-AttrTypeWithValidateWarning": {
@@ -4493,7 +4494,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4543,7 +4544,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
 						"id":                   tftypes.String,
 						"delete_with_instance": tftypes.Bool,
@@ -4551,7 +4552,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				}, map[string]tftypes.Value{
 					"id":                   tftypes.NewValue(tftypes.String, "mynewdisk"),
 					"delete_with_instance": tftypes.NewValue(tftypes.Bool, true),
-				})).WithAttributeName("id")),
+				})).AtName("id")),
 			},
 		},
 		"write-String": {
@@ -4575,7 +4576,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4605,7 +4606,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4614,7 +4615,7 @@ func TestPlanSetAttribute(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "originalname"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			plan: Plan{
@@ -4634,7 +4635,7 @@ func TestPlanSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4644,7 +4645,7 @@ func TestPlanSetAttribute(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newname"),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name")),
+				testtypes.TestWarningDiagnostic(path.Root("name")),
 			},
 		},
 	}
@@ -4669,3 +4670,606 @@ func TestPlanSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestPlanSetAttributes(t *testing.T) {
+	t.Parallel()
+
+	type testDisk struct {
+		ID string `tfsdk:"id"`
+	}
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"disks": tftypes.List{ElementType: nestedType},
+			"name":  tftypes.String,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+		},
+	}
+	value := func(disks interface{}, name interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"disks": tftypes.NewValue(tftypes.List{ElementType: nestedType}, disks),
+			"name":  tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	disk := func(id interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, id),
+		})
+	}
+
+	testCases := map[string]struct {
+		raw           tftypes.Value
+		writes        []AttributeWrite
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"existing": {
+			raw: value([]tftypes.Value{disk("disk0"), disk("disk1")}, "test"),
+			writes: []AttributeWrite{
+				{Path: path.Root("disks").AtListIndex(1).AtName("id"), Value: "new-disk1"},
+				{Path: path.Root("name"), Value: "new"},
+				{Path: path.Root("disks").AtListIndex(0).AtName("id"), Value: "new-disk0"},
+			},
+			expected: value([]tftypes.Value{disk("new-disk0"), disk("new-disk1")}, "new"),
+		},
+		"child-then-parent": {
+			raw: value([]tftypes.Value{disk("disk0")}, "test"),
+			writes: []AttributeWrite{
+				{Path: path.Root("disks").AtListIndex(0).AtName("id"), Value: "new-disk0"},
+				{Path: path.Root("disks"), Value: []testDisk{{ID: "disk1"}}},
+			},
+			expected: value([]tftypes.Value{disk("disk1")}, "test"),
+		},
+		"new-values": {
+			raw: value(nil, "test"),
+			writes: []AttributeWrite{
+				{Path: path.Root("name"), Value: "new"},
+				{Path: path.Root("disks").AtListIndex(0), Value: testDisk{ID: "disk0"}},
+				{Path: path.Root("disks").AtListIndex(0).AtName("id"), Value: "new-disk0"},
+			},
+			expected: value([]tftypes.Value{disk("new-disk0")}, "new"),
+		},
+		"invalid-path": {
+			raw: value(nil, "test"),
+			writes: []AttributeWrite{
+				{Path: path.Root("name"), Value: "new"},
+				{Path: path.Root("missing"), Value: "new"},
+			},
+			expected: value(nil, "new"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"error getting attribute type in schema: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := Plan{
+				Raw:    tc.raw,
+				Schema: schema,
+			}
+
+			diags := plan.SetAttributes(context.Background(), tc.writes)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(plan.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanRemoveAttribute(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"disks":  tftypes.List{ElementType: nestedType},
+			"name":   tftypes.String,
+			"nested": nestedType,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+	value := func(disks interface{}, name interface{}, nested interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"disks":  tftypes.NewValue(tftypes.List{ElementType: nestedType}, disks),
+			"name":   tftypes.NewValue(tftypes.String, name),
+			"nested": tftypes.NewValue(nestedType, nested),
+		})
+	}
+	disk := func(id interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, id),
+		})
+	}
+
+	testCases := map[string]struct {
+		raw           tftypes.Value
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"root": {
+			raw:      value([]tftypes.Value{disk("disk0")}, "test", nil),
+			path:     path.Root("name"),
+			expected: value([]tftypes.Value{disk("disk0")}, nil, nil),
+		},
+		"list": {
+			raw:      value([]tftypes.Value{disk("disk0")}, "test", nil),
+			path:     path.Root("disks"),
+			expected: value(nil, "test", nil),
+		},
+		"list-element": {
+			raw:      value([]tftypes.Value{disk("disk0"), disk("disk1")}, "test", nil),
+			path:     path.Root("disks").AtListIndex(0),
+			expected: value([]tftypes.Value{tftypes.NewValue(nestedType, nil), disk("disk1")}, "test", nil),
+		},
+		"list-element-attribute": {
+			raw:      value([]tftypes.Value{disk("disk0"), disk("disk1")}, "test", nil),
+			path:     path.Root("disks").AtListIndex(1).AtName("id"),
+			expected: value([]tftypes.Value{disk("disk0"), disk(nil)}, "test", nil),
+		},
+		"list-element-missing": {
+			raw:      value([]tftypes.Value{disk("disk0")}, "test", nil),
+			path:     path.Root("disks").AtListIndex(1),
+			expected: value([]tftypes.Value{disk("disk0")}, "test", nil),
+		},
+		"null-parent": {
+			raw:      value(nil, "test", nil),
+			path:     path.Root("nested").AtName("id"),
+			expected: value(nil, "test", nil),
+		},
+		"invalid-path": {
+			raw:      value(nil, "test", nil),
+			path:     path.Root("missing"),
+			expected: value(nil, "test", nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to remove an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"error getting attribute type in schema: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := Plan{
+				Raw:    tc.raw,
+				Schema: schema,
+			}
+
+			diags := plan.RemoveAttribute(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(plan.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanSetUnknown(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":     tftypes.String,
+			"nested": nestedType,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		plan          Plan
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"attribute": {
+			plan: Plan{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.String, "test"),
+					"nested": tftypes.NewValue(nestedType, nil),
+				}),
+				Schema: schema,
+			},
+			path: path.Root("id"),
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"nested": tftypes.NewValue(nestedType, nil),
+			}),
+		},
+		"nested-attribute-null-parent": {
+			plan: Plan{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.String, "test"),
+					"nested": tftypes.NewValue(nestedType, nil),
+				}),
+				Schema: schema,
+			},
+			path: path.Root("nested").AtName("id"),
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "test"),
+				"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			}),
+		},
+		"invalid-path": {
+			plan: Plan{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":     tftypes.NewValue(tftypes.String, "test"),
+					"nested": tftypes.NewValue(nestedType, nil),
+				}),
+				Schema: schema,
+			},
+			path: path.Root("missing"),
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":     tftypes.NewValue(tftypes.String, "test"),
+				"nested": tftypes.NewValue(nestedType, nil),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"Plan Write Error",
+					"An unexpected error was encountered trying to write an attribute to the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"error getting attribute type in schema: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.plan.SetUnknown(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(tc.plan.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanCopyFromState(t *testing.T) {
+	t.Parallel()
+
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		state         State
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"state": {
+			state: State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, "test-id"),
+					"name": tftypes.NewValue(tftypes.String, "old-name"),
+				}),
+				Schema: schema,
+			},
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "new-name"),
+			}),
+		},
+		"null-state": {
+			state: State{
+				Raw:    tftypes.NewValue(schemaType, nil),
+				Schema: schema,
+			},
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"name": tftypes.NewValue(tftypes.String, "new-name"),
+			}),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := Plan{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"name": tftypes.NewValue(tftypes.String, "new-name"),
+				}),
+				Schema: schema,
+			}
+
+			diags := plan.CopyFromState(context.Background(), tc.state, path.Root("id"))
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(plan.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanMarkComputedNullsUnknown(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"computed": tftypes.String,
+			"optional": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"block":    tftypes.List{ElementType: nestedType},
+			"computed": tftypes.String,
+			"known":    tftypes.String,
+			"nested":   nestedType,
+			"optional": tftypes.String,
+		},
+	}
+	nestedAttributes := map[string]Attribute{
+		"computed": {
+			Type:     types.StringType,
+			Computed: true,
+		},
+		"optional": {
+			Type:     types.StringType,
+			Optional: true,
+		},
+	}
+	plan := Plan{
+		Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"block": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+				tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"computed": tftypes.NewValue(tftypes.String, nil),
+					"optional": tftypes.NewValue(tftypes.String, nil),
+				}),
+			}),
+			"computed": tftypes.NewValue(tftypes.String, nil),
+			"known":    tftypes.NewValue(tftypes.String, "test"),
+			"nested":   tftypes.NewValue(nestedType, nil),
+			"optional": tftypes.NewValue(tftypes.String, nil),
+		}),
+		Schema: Schema{
+			Attributes: map[string]Attribute{
+				"computed": {
+					Type:     types.StringType,
+					Computed: true,
+				},
+				"known": {
+					Type:     types.StringType,
+					Computed: true,
+				},
+				"nested": {
+					Attributes: SingleNestedAttributes(nestedAttributes),
+					Optional:   true,
+					Computed:   true,
+				},
+				"optional": {
+					Type:     types.StringType,
+					Optional: true,
+				},
+			},
+			Blocks: map[string]Block{
+				"block": {
+					Attributes:  nestedAttributes,
+					NestingMode: BlockNestingModeList,
+				},
+			},
+		},
+	}
+	expected := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"block": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			tftypes.NewValue(nestedType, map[string]tftypes.Value{
+				"computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"optional": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+		"computed": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"known":    tftypes.NewValue(tftypes.String, "test"),
+		"nested":   tftypes.NewValue(nestedType, tftypes.UnknownValue),
+		"optional": tftypes.NewValue(tftypes.String, nil),
+	})
+
+	diags := plan.MarkComputedNullsUnknown(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(plan.Raw, expected); diff != "" {
+		t.Errorf("unexpected value (+wanted, -got): %s", diff)
+	}
+}
+
+func benchmarkPlanSetAttribute(b *testing.B, attributeCount int) {
+	ctx := context.Background()
+	schema, raw, paths := benchmarkStateAttributes(attributeCount)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		plan := Plan{
+			Raw:    raw,
+			Schema: schema,
+		}
+
+		for _, p := range paths {
+			if diags := plan.SetAttribute(ctx, p, "value"); diags.HasError() {
+				b.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		}
+	}
+}
+
+func BenchmarkPlanSetAttribute10(b *testing.B) {
+	benchmarkPlanSetAttribute(b, 10)
+}
+
+func BenchmarkPlanSetAttribute100(b *testing.B) {
+	benchmarkPlanSetAttribute(b, 100)
+}
+
+func BenchmarkPlanSetAttribute1000(b *testing.B) {
+	benchmarkPlanSetAttribute(b, 1000)
+}
+
+func benchmarkPlanSetAttributes(b *testing.B, attributeCount int) {
+	ctx := context.Background()
+	schema, raw, paths := benchmarkStateAttributes(attributeCount)
+	writes := make([]AttributeWrite, 0, len(paths))
+
+	for _, p := range paths {
+		writes = append(writes, AttributeWrite{
+			Path:  p,
+			Value: "value",
+		})
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		plan := Plan{
+			Raw:    raw,
+			Schema: schema,
+		}
+
+		if diags := plan.SetAttributes(ctx, writes); diags.HasError() {
+			b.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+}
+
+func BenchmarkPlanSetAttributes10(b *testing.B) {
+	benchmarkPlanSetAttributes(b, 10)
+}
+
+func BenchmarkPlanSetAttributes100(b *testing.B) {
+	benchmarkPlanSetAttributes(b, 100)
+}
+
+func BenchmarkPlanSetAttributes1000(b *testing.B) {
+	benchmarkPlanSetAttributes(b, 1000)
+}
@@ -0,0 +1,122 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// PrivateState is provider-defined data which Terraform stores alongside the
+// state of a resource, but never shows to practitioners or uses in plans.
+// It is useful for data which the provider needs across operations, such as
+// an API etag, but which should not be an attribute of the resource.
+//
+// PrivateState is a set of keys, each with an arbitrary byte value. Values
+// set during Create, Read, Update, or ImportState are returned in the
+// requests of later operations on the resource, including across refreshes.
+// The zero value is empty and ready to use.
+type PrivateState struct {
+	data map[string][]byte
+}
+
+// GetKey returns the value of the key, or nil if the key is not set.
+func (p PrivateState) GetKey(_ context.Context, key string) []byte {
+	value, ok := p.data[key]
+
+	if !ok {
+		return nil
+	}
+
+	return append([]byte(nil), value...)
+}
+
+// SetKey sets the value of the key. A nil or empty value removes the key.
+func (p *PrivateState) SetKey(_ context.Context, key string, value []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if key == "" {
+		diags.AddError(
+			"Invalid Private State Key",
+			"An unexpected error was encountered trying to set a private state key. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Private state keys must not be empty.",
+		)
+		return diags
+	}
+
+	if len(value) == 0 {
+		delete(p.data, key)
+		return diags
+	}
+
+	if p.data == nil {
+		p.data = map[string][]byte{}
+	}
+
+	p.data[key] = append([]byte(nil), value...)
+
+	return diags
+}
+
+// clone returns a PrivateState with the same keys, which can be modified
+// without affecting p.
+func (p PrivateState) clone() PrivateState {
+	if len(p.data) == 0 {
+		return PrivateState{}
+	}
+
+	data := make(map[string][]byte, len(p.data))
+
+	for key, value := range p.data {
+		data[key] = value
+	}
+
+	return PrivateState{
+		data: data,
+	}
+}
+
+// newPrivateState decodes the protocol Private field of a request.
+//
+// Data which the framework cannot decode, such as private data from
+// resources previously implemented with another SDK, is discarded, as the
+// framework has always ignored it. A warning is logged so this is visible
+// when troubleshooting.
+func newPrivateState(ctx context.Context, raw []byte) PrivateState {
+	if len(raw) == 0 {
+		return PrivateState{}
+	}
+
+	var data map[string][]byte
+
+	if err := json.Unmarshal(raw, &data); err != nil {
+		tfsdklog.Warn(ctx, "discarding private state which could not be decoded", "error", err)
+		return PrivateState{}
+	}
+
+	return PrivateState{
+		data: data,
+	}
+}
+
+// bytes encodes p for the protocol Private field of a response.
+func (p PrivateState) bytes() ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(p.data) == 0 {
+		return nil, diags
+	}
+
+	raw, err := json.Marshal(p.data)
+
+	if err != nil {
+		diags.AddError(
+			"Error Encoding Private State",
+			"An unexpected error was encountered trying to encode the private state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	return raw, diags
+}
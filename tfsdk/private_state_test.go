@@ -0,0 +1,113 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestPrivateStateSetKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		private       PrivateState
+		key           string
+		value         []byte
+		expected      []byte
+		expectedDiags diag.Diagnostics
+	}{
+		"zero-value": {
+			key:      "etag",
+			value:    []byte("1"),
+			expected: []byte(`{"etag":"MQ=="}`),
+		},
+		"overwrite": {
+			private:  newPrivateState(context.Background(), []byte(`{"etag":"MQ=="}`)),
+			key:      "etag",
+			value:    []byte("2"),
+			expected: []byte(`{"etag":"Mg=="}`),
+		},
+		"remove": {
+			private: newPrivateState(context.Background(), []byte(`{"etag":"MQ=="}`)),
+			key:     "etag",
+		},
+		"empty-key": {
+			key:   "",
+			value: []byte("1"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Private State Key",
+					"An unexpected error was encountered trying to set a private state key. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Private state keys must not be empty.",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := tc.private.SetKey(context.Background(), tc.key, tc.value)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			got, diags := tc.private.bytes()
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(string(got), string(tc.expected)); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestPrivateStateGetKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		raw      []byte
+		key      string
+		expected []byte
+	}{
+		"empty": {
+			key: "etag",
+		},
+		"found": {
+			raw:      []byte(`{"etag":"MQ==","other":"Mg=="}`),
+			key:      "etag",
+			expected: []byte("1"),
+		},
+		"not-found": {
+			raw: []byte(`{"other":"Mg=="}`),
+			key: "etag",
+		},
+		"undecodable": {
+			raw: []byte(`{"schema_version":"1","e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":1200000000000}}`),
+			key: "schema_version",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := newPrivateState(context.Background(), tc.raw).GetKey(context.Background(), tc.key)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/internal/proto5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ToProto6 returns the protocol version 6 equivalent of the Schema, as
+// returned to Terraform by GetProviderSchema. This allows custom servers and
+// tooling to use framework schemas.
+func (s Schema) ToProto6(ctx context.Context) (*tfprotov6.Schema, error) {
+	return s.tfprotov6Schema(ctx)
+}
+
+// ToProto5 returns the protocol version 5 equivalent of the Schema. It
+// returns an error if the Schema uses nested attributes, which only exist in
+// protocol version 6.
+func (s Schema) ToProto5(ctx context.Context) (*tfprotov5.Schema, error) {
+	schema6, err := s.tfprotov6Schema(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return proto5.Schema(schema6)
+}
+
+// ToProto6 returns the State as a protocol version 6 DynamicValue, with the
+// values of attributes which have a Codec converted to their stored form,
+// the same way as when state is returned to Terraform.
+func (s State) ToProto6(ctx context.Context) (*tfprotov6.DynamicValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	raw, err := s.encodeRaw(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"State Conversion Error",
+			"An unexpected error was encountered trying to convert the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	dv, err := tfprotov6.NewDynamicValue(s.Schema.TerraformType(ctx), raw)
+
+	if err != nil {
+		diags.AddError(
+			"State Conversion Error",
+			"An unexpected error was encountered trying to convert the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return &dv, diags
+}
+
+// ToProto5 returns the State as a protocol version 5 DynamicValue, with the
+// values of attributes which have a Codec converted to their stored form.
+func (s State) ToProto5(ctx context.Context) (*tfprotov5.DynamicValue, diag.Diagnostics) {
+	dv, diags := s.ToProto6(ctx)
+
+	if dv == nil {
+		return nil, diags
+	}
+
+	return &tfprotov5.DynamicValue{
+		MsgPack: dv.MsgPack,
+		JSON:    dv.JSON,
+	}, diags
+}
+
+// encodeRaw returns the Raw value of the State with the values of attributes
+// which have a Codec converted to their stored form. A zero Raw value is
+// treated as null.
+func (s State) encodeRaw(ctx context.Context) (tftypes.Value, error) {
+	if s.Raw.Type() == nil {
+		return tftypes.NewValue(s.Schema.TerraformType(ctx), nil), nil
+	}
+
+	return encodeAttributeValues(ctx, s.Schema, s.Raw)
+}
+
+// StateFromProto6 returns the State of a protocol version 6 DynamicValue,
+// such as the prior state of a request, with the values of attributes which
+// have a Codec converted from their stored form. A nil DynamicValue returns
+// a null State.
+func StateFromProto6(ctx context.Context, schema Schema, in *tfprotov6.DynamicValue) (State, diag.Diagnostics) {
+	state := State{
+		Schema: schema,
+		Raw:    tftypes.NewValue(schema.TerraformType(ctx), nil),
+	}
+
+	if in == nil {
+		return state, nil
+	}
+
+	raw, err := in.Unmarshal(schema.TerraformType(ctx))
+
+	if err != nil {
+		return state, stateFromProtoDiagnostics(err)
+	}
+
+	state.Raw, err = decodeAttributeValues(ctx, schema, raw)
+
+	if err != nil {
+		return state, stateFromProtoDiagnostics(err)
+	}
+
+	return state, nil
+}
+
+// StateFromProto5 returns the State of a protocol version 5 DynamicValue,
+// with the values of attributes which have a Codec converted from their
+// stored form. A nil DynamicValue returns a null State.
+func StateFromProto5(ctx context.Context, schema Schema, in *tfprotov5.DynamicValue) (State, diag.Diagnostics) {
+	if in == nil {
+		return StateFromProto6(ctx, schema, nil)
+	}
+
+	return StateFromProto6(ctx, schema, &tfprotov6.DynamicValue{
+		MsgPack: in.MsgPack,
+		JSON:    in.JSON,
+	})
+}
+
+// stateFromProtoDiagnostics returns the diagnostics for an error converting
+// a DynamicValue to a State.
+func stateFromProtoDiagnostics(err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.AddError(
+		"State Conversion Error",
+		"An unexpected error was encountered trying to parse the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+	)
+
+	return diags
+}
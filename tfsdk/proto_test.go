@@ -0,0 +1,235 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSchemaToProto5(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema      Schema
+		expected    *tfprotov5.Schema
+		expectedErr string
+	}{
+		"attributes-and-blocks": {
+			schema: Schema{
+				Version: 2,
+				Attributes: map[string]Attribute{
+					"name": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"rule": {
+						Attributes: map[string]Attribute{
+							"priority": {
+								Type:     types.NumberType,
+								Optional: true,
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: &tfprotov5.Schema{
+				Version: 2,
+				Block: &tfprotov5.SchemaBlock{
+					Attributes: []*tfprotov5.SchemaAttribute{
+						{
+							Name:     "name",
+							Type:     tftypes.String,
+							Required: true,
+						},
+					},
+					BlockTypes: []*tfprotov5.SchemaNestedBlock{
+						{
+							TypeName: "rule",
+							Block: &tfprotov5.SchemaBlock{
+								Attributes: []*tfprotov5.SchemaAttribute{
+									{
+										Name:     "priority",
+										Type:     tftypes.Number,
+										Optional: true,
+									},
+								},
+							},
+							Nesting: tfprotov5.SchemaNestedBlockNestingModeList,
+						},
+					},
+				},
+			},
+		},
+		"nested-attributes": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"nested": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expectedErr: `AttributeName("nested"): attribute uses nested attributes`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.schema.ToProto5(context.Background())
+
+			if err != nil {
+				if err.Error() != tc.expectedErr {
+					t.Fatalf("expected error %q, got %q", tc.expectedErr, err)
+				}
+
+				return
+			}
+
+			if tc.expectedErr != "" {
+				t.Fatalf("expected error %q, got none", tc.expectedErr)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected schema difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateProto(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"document": {
+				Type:     types.StringType,
+				Computed: true,
+				Codec:    GzipBase64Codec(),
+			},
+		},
+	}
+	schemaType := schema.TerraformType(ctx)
+	state := State{
+		Schema: schema,
+		Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+			"name":     tftypes.NewValue(tftypes.String, "example"),
+			"document": tftypes.NewValue(tftypes.String, `{"key":"value"}`),
+		}),
+	}
+	encoded, err := encodeAttributeValues(ctx, schema, state.Raw)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := map[string]struct {
+		roundTrip func(State) (State, tftypes.Value, diag.Diagnostics)
+	}{
+		"protocol-6": {
+			roundTrip: func(s State) (State, tftypes.Value, diag.Diagnostics) {
+				dv, diags := s.ToProto6(ctx)
+
+				if diags.HasError() {
+					return State{}, tftypes.Value{}, diags
+				}
+
+				raw, err := dv.Unmarshal(schemaType)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				got, diags := StateFromProto6(ctx, schema, dv)
+
+				return got, raw, diags
+			},
+		},
+		"protocol-5": {
+			roundTrip: func(s State) (State, tftypes.Value, diag.Diagnostics) {
+				dv, diags := s.ToProto5(ctx)
+
+				if diags.HasError() {
+					return State{}, tftypes.Value{}, diags
+				}
+
+				raw, err := dv.Unmarshal(schemaType)
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				got, diags := StateFromProto5(ctx, schema, dv)
+
+				return got, raw, diags
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, raw, diags := tc.roundTrip(state)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if diff := cmp.Diff(raw, encoded); diff != "" {
+				t.Errorf("unexpected encoded value difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, state); diff != "" {
+				t.Errorf("unexpected state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateFromProto6Nil(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+
+	got, diags := StateFromProto6(ctx, schema, nil)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if !got.Raw.IsNull() {
+		t.Errorf("expected null state, got %s", got.Raw)
+	}
+}
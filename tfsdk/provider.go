@@ -17,8 +17,10 @@ type Provider interface {
 	// provider configuration block. These are supplied in the
 	// ConfigureProviderRequest argument.
 	// Values from provider configuration are often used to initialise an
-	// API client, which should be stored on the struct implementing the
-	// Provider interface.
+	// API client, which should be set as the ConfigureProviderResponse
+	// ResourceData and DataSourceData, so resources and data sources
+	// implementing ResourceWithConfigure and DataSourceWithConfigure
+	// receive it.
 	Configure(context.Context, ConfigureProviderRequest, *ConfigureProviderResponse)
 
 	// GetResources returns a map of the resource types this provider
@@ -39,3 +41,20 @@ type ProviderWithProviderMeta interface {
 	// GetMetaSchema returns the provider meta schema.
 	GetMetaSchema(context.Context) (Schema, diag.Diagnostics)
 }
+
+// ProviderWithResources is a provider which registers resources as
+// factories of ResourceWithMetadata, rather than as ResourceTypes. Each
+// resource then describes its own type name and schema, so a resource is
+// implemented with a single type.
+//
+// Resources returned here are served alongside those returned by
+// GetResources, which may return an empty map. Type names must be unique
+// across both. As there is no ResourceType to receive the Provider, these
+// resources should implement ResourceWithConfigure to receive provider data.
+type ProviderWithResources interface {
+	Provider
+
+	// Resources returns a factory for each resource this provider supports.
+	// Each factory must return a new instance of the resource.
+	Resources(context.Context) []func() ResourceWithMetadata
+}
@@ -0,0 +1,137 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// InterceptedMethod is the name of the resource or data source method being
+// called through interceptors.
+type InterceptedMethod string
+
+// InterceptedMethod values are named after the method and the concept it
+// belongs to.
+const (
+	InterceptedMethodCreateResource InterceptedMethod = "CreateResource"
+	InterceptedMethodReadResource   InterceptedMethod = "ReadResource"
+	InterceptedMethodUpdateResource InterceptedMethod = "UpdateResource"
+	InterceptedMethodDeleteResource InterceptedMethod = "DeleteResource"
+	InterceptedMethodReadDataSource InterceptedMethod = "ReadDataSource"
+)
+
+// ProviderWithInterceptors is a provider with interceptors around the
+// Create, Read, Update, and Delete methods of every resource and the Read
+// method of every data source. It allows cross-cutting behavior, such as
+// logging, metrics, retries, or refreshing credentials, to be implemented
+// once rather than in every resource and data source.
+type ProviderWithInterceptors interface {
+	Provider
+
+	// Interceptors returns the interceptors to call, outermost first, so
+	// the first interceptor is called first and calls into the second.
+	Interceptors(context.Context) []Interceptor
+}
+
+// Interceptor wraps calls to resource and data source methods.
+type Interceptor interface {
+	// Intercept is called in place of the method. It should call
+	// InterceptRequest.Next, which calls the next interceptor or the
+	// method itself, and set the InterceptResponse.Diagnostics.
+	Intercept(context.Context, InterceptRequest, *InterceptResponse)
+}
+
+// InterceptRequest represents a request to intercept a resource or data
+// source method call. An instance of this request struct is supplied as an
+// argument to the interceptor's Intercept function.
+type InterceptRequest struct {
+	// Method is the intercepted method.
+	Method InterceptedMethod
+
+	// TypeName is the resource or data source type of the method.
+	TypeName string
+
+	// Next calls the next interceptor, or the method itself, and returns
+	// its diagnostics. The request context, or a context derived from it,
+	// should be passed.
+	//
+	// Next may be called more than once, such as to retry failed calls.
+	// The method response is reset to its initial values before each call,
+	// so only the final call determines the response.
+	Next func(context.Context) diag.Diagnostics
+}
+
+// InterceptResponse represents a response to an InterceptRequest. An
+// instance of this response struct is supplied as an argument to the
+// interceptor's Intercept function.
+type InterceptResponse struct {
+	// Diagnostics are returned as the diagnostics of the method. They
+	// should usually be set to, or include, the diagnostics returned by
+	// InterceptRequest.Next. Diagnostics from earlier in the handling of
+	// the request are not included and are always returned.
+	Diagnostics diag.Diagnostics
+}
+
+// intercept calls the method through the interceptors of the provider, if
+// any, and returns the resulting diagnostics appended to a copy of diags,
+// the diagnostics of the request before the call. The method must reset its
+// response, without diagnostics, before each call, so diags are preserved
+// even if an interceptor does not call the method or replaces its
+// diagnostics. Each call of the method is traced separately.
+func (s *server) intercept(ctx context.Context, method InterceptedMethod, typeName string, diags diag.Diagnostics, callMethod func(context.Context) diag.Diagnostics) diag.Diagnostics {
+	call := func(ctx context.Context) diag.Diagnostics {
+		release, diags := s.operationLimit.acquire(ctx, method, typeName)
+		defer release()
+
+		if diags.HasError() {
+			return diags
+		}
+
+		ctx, endSpan := s.startSpan(ctx, TraceSpan{Method: method, TypeName: typeName})
+
+		diags = callMethod(ctx)
+
+		endSpan(diags)
+
+		return diags
+	}
+
+	p, ok := s.p.(ProviderWithInterceptors)
+
+	if !ok {
+		return append(copyDiagnostics(diags), call(ctx)...)
+	}
+
+	interceptors := p.Interceptors(ctx)
+
+	next := call
+
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor, inner := interceptors[i], next
+
+		next = func(ctx context.Context) diag.Diagnostics {
+			req := InterceptRequest{
+				Method:   method,
+				TypeName: typeName,
+				Next:     inner,
+			}
+			resp := &InterceptResponse{}
+
+			interceptor.Intercept(ctx, req, resp)
+
+			return resp.Diagnostics
+		}
+	}
+
+	return append(copyDiagnostics(diags), next(ctx)...)
+}
+
+// copyDiagnostics returns a copy of diags, so appending to the copy cannot
+// modify diags.
+func copyDiagnostics(diags diag.Diagnostics) diag.Diagnostics {
+	if diags == nil {
+		return nil
+	}
+
+	return append(make(diag.Diagnostics, 0, len(diags)), diags...)
+}
@@ -0,0 +1,351 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testServeProviderWithInterceptors struct {
+	testServeProviderWithResources
+
+	interceptors []Interceptor
+}
+
+func (p testServeProviderWithInterceptors) Interceptors(_ context.Context) []Interceptor {
+	return p.interceptors
+}
+
+type testInterceptor struct {
+	name  string
+	calls *[]string
+}
+
+func (i testInterceptor) Intercept(ctx context.Context, req InterceptRequest, resp *InterceptResponse) {
+	*i.calls = append(*i.calls, i.name+" "+string(req.Method)+" "+req.TypeName)
+
+	resp.Diagnostics = req.Next(ctx)
+}
+
+// testRetryInterceptor calls the method again if it returns an error.
+type testRetryInterceptor struct{}
+
+func (i testRetryInterceptor) Intercept(ctx context.Context, req InterceptRequest, resp *InterceptResponse) {
+	resp.Diagnostics = req.Next(ctx)
+
+	if resp.Diagnostics.HasError() {
+		resp.Diagnostics = req.Next(ctx)
+	}
+}
+
+// testShortCircuitInterceptor returns an error without calling the method.
+type testShortCircuitInterceptor struct{}
+
+func (i testShortCircuitInterceptor) Intercept(_ context.Context, _ InterceptRequest, resp *InterceptResponse) {
+	resp.Diagnostics.AddError("Intercepted", "")
+}
+
+// testInterceptedResource fails its first Read call.
+type testInterceptedResource struct {
+	testResourceWithMetadata
+
+	reads *int
+}
+
+func (r testInterceptedResource) Read(_ context.Context, _ ReadResourceRequest, resp *ReadResourceResponse) {
+	*r.reads++
+
+	resp.Diagnostics.AddWarning("Read Attempt", "")
+
+	if *r.reads == 1 {
+		resp.Diagnostics.AddError("Read Failed", "")
+		return
+	}
+
+	resp.State.Raw = tftypes.NewValue(tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "read"),
+	})
+}
+
+func TestServerIntercept(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		interceptors  func(calls *[]string) []Interceptor
+		expectedCalls []string
+		expectedReads int
+		expectedID    string
+		expectedDiags []*tfprotov6.Diagnostic
+	}{
+		"none": {
+			interceptors: func(_ *[]string) []Interceptor {
+				return nil
+			},
+			expectedReads: 1,
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Read Attempt",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Read Failed",
+				},
+			},
+		},
+		"order": {
+			interceptors: func(calls *[]string) []Interceptor {
+				return []Interceptor{
+					testInterceptor{name: "first", calls: calls},
+					testInterceptor{name: "second", calls: calls},
+				}
+			},
+			expectedCalls: []string{
+				"first ReadResource test_intercepted",
+				"second ReadResource test_intercepted",
+			},
+			expectedReads: 1,
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Read Attempt",
+				},
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Read Failed",
+				},
+			},
+		},
+		"retry": {
+			interceptors: func(calls *[]string) []Interceptor {
+				return []Interceptor{
+					testInterceptor{name: "first", calls: calls},
+					testRetryInterceptor{},
+				}
+			},
+			expectedCalls: []string{
+				"first ReadResource test_intercepted",
+			},
+			expectedReads: 2,
+			expectedID:    "read",
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Read Attempt",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			var reads int
+
+			testServer := &server{
+				p: testServeProviderWithInterceptors{
+					testServeProviderWithResources: testServeProviderWithResources{
+						testServeProvider: &testServeProvider{},
+						resources: []func() ResourceWithMetadata{
+							func() ResourceWithMetadata {
+								return testInterceptedResource{
+									testResourceWithMetadata: testResourceWithMetadata{typeName: "test_intercepted"},
+									reads:                    &reads,
+								}
+							},
+						},
+					},
+					interceptors: tc.interceptors(&calls),
+				},
+			}
+
+			stateType := tftypes.Object{
+				AttributeTypes: map[string]tftypes.Type{
+					"id": tftypes.String,
+				},
+			}
+			currentState, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "current"),
+			}))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := testServer.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+				CurrentState: &currentState,
+				TypeName:     "test_intercepted",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(calls, tc.expectedCalls); diff != "" {
+				t.Errorf("unexpected calls difference: %s", diff)
+			}
+
+			if reads != tc.expectedReads {
+				t.Errorf("expected %d reads, got %d", tc.expectedReads, reads)
+			}
+
+			if tc.expectedID == "" {
+				return
+			}
+
+			newState, err := got.NewState.Unmarshal(stateType)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			expectedState := tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, tc.expectedID),
+			})
+
+			if diff := cmp.Diff(newState, expectedState); diff != "" {
+				t.Errorf("unexpected new state difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestServerIntercept_diagnostics(t *testing.T) {
+	t.Parallel()
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	fullLimit := newOperationLimit(1)
+	fullLimit <- struct{}{}
+
+	testCases := map[string]struct {
+		ctx            context.Context
+		interceptors   []Interceptor
+		operationLimit operationLimit
+		expectedCalls  int
+		expectedDiags  diag.Diagnostics
+	}{
+		"method": {
+			ctx:           context.Background(),
+			expectedCalls: 1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Earlier Warning", ""),
+				diag.NewWarningDiagnostic("Method Warning", ""),
+			},
+		},
+		"interceptor": {
+			ctx: context.Background(),
+			interceptors: []Interceptor{
+				testInterceptor{name: "first", calls: new([]string)},
+			},
+			expectedCalls: 1,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Earlier Warning", ""),
+				diag.NewWarningDiagnostic("Method Warning", ""),
+			},
+		},
+		"interceptor-short-circuit": {
+			ctx: context.Background(),
+			interceptors: []Interceptor{
+				testShortCircuitInterceptor{},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Earlier Warning", ""),
+				diag.NewErrorDiagnostic("Intercepted", ""),
+			},
+		},
+		"operation-limit-canceled": {
+			ctx:            canceledCtx,
+			operationLimit: fullLimit,
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Earlier Warning", ""),
+				diag.NewErrorDiagnostic(
+					"Operation Canceled",
+					"The ReadResource operation of test_intercepted was canceled while waiting for other operations to complete, since the provider allows 1 concurrent operations: context canceled",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := &server{
+				p: testServeProviderWithInterceptors{
+					testServeProviderWithResources: testServeProviderWithResources{
+						testServeProvider: &testServeProvider{},
+					},
+					interceptors: tc.interceptors,
+				},
+				operationLimit: tc.operationLimit,
+			}
+
+			initial := diag.Diagnostics{
+				diag.NewWarningDiagnostic("Earlier Warning", ""),
+			}
+			calls := 0
+
+			got := testServer.intercept(tc.ctx, InterceptedMethodReadResource, "test_intercepted", initial, func(_ context.Context) diag.Diagnostics {
+				calls++
+
+				return diag.Diagnostics{
+					diag.NewWarningDiagnostic("Method Warning", ""),
+				}
+			})
+
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if calls != tc.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tc.expectedCalls, calls)
+			}
+
+			if len(initial) != 1 {
+				t.Errorf("expected initial diagnostics to be unmodified, got: %v", initial)
+			}
+		})
+	}
+}
+
+func TestCopyDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	diags := make(diag.Diagnostics, 1, 2)
+	diags[0] = diag.NewWarningDiagnostic("first", "")
+
+	got := copyDiagnostics(diags)
+	got = append(got, diag.NewErrorDiagnostic("second", ""))
+
+	if diags[:2][1] != nil {
+		t.Errorf("expected original diagnostics to be unmodified, got: %v", diags[:2])
+	}
+
+	if len(got) != 2 {
+		t.Errorf("expected 2 diagnostics, got: %v", got)
+	}
+
+	if copyDiagnostics(nil) != nil {
+		t.Error("expected nil copy of nil diagnostics")
+	}
+}
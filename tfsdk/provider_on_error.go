@@ -0,0 +1,91 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Operation is the name of the protocol operation, or RPC, being served.
+type Operation string
+
+// Operation values are named after the protocol RPC they represent.
+const (
+	OperationGetProviderSchema          Operation = "GetProviderSchema"
+	OperationValidateProviderConfig     Operation = "ValidateProviderConfig"
+	OperationConfigureProvider          Operation = "ConfigureProvider"
+	OperationValidateResourceConfig     Operation = "ValidateResourceConfig"
+	OperationUpgradeResourceState       Operation = "UpgradeResourceState"
+	OperationReadResource               Operation = "ReadResource"
+	OperationPlanResourceChange         Operation = "PlanResourceChange"
+	OperationApplyResourceChange        Operation = "ApplyResourceChange"
+	OperationImportResourceState        Operation = "ImportResourceState"
+	OperationValidateDataResourceConfig Operation = "ValidateDataResourceConfig"
+	OperationReadDataSource             Operation = "ReadDataSource"
+)
+
+// ProviderWithOnError is a provider which is notified whenever an operation
+// is about to return error diagnostics to Terraform. It allows error handling
+// policy, such as adding remediation hints or reporting to an error tracker,
+// to be implemented once for every resource and data source.
+type ProviderWithOnError interface {
+	Provider
+
+	// OnError is called with the diagnostics of any operation which
+	// contains at least one error diagnostic. The correlation ID of the
+	// operation is available via CorrelationIDFromContext.
+	OnError(context.Context, OnErrorRequest, *OnErrorResponse)
+}
+
+// OnErrorRequest represents a request to handle the error diagnostics of an
+// operation. An instance of this request struct is supplied as an argument to
+// the provider's OnError function.
+type OnErrorRequest struct {
+	// Operation is the operation which returned errors.
+	Operation Operation
+
+	// TypeName is the resource or data source type of the operation. It is
+	// empty for provider operations, such as ConfigureProvider.
+	TypeName string
+
+	// Diagnostics are all diagnostics of the operation, including warnings.
+	Diagnostics diag.Diagnostics
+}
+
+// OnErrorResponse represents a response to an OnErrorRequest. An instance of
+// this response struct is supplied as an argument to the provider's OnError
+// function, in which the provider should set values on the
+// OnErrorResponse as appropriate.
+type OnErrorResponse struct {
+	// Diagnostics are returned to Terraform in place of the operation
+	// diagnostics. They are populated with the operation diagnostics, so
+	// diagnostics such as remediation hints should be appended.
+	Diagnostics diag.Diagnostics
+}
+
+// onError calls the provider OnError hook, if implemented and the diagnostics
+// contain an error, and returns the resulting diagnostics.
+func (s *server) onError(ctx context.Context, op Operation, typeName string, diags diag.Diagnostics) diag.Diagnostics {
+	if !diags.HasError() {
+		return diags
+	}
+
+	p, ok := s.p.(ProviderWithOnError)
+
+	if !ok {
+		return diags
+	}
+
+	req := OnErrorRequest{
+		Operation:   op,
+		TypeName:    typeName,
+		Diagnostics: diags,
+	}
+	resp := &OnErrorResponse{
+		Diagnostics: append(diag.Diagnostics{}, diags...),
+	}
+
+	p.OnError(ctx, req, resp)
+
+	return resp.Diagnostics
+}
@@ -0,0 +1,159 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testServeProviderWithOnError struct {
+	*testServeProvider
+
+	requests []OnErrorRequest
+}
+
+func (p *testServeProviderWithOnError) OnError(_ context.Context, req OnErrorRequest, resp *OnErrorResponse) {
+	p.requests = append(p.requests, req)
+
+	resp.Diagnostics.AddWarning("Remediation Hint", "Check the resource type name.")
+}
+
+func TestServerOnError(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		provider         Provider
+		diags            diag.Diagnostics
+		expectedDiags    diag.Diagnostics
+		expectedRequests []OnErrorRequest
+	}{
+		"no-hook": {
+			provider: &testServeProvider{},
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error", "An error."),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Error", "An error."),
+			},
+		},
+		"no-error": {
+			provider: &testServeProviderWithOnError{},
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning", "A warning."),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning", "A warning."),
+			},
+		},
+		"error": {
+			provider: &testServeProviderWithOnError{},
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning", "A warning."),
+				diag.NewErrorDiagnostic("Error", "An error."),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("Warning", "A warning."),
+				diag.NewErrorDiagnostic("Error", "An error."),
+				diag.NewWarningDiagnostic("Remediation Hint", "Check the resource type name."),
+			},
+			expectedRequests: []OnErrorRequest{
+				{
+					Operation: OperationReadResource,
+					TypeName:  "test_one",
+					Diagnostics: diag.Diagnostics{
+						diag.NewWarningDiagnostic("Warning", "A warning."),
+						diag.NewErrorDiagnostic("Error", "An error."),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testServer := &server{
+				p: tc.provider,
+			}
+
+			got := testServer.onError(context.Background(), OperationReadResource, "test_one", tc.diags)
+
+			if diff := cmp.Diff(got, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if p, ok := tc.provider.(*testServeProviderWithOnError); ok {
+				if diff := cmp.Diff(p.requests, tc.expectedRequests); diff != "" {
+					t.Errorf("unexpected OnError requests difference: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestServerValidateResourceConfigOnError(t *testing.T) {
+	t.Parallel()
+
+	p := &testServeProviderWithOnError{
+		testServeProvider: &testServeProvider{},
+	}
+	testServer := &server{
+		p: p,
+	}
+
+	dv, err := tfprotov6.NewDynamicValue(testServeResourceTypeOneType, tftypes.NewValue(testServeResourceTypeOneType, nil))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err := testServer.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+		TypeName: "test_missing",
+		Config:   &dv,
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expectedDiags := []*tfprotov6.Diagnostic{
+		{
+			Severity: tfprotov6.DiagnosticSeverityError,
+			Summary:  "Resource not found",
+			Detail:   `No resource named "test_missing" is configured on the provider`,
+		},
+		{
+			Severity: tfprotov6.DiagnosticSeverityWarning,
+			Summary:  "Remediation Hint",
+			Detail:   "Check the resource type name.",
+		},
+	}
+
+	if diff := cmp.Diff(got.Diagnostics, expectedDiags); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	expectedRequests := []OnErrorRequest{
+		{
+			Operation: OperationValidateResourceConfig,
+			TypeName:  "test_missing",
+			Diagnostics: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource not found",
+					`No resource named "test_missing" is configured on the provider`,
+				),
+			},
+		},
+	}
+
+	if diff := cmp.Diff(p.requests, expectedRequests); diff != "" {
+		t.Errorf("unexpected OnError requests difference: %s", diff)
+	}
+}
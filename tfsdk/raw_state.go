@@ -0,0 +1,260 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// flatmapUnknownValue is the value terraform-plugin-sdk and Terraform 0.11
+// and earlier used to represent unknown values in flatmap state data.
+const flatmapUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// DecodeRawState decodes the prior state data in RawState using the given
+// prior schema. Unlike the PriorSchema of ResourceStateUpgrader, this allows
+// implementations to choose the prior schema based on the raw state data.
+//
+// Both JSON state data and flatmap state data, written by Terraform 0.11 and
+// earlier through terraform-plugin-sdk, are supported. Flatmap state data has
+// no type information, so values are converted into the types of the prior
+// schema. Elements of sets are returned in lexical order of their flatmap
+// keys.
+func (r UpgradeResourceStateRequest) DecodeRawState(ctx context.Context, priorSchema Schema) (*State, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if r.RawState == nil {
+		diags.AddError(
+			"Unable to Read Previously Saved State for UpgradeResourceState",
+			"There was an error reading the saved resource state using the prior resource schema.\n\n"+
+				"Please report this to the provider developer:\n\n"+
+				"RawState is missing",
+		)
+
+		return nil, diags
+	}
+
+	rawStateValue, err := rawStateValue(r.RawState, priorSchema.TerraformType(ctx))
+
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Previously Saved State for UpgradeResourceState",
+			"There was an error reading the saved resource state using the prior resource schema.\n\n"+
+				"Please report this to the provider developer:\n\n"+err.Error(),
+		)
+
+		return nil, diags
+	}
+
+	return &State{
+		Raw:    rawStateValue,
+		Schema: priorSchema,
+	}, diags
+}
+
+// rawStateValue returns the raw state data as a value of the given type.
+// Unlike tfprotov6.RawState.Unmarshal, flatmap state data is also supported.
+func rawStateValue(rawState *tfprotov6.RawState, typ tftypes.Type) (tftypes.Value, error) {
+	if rawState.JSON == nil && rawState.Flatmap != nil {
+		return flatmapValue(rawState.Flatmap, "", typ)
+	}
+
+	return rawState.Unmarshal(typ)
+}
+
+// flatmapValue returns the value at the flatmap key as the given type. The
+// empty key is the root of the flatmap state data. Missing keys are returned
+// as null values.
+func flatmapValue(flatmap map[string]string, key string, typ tftypes.Type) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.Bool), typ.Is(tftypes.Number), typ.Is(tftypes.String):
+		raw, ok := flatmap[key]
+
+		if !ok {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		if raw == flatmapUnknownValue {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+
+		return flatmapPrimitiveValue(key, raw, typ)
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}):
+		count, ok := flatmap[key+".#"]
+
+		if !ok {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		if count == flatmapUnknownValue {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+
+		var elemType tftypes.Type
+		var elemKeys []string
+
+		switch t := typ.(type) {
+		case tftypes.List:
+			elemType = t.ElementType
+
+			length, err := strconv.Atoi(count)
+
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: invalid list length %q: %w", key, count, err)
+			}
+
+			for idx := 0; idx < length; idx++ {
+				elemKeys = append(elemKeys, strconv.Itoa(idx))
+			}
+		case tftypes.Set:
+			elemType = t.ElementType
+			elemKeys = flatmapChildKeys(flatmap, key, "#", false)
+		}
+
+		elems := make([]tftypes.Value, 0, len(elemKeys))
+
+		for _, elemKey := range elemKeys {
+			elem, err := flatmapValue(flatmap, key+"."+elemKey, elemType)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elems = append(elems, elem)
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	case typ.Is(tftypes.Map{}):
+		count, ok := flatmap[key+".%"]
+
+		if !ok {
+			// terraform-plugin-sdk versions before 0.11 used the list count key.
+			count, ok = flatmap[key+".#"]
+		}
+
+		if !ok {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		if count == flatmapUnknownValue {
+			return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+		}
+
+		elemType := typ.(tftypes.Map).ElementType
+
+		// Map keys of primitive elements can contain periods, so the entire
+		// remainder of the flatmap key is the map key.
+		primitive := elemType.Is(tftypes.Bool) || elemType.Is(tftypes.Number) || elemType.Is(tftypes.String)
+		elems := make(map[string]tftypes.Value)
+
+		for _, elemKey := range flatmapChildKeys(flatmap, key, "%", primitive) {
+			if elemKey == "#" {
+				continue
+			}
+
+			elem, err := flatmapValue(flatmap, key+"."+elemKey, elemType)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			elems[elemKey] = elem
+		}
+
+		return tftypes.NewValue(typ, elems), nil
+	case typ.Is(tftypes.Object{}):
+		if key != "" && len(flatmapChildKeys(flatmap, key, "", false)) == 0 {
+			return tftypes.NewValue(typ, nil), nil
+		}
+
+		attrs := make(map[string]tftypes.Value)
+
+		for name, attrType := range typ.(tftypes.Object).AttributeTypes {
+			attrKey := name
+
+			if key != "" {
+				attrKey = key + "." + name
+			}
+
+			attr, err := flatmapValue(flatmap, attrKey, attrType)
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			attrs[name] = attr
+		}
+
+		return tftypes.NewValue(typ, attrs), nil
+	default:
+		return tftypes.Value{}, fmt.Errorf("%s: unsupported type %s in flatmap state", key, typ)
+	}
+}
+
+// flatmapPrimitiveValue converts the raw flatmap string into a value of the
+// given primitive type.
+func flatmapPrimitiveValue(key string, raw string, typ tftypes.Type) (tftypes.Value, error) {
+	switch {
+	case typ.Is(tftypes.Bool):
+		b, err := strconv.ParseBool(raw)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: invalid bool %q: %w", key, raw, err)
+		}
+
+		return tftypes.NewValue(typ, b), nil
+	case typ.Is(tftypes.Number):
+		n, _, err := big.ParseFloat(raw, 10, 512, big.ToNearestEven)
+
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: invalid number %q: %w", key, raw, err)
+		}
+
+		return tftypes.NewValue(typ, n), nil
+	default:
+		return tftypes.NewValue(typ, raw), nil
+	}
+}
+
+// flatmapChildKeys returns the distinct child keys under the flatmap key, in
+// lexical order, excluding the count key. When remainder is true, the entire
+// remainder of each flatmap key is returned, rather than only its next
+// segment.
+func flatmapChildKeys(flatmap map[string]string, key string, countKey string, remainder bool) []string {
+	prefix := key + "."
+	seen := make(map[string]struct{})
+
+	for flatmapKey := range flatmap {
+		if !strings.HasPrefix(flatmapKey, prefix) {
+			continue
+		}
+
+		childKey := strings.TrimPrefix(flatmapKey, prefix)
+
+		if !remainder {
+			childKey = strings.SplitN(childKey, ".", 2)[0]
+		}
+
+		if childKey == countKey {
+			continue
+		}
+
+		seen[childKey] = struct{}{}
+	}
+
+	result := make([]string, 0, len(seen))
+
+	for childKey := range seen {
+		result = append(result, childKey)
+	}
+
+	sort.Strings(result)
+
+	return result
+}
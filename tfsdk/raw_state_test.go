@@ -0,0 +1,201 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeResourceStateRequestDecodeRawState(t *testing.T) {
+	t.Parallel()
+
+	priorSchema := Schema{
+		Attributes: map[string]Attribute{
+			"enabled": {
+				Type:     types.BoolType,
+				Optional: true,
+			},
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"count": {
+				Type:     types.NumberType,
+				Optional: true,
+			},
+			"names": {
+				Type:     types.ListType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"tags": {
+				Type:     types.MapType{ElemType: types.StringType},
+				Optional: true,
+			},
+			"zones": {
+				Type:     types.SetType{ElemType: types.StringType},
+				Optional: true,
+			},
+		},
+		Blocks: map[string]Block{
+			"disk": {
+				Attributes: map[string]Attribute{
+					"size": {
+						Type:     types.NumberType,
+						Optional: true,
+					},
+				},
+				NestingMode: BlockNestingModeList,
+			},
+		},
+	}
+	diskType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"size": tftypes.Number,
+		},
+	}
+	schemaType := priorSchema.TerraformType(context.Background())
+
+	testCases := map[string]struct {
+		rawState      *tfprotov6.RawState
+		expected      *State
+		expectedDiags diag.Diagnostics
+	}{
+		"flatmap": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"count":         "1.5",
+					"disk.#":        "2",
+					"disk.0.size":   "10",
+					"disk.1.size":   "20",
+					"enabled":       "true",
+					"id":            "test-id",
+					"names.#":       "2",
+					"names.0":       "first",
+					"names.1":       "second",
+					"tags.%":        "1",
+					"tags.with.dot": "value",
+					"zones.#":       "2",
+					"zones.1234":    "zone-b",
+					"zones.5678":    "zone-a",
+				},
+			},
+			expected: &State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"count": tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+					"disk": tftypes.NewValue(tftypes.List{ElementType: diskType}, []tftypes.Value{
+						tftypes.NewValue(diskType, map[string]tftypes.Value{
+							"size": tftypes.NewValue(tftypes.Number, big.NewFloat(10)),
+						}),
+						tftypes.NewValue(diskType, map[string]tftypes.Value{
+							"size": tftypes.NewValue(tftypes.Number, big.NewFloat(20)),
+						}),
+					}),
+					"enabled": tftypes.NewValue(tftypes.Bool, true),
+					"id":      tftypes.NewValue(tftypes.String, "test-id"),
+					"names": tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "first"),
+						tftypes.NewValue(tftypes.String, "second"),
+					}),
+					"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"with.dot": tftypes.NewValue(tftypes.String, "value"),
+					}),
+					"zones": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "zone-b"),
+						tftypes.NewValue(tftypes.String, "zone-a"),
+					}),
+				}),
+				Schema: priorSchema,
+			},
+		},
+		"flatmap-null-and-unknown": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"id":      flatmapUnknownValue,
+					"names.#": flatmapUnknownValue,
+				},
+			},
+			expected: &State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"count":   tftypes.NewValue(tftypes.Number, nil),
+					"disk":    tftypes.NewValue(tftypes.List{ElementType: diskType}, nil),
+					"enabled": tftypes.NewValue(tftypes.Bool, nil),
+					"id":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"names":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue),
+					"tags":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"zones":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				}),
+				Schema: priorSchema,
+			},
+		},
+		"flatmap-invalid-number": {
+			rawState: &tfprotov6.RawState{
+				Flatmap: map[string]string{
+					"count": "not-a-number",
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Read Previously Saved State for UpgradeResourceState",
+					"There was an error reading the saved resource state using the prior resource schema.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						`count: invalid number "not-a-number": number has no digits`,
+				),
+			},
+		},
+		"json": {
+			rawState: &tfprotov6.RawState{
+				JSON: []byte(`{"count":null,"disk":null,"enabled":null,"id":"test-id","names":null,"tags":null,"zones":null}`),
+			},
+			expected: &State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"count":   tftypes.NewValue(tftypes.Number, nil),
+					"disk":    tftypes.NewValue(tftypes.List{ElementType: diskType}, nil),
+					"enabled": tftypes.NewValue(tftypes.Bool, nil),
+					"id":      tftypes.NewValue(tftypes.String, "test-id"),
+					"names":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+					"tags":    tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+					"zones":   tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+				}),
+				Schema: priorSchema,
+			},
+		},
+		"missing": {
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unable to Read Previously Saved State for UpgradeResourceState",
+					"There was an error reading the saved resource state using the prior resource schema.\n\n"+
+						"Please report this to the provider developer:\n\n"+
+						"RawState is missing",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := UpgradeResourceStateRequest{
+				RawState: tc.rawState,
+			}
+
+			got, diags := req.DecodeRawState(context.Background(), priorSchema)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// RedactedValue is the string which replaces the values of Sensitive string
+// attributes in redacted data.
+const RedactedValue = "(sensitive value)"
+
+// Redacted returns a copy of the configuration with the values of Sensitive
+// attributes masked, for safe inclusion in logs and bug reports. It must not
+// be used as actual configuration data.
+func (c Config) Redacted(ctx context.Context) (Config, diag.Diagnostics) {
+	raw, diags := redacted(ctx, c.Schema, c.Raw, "Configuration Read Error", "configuration")
+
+	return Config{
+		Raw:    raw,
+		Schema: c.Schema,
+	}, diags
+}
+
+// Redacted returns a copy of the plan with the values of Sensitive
+// attributes masked, for safe inclusion in logs and bug reports. It must not
+// be returned as the planned data.
+func (p Plan) Redacted(ctx context.Context) (Plan, diag.Diagnostics) {
+	raw, diags := redacted(ctx, p.Schema, p.Raw, "Plan Read Error", "plan")
+
+	return Plan{
+		Raw:    raw,
+		Schema: p.Schema,
+	}, diags
+}
+
+// Redacted returns a copy of the state with the values of Sensitive
+// attributes masked, for safe inclusion in logs and bug reports. It must not
+// be returned as the new state.
+func (s State) Redacted(ctx context.Context) (State, diag.Diagnostics) {
+	raw, diags := redacted(ctx, s.Schema, s.Raw, "State Read Error", "state")
+
+	return State{
+		Raw:    raw,
+		Schema: s.Schema,
+	}, diags
+}
+
+// redacted returns a copy of raw with the values of Sensitive attributes,
+// including any values nested beneath them, masked. Known string values are
+// replaced with RedactedValue and other known values, which cannot hold the
+// placeholder, are replaced with an unknown value of the same type. Null and
+// unknown values reveal nothing and are left as-is.
+//
+// The summary and dataName describe the raw data in diagnostics, such as
+// "State Read Error" and "state".
+func redacted(ctx context.Context, schema Schema, raw tftypes.Value, summary string, dataName string) (tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if raw.IsNull() || !definesSensitiveAttributes(schema.Attributes, schema.Blocks) {
+		return raw, diags
+	}
+
+	result, err := tftypes.Transform(raw, func(path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+		// we are only modifying attributes, not the entire resource
+		if len(path.Steps()) < 1 {
+			return val, nil
+		}
+
+		if !val.IsKnown() || val.IsNull() {
+			return val, nil
+		}
+
+		attribute, err := schema.attributeAtTerraformPath(path)
+
+		if err != nil {
+			if errors.Is(err, ErrPathInsideAtomicAttribute) || errors.Is(err, ErrPathIsBlock) {
+				return val, nil
+			}
+
+			return tftypes.Value{}, fmt.Errorf("couldn't find attribute in schema: %w", err)
+		}
+
+		if !attribute.Sensitive {
+			return val, nil
+		}
+
+		if val.Type().Is(tftypes.String) {
+			return tftypes.NewValue(tftypes.String, RedactedValue), nil
+		}
+
+		return tftypes.NewValue(val.Type(), tftypes.UnknownValue), nil
+	})
+
+	if err != nil {
+		diags.AddError(
+			summary,
+			"An unexpected error was encountered trying to redact sensitive values from the "+dataName+". This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return tftypes.Value{}, diags
+	}
+
+	return result, diags
+}
+
+// definesSensitiveAttributes returns true if any of the attributes, including
+// nested attributes and attributes in blocks, is Sensitive.
+func definesSensitiveAttributes(attributes map[string]Attribute, blocks map[string]Block) bool {
+	for _, attribute := range attributes {
+		if attribute.Sensitive {
+			return true
+		}
+
+		if attribute.definesAttributes() && definesSensitiveAttributes(attribute.Attributes.GetAttributes(), nil) {
+			return true
+		}
+	}
+
+	for _, block := range blocks {
+		if definesSensitiveAttributes(block.Attributes, block.Blocks) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,161 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStateRedacted(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":   tftypes.String,
+			"secret": tftypes.String,
+		},
+	}
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"block":    tftypes.List{ElementType: nestedType},
+			"id":       tftypes.String,
+			"nested":   nestedType,
+			"password": tftypes.String,
+			"pin":      tftypes.Number,
+			"tags":     tftypes.Map{ElementType: tftypes.String},
+			"token":    tftypes.String,
+		},
+	}
+	nestedAttributes := map[string]Attribute{
+		"name": {
+			Type:     types.StringType,
+			Optional: true,
+		},
+		"secret": {
+			Type:      types.StringType,
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(nestedAttributes),
+				Optional:   true,
+			},
+			"password": {
+				Type:      types.StringType,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"pin": {
+				Type:      types.NumberType,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"tags": {
+				Type:      types.MapType{ElemType: types.StringType},
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token": {
+				Type:      types.StringType,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+		Blocks: map[string]Block{
+			"block": {
+				Attributes:  nestedAttributes,
+				NestingMode: BlockNestingModeList,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		state         State
+		expected      State
+		expectedDiags diag.Diagnostics
+	}{
+		"null": {
+			state: State{
+				Raw:    tftypes.NewValue(schemaType, nil),
+				Schema: schema,
+			},
+			expected: State{
+				Raw:    tftypes.NewValue(schemaType, nil),
+				Schema: schema,
+			},
+		},
+		"sensitive": {
+			state: State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"block": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+						tftypes.NewValue(nestedType, map[string]tftypes.Value{
+							"name":   tftypes.NewValue(tftypes.String, "block-name"),
+							"secret": tftypes.NewValue(tftypes.String, "block-secret"),
+						}),
+					}),
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+					"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+						"name":   tftypes.NewValue(tftypes.String, "nested-name"),
+						"secret": tftypes.NewValue(tftypes.String, "nested-secret"),
+					}),
+					"password": tftypes.NewValue(tftypes.String, "hunter2"),
+					"pin":      tftypes.NewValue(tftypes.Number, 1234),
+					"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+						"key": tftypes.NewValue(tftypes.String, "value"),
+					}),
+					"token": tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: schema,
+			},
+			expected: State{
+				Raw: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"block": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+						tftypes.NewValue(nestedType, map[string]tftypes.Value{
+							"name":   tftypes.NewValue(tftypes.String, "block-name"),
+							"secret": tftypes.NewValue(tftypes.String, RedactedValue),
+						}),
+					}),
+					"id": tftypes.NewValue(tftypes.String, "test-id"),
+					"nested": tftypes.NewValue(nestedType, map[string]tftypes.Value{
+						"name":   tftypes.NewValue(tftypes.String, "nested-name"),
+						"secret": tftypes.NewValue(tftypes.String, RedactedValue),
+					}),
+					"password": tftypes.NewValue(tftypes.String, RedactedValue),
+					"pin":      tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+					"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tftypes.UnknownValue),
+					"token":    tftypes.NewValue(tftypes.String, nil),
+				}),
+				Schema: schema,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := tc.state.Redacted(context.Background())
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
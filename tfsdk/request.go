@@ -8,7 +8,8 @@ type ConfigureProviderRequest struct {
 	// TerraformVersion is the version of Terraform executing the request.
 	// This is supplied for logging, analytics, and User-Agent purposes
 	// only. Providers should not try to gate provider behavior on
-	// Terraform versions.
+	// Terraform versions. It is also available to later requests via
+	// TerraformVersionFromContext.
 	TerraformVersion string
 
 	// Config is the configuration the user supplied for the provider. This
@@ -18,6 +19,29 @@ type ConfigureProviderRequest struct {
 	Config Config
 }
 
+// ConfigureResourceRequest represents a request for the provider to configure
+// a resource instance. An instance of this request struct is supplied as an
+// argument to the resource's Configure function.
+type ConfigureResourceRequest struct {
+	// ProviderData is the ResourceData set by the Provider's Configure
+	// method, or nil if the provider has not been configured yet.
+	ProviderData interface{}
+}
+
+// ConfigureDataSourceRequest represents a request for the provider to
+// configure a data source instance. An instance of this request struct is
+// supplied as an argument to the data source's Configure function.
+type ConfigureDataSourceRequest struct {
+	// ProviderData is the DataSourceData set by the Provider's Configure
+	// method, or nil if the provider has not been configured yet.
+	ProviderData interface{}
+}
+
+// ResourceMetadataRequest represents a request for the resource to return
+// its metadata. An instance of this request struct is supplied as an
+// argument to the resource's Metadata function.
+type ResourceMetadataRequest struct{}
+
 // CreateResourceRequest represents a request for the provider to create a
 // resource. An instance of this request struct is supplied as an argument to
 // the resource's Create function.
@@ -32,6 +56,10 @@ type CreateResourceRequest struct {
 	// Plan is the planned state for the resource.
 	Plan Plan
 
+	// Private is the private state planned for the resource, which is
+	// usually the private state of a prior ModifyPlan call.
+	Private PrivateState
+
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
 }
@@ -45,6 +73,10 @@ type ReadResourceRequest struct {
 	// operation.
 	State State
 
+	// Private is the private state of the resource prior to the Read
+	// operation.
+	Private PrivateState
+
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
 }
@@ -67,6 +99,10 @@ type UpdateResourceRequest struct {
 	// operation.
 	State State
 
+	// Private is the private state planned for the resource, which is
+	// usually the private state of a prior ModifyPlan call.
+	Private PrivateState
+
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
 }
@@ -79,6 +115,10 @@ type DeleteResourceRequest struct {
 	// operation.
 	State State
 
+	// Private is the private state of the resource prior to the Delete
+	// operation.
+	Private PrivateState
+
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
 }
@@ -99,6 +139,10 @@ type ModifyResourcePlanRequest struct {
 	// Plan is the planned new state for the resource.
 	Plan Plan
 
+	// Private is the private state of the resource. It is empty if the
+	// resource is being created.
+	Private PrivateState
+
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
 }
@@ -0,0 +1,23 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// UpgradeResourceStateRequest represents a request for the provider to upgrade
+// prior resource state data into the current schema version. An instance of
+// this request struct is supplied as an argument to the StateUpgrader
+// function of the ResourceStateUpgrader for the prior schema version.
+type UpgradeResourceStateRequest struct {
+	// RawState is the prior state data, as saved by Terraform. It is always
+	// populated. Implementations without a PriorSchema can use its JSON
+	// field, or its Flatmap field for state data written by Terraform 0.11
+	// and earlier, to decode the prior state data. DecodeRawState can decode
+	// either with a prior schema.
+	RawState *tfprotov6.RawState
+
+	// State is the prior state data, decoded with the PriorSchema of the
+	// ResourceStateUpgrader. It is only populated when PriorSchema is set.
+	// Flatmap state data is decoded the same as with DecodeRawState.
+	State *State
+}
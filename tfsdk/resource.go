@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // A ResourceType is a type of resource. For each type of resource this provider
@@ -11,6 +12,10 @@ import (
 // instance of it in the map returned by Provider.GetResources.
 type ResourceType interface {
 	// GetSchema returns the schema for this resource.
+	//
+	// The schema is only constructed when first needed and is then reused
+	// for the lifetime of the provider server, unless an error diagnostic
+	// is returned. The schema must not change between calls.
 	GetSchema(context.Context) (Schema, diag.Diagnostics)
 
 	// NewResource instantiates a new Resource of this ResourceType.
@@ -77,3 +82,84 @@ type ResourceWithModifyPlan interface {
 	// Any errors will prevent further resource-level plan modifications.
 	ModifyPlan(context.Context, ModifyResourcePlanRequest, *ModifyResourcePlanResponse)
 }
+
+// ResourceWithUpgradeState represents a resource instance with an
+// UpgradeState function.
+type ResourceWithUpgradeState interface {
+	Resource
+
+	// UpgradeState returns a mapping of prior schema versions to state
+	// upgrade implementations, which convert prior state data into the
+	// current schema version. Only the implementation for the prior state
+	// version is called, so each implementation must upgrade directly to
+	// the current schema version rather than the next version.
+	//
+	// State data saved with the current schema version is handled by the
+	// framework and does not need an implementation.
+	UpgradeState(context.Context) map[int64]ResourceStateUpgrader
+}
+
+// ResourceWithConfigure represents a resource instance with a Configure
+// function.
+type ResourceWithConfigure interface {
+	Resource
+
+	// Configure is called after NewResource, before any other method of the
+	// resource, with the ResourceData set by the Provider's Configure
+	// method. This allows resources to receive an API client, for example,
+	// without depending on the concrete type of the Provider.
+	//
+	// ConfigureResourceRequest.ProviderData is nil when the provider has
+	// not been configured yet, such as during configuration validation, so
+	// implementations must not return an error in that case.
+	Configure(context.Context, ConfigureResourceRequest, *ConfigureResourceResponse)
+}
+
+// ResourceWithMetadata represents a resource instance which describes its
+// own type name and schema, so it can be registered with
+// ProviderWithResources without a separate ResourceType.
+type ResourceWithMetadata interface {
+	Resource
+
+	// Metadata returns the type name of the resource, such as
+	// examplecloud_thing.
+	Metadata(context.Context, ResourceMetadataRequest, *ResourceMetadataResponse)
+
+	// GetSchema returns the schema for this resource.
+	//
+	// The schema is only constructed when first needed and is then reused
+	// for the lifetime of the provider server, unless an error diagnostic
+	// is returned. The schema must not change between calls.
+	GetSchema(context.Context) (Schema, diag.Diagnostics)
+}
+
+// ResourceWithPostApplyHooks represents a resource instance with hooks which
+// are called after a successful Create, Update, or Delete.
+type ResourceWithPostApplyHooks interface {
+	Resource
+
+	// PostApplyHooks returns the hooks to call, in order, after the
+	// resource has been successfully created, updated, or deleted.
+	PostApplyHooks(context.Context) []PostApplyHook
+}
+
+// ResourceWithInconsistentApply represents a resource instance whose remote
+// API may return values which differ from the plan, such as normalized
+// values, which cannot be planned ahead of time.
+//
+// After Create and Update, the framework returns an error diagnostic for
+// each value of the new state which differs from a known planned value. The
+// new state must still be wholly known.
+type ResourceWithInconsistentApply interface {
+	Resource
+
+	// InconsistentApplyPaths returns the paths, including any values
+	// beneath them, whose new state values may differ from the planned
+	// values. path.Empty() allows any value to differ.
+	//
+	// Terraform reports values which differ from the plan as warnings in
+	// its logs for providers built with the legacy SDK, but returns errors
+	// for framework providers, so this is only intended for use while
+	// remote API quirks are being addressed.
+	InconsistentApplyPaths(context.Context) path.Paths
+}
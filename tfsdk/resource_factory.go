@@ -0,0 +1,88 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var _ ResourceType = resourceFactoryType{}
+
+// resourceFactoryType adapts a resource factory of ProviderWithResources to
+// a ResourceType, so the rest of the framework handles both kinds of
+// resources the same way.
+type resourceFactoryType struct {
+	factory func() ResourceWithMetadata
+}
+
+// GetSchema returns the schema of a new instance of the resource.
+func (t resourceFactoryType) GetSchema(ctx context.Context) (Schema, diag.Diagnostics) {
+	return t.factory().GetSchema(ctx)
+}
+
+// NewResource returns a new instance of the resource.
+func (t resourceFactoryType) NewResource(_ context.Context, _ Provider) (Resource, diag.Diagnostics) {
+	return t.factory(), nil
+}
+
+// providerResourceTypes returns the resource types of the provider, from
+// both GetResources and, if implemented, ProviderWithResources.
+func providerResourceTypes(ctx context.Context, p Provider) (map[string]ResourceType, diag.Diagnostics) {
+	resourceTypes, diags := p.GetResources(ctx)
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	pr, ok := p.(ProviderWithResources)
+
+	if !ok {
+		return resourceTypes, diags
+	}
+
+	factories := pr.Resources(ctx)
+
+	if len(factories) == 0 {
+		return resourceTypes, diags
+	}
+
+	result := make(map[string]ResourceType, len(resourceTypes)+len(factories))
+
+	for typeName, resourceType := range resourceTypes {
+		result[typeName] = resourceType
+	}
+
+	for _, factory := range factories {
+		resp := &ResourceMetadataResponse{}
+		factory().Metadata(ctx, ResourceMetadataRequest{}, resp)
+
+		if resp.TypeName == "" {
+			diags.AddError(
+				"Resource Type Name Missing",
+				"An unexpected error was encountered trying to register the provider resources. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					"A resource returned by Resources did not set a TypeName in its Metadata method.",
+			)
+			continue
+		}
+
+		if _, ok := result[resp.TypeName]; ok {
+			diags.AddError(
+				"Duplicate Resource Type Defined",
+				"An unexpected error was encountered trying to register the provider resources. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+					fmt.Sprintf("The %q resource type name was returned for multiple resources. Resource type names must be unique.", resp.TypeName),
+			)
+			continue
+		}
+
+		result[resp.TypeName] = resourceFactoryType{
+			factory: factory,
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return result, diags
+}
@@ -0,0 +1,174 @@
+package tfsdk
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testServeProviderWithResources struct {
+	*testServeProvider
+
+	resources []func() ResourceWithMetadata
+}
+
+func (p testServeProviderWithResources) Resources(_ context.Context) []func() ResourceWithMetadata {
+	return p.resources
+}
+
+type testResourceWithMetadata struct {
+	Resource
+
+	typeName string
+}
+
+func (r testResourceWithMetadata) Metadata(_ context.Context, _ ResourceMetadataRequest, resp *ResourceMetadataResponse) {
+	resp.TypeName = r.typeName
+}
+
+func (r testResourceWithMetadata) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	return Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, nil
+}
+
+func testResourceWithMetadataFactory(typeName string) func() ResourceWithMetadata {
+	return func() ResourceWithMetadata {
+		return testResourceWithMetadata{
+			typeName: typeName,
+		}
+	}
+}
+
+func TestProviderResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resources         []func() ResourceWithMetadata
+		expectedTypeNames []string
+		expectedDiags     diag.Diagnostics
+	}{
+		"none": {
+			expectedTypeNames: []string{
+				"test_attribute_plan_modifiers",
+				"test_config_validators",
+				"test_import_state",
+				"test_one",
+				"test_three",
+				"test_two",
+				"test_upgrade_state",
+				"test_validate_config",
+			},
+		},
+		"factories": {
+			resources: []func() ResourceWithMetadata{
+				testResourceWithMetadataFactory("test_factory_one"),
+				testResourceWithMetadataFactory("test_factory_two"),
+			},
+			expectedTypeNames: []string{
+				"test_attribute_plan_modifiers",
+				"test_config_validators",
+				"test_factory_one",
+				"test_factory_two",
+				"test_import_state",
+				"test_one",
+				"test_three",
+				"test_two",
+				"test_upgrade_state",
+				"test_validate_config",
+			},
+		},
+		"duplicate": {
+			resources: []func() ResourceWithMetadata{
+				testResourceWithMetadataFactory("test_one"),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Duplicate Resource Type Defined",
+					"An unexpected error was encountered trying to register the provider resources. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"The \"test_one\" resource type name was returned for multiple resources. Resource type names must be unique.",
+				),
+			},
+		},
+		"missing-type-name": {
+			resources: []func() ResourceWithMetadata{
+				testResourceWithMetadataFactory(""),
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Type Name Missing",
+					"An unexpected error was encountered trying to register the provider resources. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"A resource returned by Resources did not set a TypeName in its Metadata method.",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p := testServeProviderWithResources{
+				testServeProvider: &testServeProvider{},
+				resources:         tc.resources,
+			}
+
+			got, diags := providerResourceTypes(context.Background(), p)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			var gotTypeNames []string
+
+			for typeName := range got {
+				gotTypeNames = append(gotTypeNames, typeName)
+			}
+
+			sort.Strings(gotTypeNames)
+
+			if diff := cmp.Diff(gotTypeNames, tc.expectedTypeNames); diff != "" {
+				t.Errorf("unexpected type names difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceFactoryType(t *testing.T) {
+	t.Parallel()
+
+	resourceType := resourceFactoryType{
+		factory: testResourceWithMetadataFactory("test_factory"),
+	}
+
+	schema, diags := resourceType.GetSchema(context.Background())
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if _, ok := schema.Attributes["id"]; !ok {
+		t.Errorf("expected schema with id attribute, got: %v", schema)
+	}
+
+	resource, diags := resourceType.NewResource(context.Background(), nil)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got, ok := resource.(testResourceWithMetadata); !ok || got.typeName != "test_factory" {
+		t.Errorf("unexpected resource: %#v", resource)
+	}
+}
@@ -2,8 +2,10 @@ package tfsdk
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // ResourceImportStateNotImplemented is a helper function to return an error
@@ -24,8 +26,8 @@ func ResourceImportStateNotImplemented(ctx context.Context, details string, resp
 // ResourceImportStatePassthroughID is a helper function to set the import
 // identifier to a given state attribute path. The attribute must accept a
 // string value.
-func ResourceImportStatePassthroughID(ctx context.Context, path *tftypes.AttributePath, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
-	if path == nil || tftypes.NewAttributePath().Equal(path) {
+func ResourceImportStatePassthroughID(ctx context.Context, path path.Path, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	if len(path.Steps()) == 0 {
 		resp.Diagnostics.AddError(
 			"Resource Import Passthrough Missing Attribute Path",
 			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
@@ -35,3 +37,47 @@ func ResourceImportStatePassthroughID(ctx context.Context, path *tftypes.Attribu
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path, req.ID)...)
 }
+
+// ResourceImportStatePassthroughMultipartID is a helper function to split a
+// composite import identifier, such as "cluster/namespace/name", on the
+// separator and set each part to the given state attribute paths in order.
+// The attributes must accept a string value. An error diagnostic describing
+// the expected format is returned if the identifier does not have exactly one
+// non-empty part per path.
+func ResourceImportStatePassthroughMultipartID(ctx context.Context, separator string, paths path.Paths, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+	if len(paths) == 0 || separator == "" {
+		resp.Diagnostics.AddError(
+			"Resource Import Passthrough Missing Attribute Paths",
+			"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				"Resource ImportState method call to ResourceImportStatePassthroughMultipartID must set a separator and at least one valid attribute path that can accept a string value.",
+		)
+		return
+	}
+
+	parts := strings.Split(req.ID, separator)
+	valid := len(parts) == len(paths)
+
+	for _, part := range parts {
+		if part == "" {
+			valid = false
+		}
+	}
+
+	if !valid {
+		format := make([]string, 0, len(paths))
+
+		for _, p := range paths {
+			format = append(format, p.String())
+		}
+
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: %s. Got: %q", strings.Join(format, separator), req.ID),
+		)
+		return
+	}
+
+	for idx, p := range paths {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, p, parts[idx])...)
+	}
+}
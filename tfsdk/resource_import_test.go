@@ -0,0 +1,137 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceImportStatePassthroughMultipartID(t *testing.T) {
+	t.Parallel()
+
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"cluster":   tftypes.String,
+			"name":      tftypes.String,
+			"namespace": tftypes.String,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"cluster": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+			"namespace": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+	paths := path.Paths{
+		path.Root("cluster"),
+		path.Root("namespace"),
+		path.Root("name"),
+	}
+
+	testCases := map[string]struct {
+		id            string
+		separator     string
+		paths         path.Paths
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			id:        "test-cluster/test-namespace/test-name",
+			separator: "/",
+			paths:     paths,
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"cluster":   tftypes.NewValue(tftypes.String, "test-cluster"),
+				"name":      tftypes.NewValue(tftypes.String, "test-name"),
+				"namespace": tftypes.NewValue(tftypes.String, "test-namespace"),
+			}),
+		},
+		"too-few-parts": {
+			id:        "test-cluster/test-name",
+			separator: "/",
+			paths:     paths,
+			expected:  tftypes.NewValue(schemaType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: cluster/namespace/name. Got: "test-cluster/test-name"`,
+				),
+			},
+		},
+		"too-many-parts": {
+			id:        "test-cluster/test-namespace/test-name/extra",
+			separator: "/",
+			paths:     paths,
+			expected:  tftypes.NewValue(schemaType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: cluster/namespace/name. Got: "test-cluster/test-namespace/test-name/extra"`,
+				),
+			},
+		},
+		"empty-part": {
+			id:        "test-cluster//test-name",
+			separator: "/",
+			paths:     paths,
+			expected:  tftypes.NewValue(schemaType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Unexpected Import Identifier",
+					`Expected import identifier with format: cluster/namespace/name. Got: "test-cluster//test-name"`,
+				),
+			},
+		},
+		"missing-paths": {
+			id:        "test-cluster/test-namespace/test-name",
+			separator: "/",
+			expected:  tftypes.NewValue(schemaType, nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Resource Import Passthrough Missing Attribute Paths",
+					"This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"Resource ImportState method call to ResourceImportStatePassthroughMultipartID must set a separator and at least one valid attribute path that can accept a string value.",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &ImportResourceStateResponse{
+				State: State{
+					Raw:    tftypes.NewValue(schemaType, nil),
+					Schema: schema,
+				},
+			}
+
+			ResourceImportStatePassthroughMultipartID(context.Background(), tc.separator, tc.paths, ImportResourceStateRequest{ID: tc.id}, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(resp.State.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
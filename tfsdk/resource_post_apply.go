@@ -0,0 +1,94 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// PostApplyOperation is the resource operation which completed before a
+// PostApplyHook is called.
+type PostApplyOperation string
+
+// PostApplyOperation values are named after the resource method which
+// completed.
+const (
+	PostApplyOperationCreate PostApplyOperation = "Create"
+	PostApplyOperationUpdate PostApplyOperation = "Update"
+	PostApplyOperationDelete PostApplyOperation = "Delete"
+)
+
+// PostApplyHook is called after a resource has been successfully created,
+// updated, or deleted, while the apply request is still being handled. Hooks
+// are not called when the new state of a create or update fails the apply
+// consistency check, since Terraform rejects that state. This
+// allows behavior such as invalidating caches, emitting events, or waiting
+// for an eventually consistent API to reflect the change.
+type PostApplyHook interface {
+	// PostApply is called with the result of the resource operation. Any
+	// diagnostics are returned with the diagnostics of the operation.
+	//
+	// The new state is saved regardless of any error diagnostics, since
+	// the operation itself succeeded. Terraform marks a newly created
+	// resource as tainted when errors are returned, so it is replaced on
+	// the next apply.
+	PostApply(context.Context, PostApplyRequest, *PostApplyResponse)
+}
+
+// PostApplyRequest represents a request to call a post-apply hook. An
+// instance of this request struct is supplied as an argument to the hook's
+// PostApply function.
+type PostApplyRequest struct {
+	// Operation is the resource operation which completed.
+	Operation PostApplyOperation
+
+	// TypeName is the type of the resource.
+	TypeName string
+
+	// PriorState is the state of the resource prior to the operation. It
+	// is null when the resource was created.
+	PriorState State
+
+	// State is the new state of the resource following the operation. It
+	// is null when the resource was deleted.
+	State State
+
+	// Private is the new private state of the resource following the
+	// operation. It is empty when the resource was deleted. Changes to it
+	// are not saved.
+	Private PrivateState
+}
+
+// PostApplyResponse represents a response to a PostApplyRequest. An
+// instance of this response struct is supplied as an argument to the
+// hook's PostApply function.
+type PostApplyResponse struct {
+	// Diagnostics report errors or warnings related to the hook. They are
+	// returned with the diagnostics of the resource operation.
+	Diagnostics diag.Diagnostics
+}
+
+// postApply calls the post-apply hooks of the resource, if any, and returns
+// their diagnostics. Every hook is called, even when an earlier hook
+// returns an error, so hooks such as cache invalidation are not skipped.
+func postApply(ctx context.Context, resource Resource, req PostApplyRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	r, ok := resource.(ResourceWithPostApplyHooks)
+
+	if !ok {
+		return diags
+	}
+
+	for _, hook := range r.PostApplyHooks(ctx) {
+		resp := &PostApplyResponse{}
+
+		tfsdklog.Trace(ctx, "calling post-apply hook", "operation", string(req.Operation))
+		hook.PostApply(ctx, req, resp)
+
+		diags.Append(resp.Diagnostics...)
+	}
+
+	return diags
+}
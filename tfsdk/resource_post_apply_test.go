@@ -0,0 +1,321 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testPostApplyResource copies the planned state to the new state, or
+// returns an error if fail is set. If inconsistent is set, the new state has
+// a different id than planned.
+type testPostApplyResource struct {
+	testResourceWithMetadata
+
+	fail         bool
+	inconsistent bool
+	hooks        []PostApplyHook
+}
+
+func (r testPostApplyResource) Create(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+	if r.fail {
+		resp.Diagnostics.AddError("Create Failed", "")
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+
+	if r.inconsistent {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "inconsistent")...)
+	}
+}
+
+func (r testPostApplyResource) Update(ctx context.Context, req UpdateResourceRequest, resp *UpdateResourceResponse) {
+	if r.fail {
+		resp.Diagnostics.AddError("Update Failed", "")
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+
+	if r.inconsistent {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), "inconsistent")...)
+	}
+}
+
+func (r testPostApplyResource) Delete(ctx context.Context, req DeleteResourceRequest, resp *DeleteResourceResponse) {
+	if r.fail {
+		resp.Diagnostics.AddError("Delete Failed", "")
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
+
+func (r testPostApplyResource) PostApplyHooks(_ context.Context) []PostApplyHook {
+	return r.hooks
+}
+
+// testPostApplyHook records the operation and the prior and new IDs of each
+// call, and returns the diagnostic, if any.
+type testPostApplyHook struct {
+	calls *[]string
+	diag  func(*PostApplyResponse)
+}
+
+func (h testPostApplyHook) PostApply(ctx context.Context, req PostApplyRequest, resp *PostApplyResponse) {
+	var priorID, id types.String
+
+	if !req.PriorState.Raw.IsNull() {
+		resp.Diagnostics.Append(req.PriorState.GetAttribute(ctx, path.Root("id"), &priorID)...)
+	}
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
+	}
+
+	*h.calls = append(*h.calls, string(req.Operation)+" "+req.TypeName+" "+priorID.Value+"->"+id.Value)
+
+	if h.diag != nil {
+		h.diag(resp)
+	}
+}
+
+func TestServerPostApply(t *testing.T) {
+	t.Parallel()
+
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	state := func(id interface{}) tftypes.Value {
+		if id == nil {
+			return tftypes.NewValue(stateType, nil)
+		}
+
+		return tftypes.NewValue(stateType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, id),
+		})
+	}
+	warning := func(resp *PostApplyResponse) {
+		resp.Diagnostics.AddWarning("Hook Warning", "")
+	}
+	hookError := func(resp *PostApplyResponse) {
+		resp.Diagnostics.AddError("Hook Failed", "")
+	}
+
+	testCases := map[string]struct {
+		prior         tftypes.Value
+		planned       tftypes.Value
+		fail          bool
+		inconsistent  bool
+		hookDiags     []func(*PostApplyResponse)
+		expectedCalls []string
+		expectedState tftypes.Value
+		expectedDiags []*tfprotov6.Diagnostic
+	}{
+		"create": {
+			prior:     state(nil),
+			planned:   state("created"),
+			hookDiags: []func(*PostApplyResponse){nil, warning},
+			expectedCalls: []string{
+				"Create test_post_apply ->created",
+				"Create test_post_apply ->created",
+			},
+			expectedState: state("created"),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Hook Warning",
+				},
+			},
+		},
+		"create-error": {
+			prior:         state(nil),
+			planned:       state("created"),
+			fail:          true,
+			hookDiags:     []func(*PostApplyResponse){nil},
+			expectedState: state(nil),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Create Failed",
+				},
+			},
+		},
+		"create-hook-error": {
+			prior:     state(nil),
+			planned:   state("created"),
+			hookDiags: []func(*PostApplyResponse){hookError, nil},
+			expectedCalls: []string{
+				"Create test_post_apply ->created",
+				"Create test_post_apply ->created",
+			},
+			expectedState: state("created"),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Hook Failed",
+				},
+			},
+		},
+		"create-inconsistent": {
+			prior:         state(nil),
+			planned:       state("created"),
+			inconsistent:  true,
+			hookDiags:     []func(*PostApplyResponse){nil},
+			expectedState: state("inconsistent"),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Produced Inconsistent Result After Apply",
+					Detail: "When applying changes to the test_post_apply resource, the provider set a value which differs from the planned value. " +
+						"Known planned values must be returned unchanged, otherwise the value must be unknown in the plan, such as by marking the attribute Computed and leaving it unknown during ModifyPlan.\n\n" +
+						"This is always an error in the provider. Please report this to the provider developer.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("id"),
+				},
+			},
+		},
+		"update": {
+			prior:     state("current"),
+			planned:   state("updated"),
+			hookDiags: []func(*PostApplyResponse){nil},
+			expectedCalls: []string{
+				"Update test_post_apply current->updated",
+			},
+			expectedState: state("updated"),
+		},
+		"update-error": {
+			prior:         state("current"),
+			planned:       state("updated"),
+			fail:          true,
+			hookDiags:     []func(*PostApplyResponse){nil},
+			expectedState: state("current"),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Update Failed",
+				},
+			},
+		},
+		"update-inconsistent": {
+			prior:         state("current"),
+			planned:       state("updated"),
+			inconsistent:  true,
+			hookDiags:     []func(*PostApplyResponse){nil},
+			expectedState: state("inconsistent"),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Provider Produced Inconsistent Result After Apply",
+					Detail: "When applying changes to the test_post_apply resource, the provider set a value which differs from the planned value. " +
+						"Known planned values must be returned unchanged, otherwise the value must be unknown in the plan, such as by marking the attribute Computed and leaving it unknown during ModifyPlan.\n\n" +
+						"This is always an error in the provider. Please report this to the provider developer.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("id"),
+				},
+			},
+		},
+		"delete": {
+			prior:     state("current"),
+			planned:   state(nil),
+			hookDiags: []func(*PostApplyResponse){nil},
+			expectedCalls: []string{
+				"Delete test_post_apply current->",
+			},
+			expectedState: state(nil),
+		},
+		"none": {
+			prior:         state(nil),
+			planned:       state("created"),
+			expectedState: state("created"),
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+			var hooks []PostApplyHook
+
+			for _, hookDiag := range tc.hookDiags {
+				hooks = append(hooks, testPostApplyHook{calls: &calls, diag: hookDiag})
+			}
+
+			testServer := &server{
+				p: testServeProviderWithResources{
+					testServeProvider: &testServeProvider{},
+					resources: []func() ResourceWithMetadata{
+						func() ResourceWithMetadata {
+							return testPostApplyResource{
+								testResourceWithMetadata: testResourceWithMetadata{typeName: "test_post_apply"},
+								fail:                     tc.fail,
+								inconsistent:             tc.inconsistent,
+								hooks:                    hooks,
+							}
+						},
+					},
+				},
+			}
+
+			config, err := tfprotov6.NewDynamicValue(stateType, tc.planned)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			priorState, err := tfprotov6.NewDynamicValue(stateType, tc.prior)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			plannedState, err := tfprotov6.NewDynamicValue(stateType, tc.planned)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := testServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				Config:       &config,
+				PlannedState: &plannedState,
+				PriorState:   &priorState,
+				TypeName:     "test_post_apply",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(calls, tc.expectedCalls); diff != "" {
+				t.Errorf("unexpected calls difference: %s", diff)
+			}
+
+			if got.NewState == nil {
+				t.Fatal("expected new state")
+			}
+
+			newState, err := got.NewState.Unmarshal(stateType)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(newState, tc.expectedState); diff != "" {
+				t.Errorf("unexpected new state difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package tfsdk
+
+import (
+	"context"
+)
+
+// ResourceStateUpgrader represents a state upgrade implementation for a
+// single prior schema version.
+type ResourceStateUpgrader struct {
+	// PriorSchema, if set, is the schema of the prior state data. The prior
+	// state data is then decoded into UpgradeResourceStateRequest.State,
+	// rather than requiring the implementation to handle the raw state
+	// data in UpgradeResourceStateRequest.RawState.
+	//
+	// The Version of PriorSchema is not used.
+	PriorSchema *Schema
+
+	// StateUpgrader is the implementation which converts the prior state
+	// data into the current schema version. It must set either
+	// UpgradeResourceStateResponse.State or
+	// UpgradeResourceStateResponse.DynamicValue.
+	//
+	// UpgradeStateStub can generate a starting point for this
+	// implementation.
+	StateUpgrader func(context.Context, UpgradeResourceStateRequest, *UpgradeResourceStateResponse)
+}
@@ -2,7 +2,7 @@ package tfsdk
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // ConfigureProviderResponse represents a response to a
@@ -10,12 +10,50 @@ import (
 // an argument to the provider's Configure function, in which the provider
 // should set values on the ConfigureProviderResponse as appropriate.
 type ConfigureProviderResponse struct {
+	// DataSourceData is passed to the Configure method of data sources
+	// which implement DataSourceWithConfigure, such as an API client.
+	DataSourceData interface{}
+
+	// ResourceData is passed to the Configure method of resources which
+	// implement ResourceWithConfigure, such as an API client.
+	ResourceData interface{}
+
 	// Diagnostics report errors or warnings related to configuring the
 	// provider. An empty slice indicates success, with no warnings or
 	// errors generated.
 	Diagnostics diag.Diagnostics
 }
 
+// ConfigureResourceResponse represents a response to a
+// ConfigureResourceRequest. An instance of this response struct is supplied
+// as an argument to the resource's Configure function.
+type ConfigureResourceResponse struct {
+	// Diagnostics report errors or warnings related to configuring the
+	// resource. An empty slice indicates success, with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+}
+
+// ConfigureDataSourceResponse represents a response to a
+// ConfigureDataSourceRequest. An instance of this response struct is
+// supplied as an argument to the data source's Configure function.
+type ConfigureDataSourceResponse struct {
+	// Diagnostics report errors or warnings related to configuring the
+	// data source. An empty slice indicates success, with no warnings or
+	// errors generated.
+	Diagnostics diag.Diagnostics
+}
+
+// ResourceMetadataResponse represents a response to a
+// ResourceMetadataRequest. An instance of this response struct is supplied
+// as an argument to the resource's Metadata function, in which the resource
+// should set the TypeName.
+type ResourceMetadataResponse struct {
+	// TypeName is the type name of the resource, such as
+	// examplecloud_thing.
+	TypeName string
+}
+
 // CreateResourceResponse represents a response to a CreateResourceRequest. An
 // instance of this response struct is supplied as
 // an argument to the resource's Create function, in which the provider
@@ -26,6 +64,12 @@ type CreateResourceResponse struct {
 	// should be set during the resource's Create operation.
 	State State
 
+	// Private is the private state of the resource following the Create
+	// operation. This field is pre-populated from
+	// CreateResourceRequest.Private and can be modified during the
+	// resource's Create operation.
+	Private PrivateState
+
 	// Diagnostics report errors or warnings related to creating the
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
@@ -42,6 +86,12 @@ type ReadResourceResponse struct {
 	// should be set during the resource's Read operation.
 	State State
 
+	// Private is the private state of the resource following the Read
+	// operation. This field is pre-populated from
+	// ReadResourceRequest.Private and can be modified during the
+	// resource's Read operation.
+	Private PrivateState
+
 	// Diagnostics report errors or warnings related to reading the
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
@@ -58,6 +108,12 @@ type UpdateResourceResponse struct {
 	// should be set during the resource's Update operation.
 	State State
 
+	// Private is the private state of the resource following the Update
+	// operation. This field is pre-populated from
+	// UpdateResourceRequest.Private and can be modified during the
+	// resource's Update operation.
+	Private PrivateState
+
 	// Diagnostics report errors or warnings related to updating the
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
@@ -92,7 +148,12 @@ type ModifyResourcePlanResponse struct {
 	// resource to be replaced. They should point to the specific field
 	// that changed that requires the resource to be destroyed and
 	// recreated.
-	RequiresReplace []*tftypes.AttributePath
+	RequiresReplace path.Paths
+
+	// Private is the private state planned for the resource, which is
+	// passed to the Create or Update operation. This field is pre-populated
+	// from ModifyResourcePlanRequest.Private.
+	Private PrivateState
 
 	// Diagnostics report errors or warnings related to determining the
 	// planned state of the requested resource. Returning an empty slice
@@ -0,0 +1,94 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// apiErrorDiagnostic returns the standard error diagnostic for a failed
+// remote API call. The operation should complete the sentence "Unable to
+// ...", such as "create widget".
+func apiErrorDiagnostic(operation string, err error) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"API Error",
+		fmt.Sprintf("Unable to %s, got error: %s", operation, err),
+	)
+}
+
+// CreateSucceeded sets the entire State to the given model, which should be
+// a struct with tfsdk tags or an attr.Value matching the schema, following a
+// successful Create operation.
+func (r *CreateResourceResponse) CreateSucceeded(ctx context.Context, model interface{}) {
+	r.Diagnostics.Append(r.State.Set(ctx, model)...)
+}
+
+// FailWithAPIError adds the standard error diagnostic for a failed remote
+// API call. The operation should complete the sentence "Unable to ...", such
+// as "create widget".
+func (r *CreateResourceResponse) FailWithAPIError(operation string, err error) {
+	r.Diagnostics.Append(apiErrorDiagnostic(operation, err))
+}
+
+// ReadSucceeded sets the entire State to the given model, which should be
+// a struct with tfsdk tags or an attr.Value matching the schema, following a
+// successful Read operation.
+func (r *ReadResourceResponse) ReadSucceeded(ctx context.Context, model interface{}) {
+	r.Diagnostics.Append(r.State.Set(ctx, model)...)
+}
+
+// NotFoundRemove removes the resource from State, which should be called
+// when Read finds the remote resource no longer exists. Terraform will then
+// plan to create the resource again.
+func (r *ReadResourceResponse) NotFoundRemove(ctx context.Context) {
+	r.State.RemoveResource(ctx)
+}
+
+// FailWithAPIError adds the standard error diagnostic for a failed remote
+// API call. The operation should complete the sentence "Unable to ...", such
+// as "read widget".
+func (r *ReadResourceResponse) FailWithAPIError(operation string, err error) {
+	r.Diagnostics.Append(apiErrorDiagnostic(operation, err))
+}
+
+// UpdateSucceeded sets the entire State to the given model, which should be
+// a struct with tfsdk tags or an attr.Value matching the schema, following a
+// successful Update operation.
+func (r *UpdateResourceResponse) UpdateSucceeded(ctx context.Context, model interface{}) {
+	r.Diagnostics.Append(r.State.Set(ctx, model)...)
+}
+
+// FailWithAPIError adds the standard error diagnostic for a failed remote
+// API call. The operation should complete the sentence "Unable to ...", such
+// as "update widget".
+func (r *UpdateResourceResponse) FailWithAPIError(operation string, err error) {
+	r.Diagnostics.Append(apiErrorDiagnostic(operation, err))
+}
+
+// DeleteSucceeded removes the resource from State following a successful
+// Delete operation.
+func (r *DeleteResourceResponse) DeleteSucceeded(ctx context.Context) {
+	r.State.RemoveResource(ctx)
+}
+
+// FailWithAPIError adds the standard error diagnostic for a failed remote
+// API call. The operation should complete the sentence "Unable to ...", such
+// as "delete widget".
+func (r *DeleteResourceResponse) FailWithAPIError(operation string, err error) {
+	r.Diagnostics.Append(apiErrorDiagnostic(operation, err))
+}
+
+// ReadSucceeded sets the entire State to the given model, which should be
+// a struct with tfsdk tags or an attr.Value matching the schema, following a
+// successful Read operation.
+func (r *ReadDataSourceResponse) ReadSucceeded(ctx context.Context, model interface{}) {
+	r.Diagnostics.Append(r.State.Set(ctx, model)...)
+}
+
+// FailWithAPIError adds the standard error diagnostic for a failed remote
+// API call. The operation should complete the sentence "Unable to ...", such
+// as "read widget".
+func (r *ReadDataSourceResponse) FailWithAPIError(operation string, err error) {
+	r.Diagnostics.Append(apiErrorDiagnostic(operation, err))
+}
@@ -0,0 +1,81 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceResponseHelpers(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+	schemaType := schema.TerraformType(ctx)
+
+	type model struct {
+		Name string `tfsdk:"name"`
+	}
+
+	expectedState := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "test"),
+	})
+	expectedAPIErrorDiags := diag.Diagnostics{
+		diag.NewErrorDiagnostic(
+			"API Error",
+			"Unable to read widget, got error: not authorized",
+		),
+	}
+
+	createResp := CreateResourceResponse{
+		State: State{
+			Raw:    tftypes.NewValue(schemaType, nil),
+			Schema: schema,
+		},
+	}
+	createResp.CreateSucceeded(ctx, model{Name: "test"})
+
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected create diagnostics: %v", createResp.Diagnostics)
+	}
+
+	if diff := cmp.Diff(createResp.State.Raw, expectedState); diff != "" {
+		t.Errorf("unexpected create state difference: %s", diff)
+	}
+
+	readResp := ReadResourceResponse{
+		State: createResp.State,
+	}
+	readResp.NotFoundRemove(ctx)
+
+	if diff := cmp.Diff(readResp.State.Raw, tftypes.NewValue(schemaType, nil)); diff != "" {
+		t.Errorf("unexpected read state difference: %s", diff)
+	}
+
+	readResp.FailWithAPIError("read widget", errors.New("not authorized"))
+
+	if diff := cmp.Diff(readResp.Diagnostics, expectedAPIErrorDiags); diff != "" {
+		t.Errorf("unexpected read diagnostics difference: %s", diff)
+	}
+
+	deleteResp := DeleteResourceResponse{
+		State: createResp.State,
+	}
+	deleteResp.DeleteSucceeded(ctx)
+
+	if diff := cmp.Diff(deleteResp.State.Raw, tftypes.NewValue(schemaType, nil)); diff != "" {
+		t.Errorf("unexpected delete state difference: %s", diff)
+	}
+}
@@ -9,6 +9,11 @@ import (
 // Resource's ImportState method, in which the provider should set values on
 // the ImportResourceStateResponse as appropriate.
 type ImportResourceStateResponse struct {
+	// Private is the private state of the resource following the import
+	// operation, which is passed to the Read operation that refreshes the
+	// imported resource.
+	Private PrivateState
+
 	// Diagnostics report errors or warnings related to importing the
 	// resource. An empty slice indicates a successful operation with no
 	// warnings or errors generated.
@@ -0,0 +1,28 @@
+package tfsdk
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// UpgradeResourceStateResponse represents a response to an
+// UpgradeResourceStateRequest. An instance of this response struct is
+// supplied as an argument to the StateUpgrader function of the
+// ResourceStateUpgrader, in which the provider should set values on the
+// UpgradeResourceStateResponse as appropriate.
+type UpgradeResourceStateResponse struct {
+	// Diagnostics report errors or warnings related to upgrading the
+	// resource state. An empty slice indicates a successful operation with
+	// no warnings or errors generated.
+	Diagnostics diag.Diagnostics
+
+	// DynamicValue is the upgraded state data, encoded with the current
+	// schema. It is an alternative to State for implementations working with
+	// the raw state data, and takes precedence when set.
+	DynamicValue *tfprotov6.DynamicValue
+
+	// State is the upgraded state data, which must conform to the current
+	// schema. It is populated with the current schema and a null value
+	// before the StateUpgrader is called.
+	State State
+}
@@ -0,0 +1,328 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// RPCMiddleware wraps the handling of protocol RPCs, other than
+// StopProvider, which is never delayed. It allows inspecting or modifying
+// the protocol requests and responses, such as their DynamicValues, timing
+// requests, or adding values, such as HTTP headers for API clients, to the
+// request context.
+//
+// Unlike Interceptor, which wraps resource and data source methods,
+// middleware receives the tfprotov6 types, before the framework decodes the
+// request and after it encodes the response.
+type RPCMiddleware interface {
+	// HandleRPC is called in place of the RPC. It should call
+	// RPCRequest.Next, which calls the next middleware or the RPC itself,
+	// and set the RPCResponse.
+	HandleRPC(context.Context, RPCRequest, *RPCResponse)
+}
+
+// RPCRequest represents a request to handle a protocol RPC. An instance of
+// this request struct is supplied as an argument to the middleware's
+// HandleRPC function.
+type RPCRequest struct {
+	// Operation is the RPC being served.
+	Operation Operation
+
+	// TypeName is the resource or data source type of the RPC. It is
+	// empty for provider RPCs, such as ConfigureProvider. It is not
+	// updated when middleware replaces the request.
+	TypeName string
+
+	// Request is the protocol request of the RPC, such as
+	// *tfprotov6.PlanResourceChangeRequest.
+	Request interface{}
+
+	// Next calls the next middleware, or the RPC itself, with the given
+	// request and returns its protocol response, such as
+	// *tfprotov6.PlanResourceChangeResponse, and error. The request
+	// context, or a context derived from it, should be passed.
+	//
+	// The request should usually be Request. It may be replaced, such as
+	// with a modified copy, which is then seen by later middleware and the
+	// RPC, but it must have the same type.
+	Next func(ctx context.Context, request interface{}) (interface{}, error)
+}
+
+// RPCResponse represents a response to an RPCRequest. An instance of this
+// response struct is supplied as an argument to the middleware's HandleRPC
+// function.
+type RPCResponse struct {
+	// Response is returned as the protocol response of the RPC. It should
+	// usually be set to the response returned by RPCRequest.Next, and it
+	// must have the same type.
+	Response interface{}
+
+	// Error is returned as the error of the RPC, which Terraform reports
+	// without any diagnostics. It should usually be set to the error
+	// returned by RPCRequest.Next.
+	Error error
+}
+
+var _ tfprotov6.ProviderServer = middlewareServer{}
+
+// middlewareServer calls the RPCs of the server through the middleware.
+type middlewareServer struct {
+	server     tfprotov6.ProviderServer
+	middleware []RPCMiddleware
+}
+
+// withRPCMiddleware returns the server with the middleware, outermost
+// first, or the server itself when there is no middleware.
+func withRPCMiddleware(server tfprotov6.ProviderServer, middleware []RPCMiddleware) tfprotov6.ProviderServer {
+	if len(middleware) == 0 {
+		return server
+	}
+
+	return middlewareServer{
+		server:     server,
+		middleware: middleware,
+	}
+}
+
+// handle calls the RPC through the middleware and returns the resulting
+// protocol response and error. The call receives the request passed by the
+// innermost middleware.
+func (s middlewareServer) handle(ctx context.Context, op Operation, typeName string, request interface{}, call func(context.Context, interface{}) (interface{}, error)) (interface{}, error) {
+	next := call
+
+	for i := len(s.middleware) - 1; i >= 0; i-- {
+		middleware, inner := s.middleware[i], next
+
+		next = func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := RPCRequest{
+				Operation: op,
+				TypeName:  typeName,
+				Request:   request,
+				Next:      inner,
+			}
+			resp := &RPCResponse{}
+
+			middleware.HandleRPC(ctx, req, resp)
+
+			return resp.Response, resp.Error
+		}
+	}
+
+	return next(ctx, request)
+}
+
+// unexpectedRPCRequestError returns the error for a middleware request of
+// the wrong type.
+func unexpectedRPCRequestError(op Operation, req interface{}) error {
+	return fmt.Errorf("RPC middleware passed unexpected %s request type: %T", op, req)
+}
+
+// unexpectedRPCResponseError returns the error for a middleware response of
+// the wrong type.
+func unexpectedRPCResponseError(op Operation, resp interface{}) error {
+	return fmt.Errorf("RPC middleware returned unexpected %s response type: %T", op, resp)
+}
+
+func (s middlewareServer) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp, err := s.handle(ctx, OperationGetProviderSchema, "", req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.GetProviderSchemaRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationGetProviderSchema, request)
+		}
+
+		return s.server.GetProviderSchema(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.GetProviderSchemaResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationGetProviderSchema, resp)
+}
+
+func (s middlewareServer) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	resp, err := s.handle(ctx, OperationValidateProviderConfig, "", req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ValidateProviderConfigRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationValidateProviderConfig, request)
+		}
+
+		return s.server.ValidateProviderConfig(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ValidateProviderConfigResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationValidateProviderConfig, resp)
+}
+
+func (s middlewareServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	resp, err := s.handle(ctx, OperationConfigureProvider, "", req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ConfigureProviderRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationConfigureProvider, request)
+		}
+
+		return s.server.ConfigureProvider(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ConfigureProviderResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationConfigureProvider, resp)
+}
+
+func (s middlewareServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	return s.server.StopProvider(ctx, req)
+}
+
+func (s middlewareServer) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
+	resp, err := s.handle(ctx, OperationValidateResourceConfig, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ValidateResourceConfigRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationValidateResourceConfig, request)
+		}
+
+		return s.server.ValidateResourceConfig(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ValidateResourceConfigResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationValidateResourceConfig, resp)
+}
+
+func (s middlewareServer) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
+	resp, err := s.handle(ctx, OperationUpgradeResourceState, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.UpgradeResourceStateRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationUpgradeResourceState, request)
+		}
+
+		return s.server.UpgradeResourceState(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.UpgradeResourceStateResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationUpgradeResourceState, resp)
+}
+
+func (s middlewareServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	resp, err := s.handle(ctx, OperationReadResource, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ReadResourceRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationReadResource, request)
+		}
+
+		return s.server.ReadResource(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ReadResourceResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationReadResource, resp)
+}
+
+func (s middlewareServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.handle(ctx, OperationPlanResourceChange, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.PlanResourceChangeRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationPlanResourceChange, request)
+		}
+
+		return s.server.PlanResourceChange(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.PlanResourceChangeResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationPlanResourceChange, resp)
+}
+
+func (s middlewareServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	resp, err := s.handle(ctx, OperationApplyResourceChange, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ApplyResourceChangeRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationApplyResourceChange, request)
+		}
+
+		return s.server.ApplyResourceChange(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ApplyResourceChangeResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationApplyResourceChange, resp)
+}
+
+func (s middlewareServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	resp, err := s.handle(ctx, OperationImportResourceState, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ImportResourceStateRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationImportResourceState, request)
+		}
+
+		return s.server.ImportResourceState(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ImportResourceStateResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationImportResourceState, resp)
+}
+
+func (s middlewareServer) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
+	resp, err := s.handle(ctx, OperationValidateDataResourceConfig, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ValidateDataResourceConfigRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationValidateDataResourceConfig, request)
+		}
+
+		return s.server.ValidateDataResourceConfig(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ValidateDataResourceConfigResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationValidateDataResourceConfig, resp)
+}
+
+func (s middlewareServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	resp, err := s.handle(ctx, OperationReadDataSource, req.TypeName, req, func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(*tfprotov6.ReadDataSourceRequest)
+
+		if !ok {
+			return nil, unexpectedRPCRequestError(OperationReadDataSource, request)
+		}
+
+		return s.server.ReadDataSource(ctx, req)
+	})
+
+	if r, ok := resp.(*tfprotov6.ReadDataSourceResponse); ok || resp == nil {
+		return r, err
+	}
+
+	return nil, unexpectedRPCResponseError(OperationReadDataSource, resp)
+}
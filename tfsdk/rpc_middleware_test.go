@@ -0,0 +1,222 @@
+package tfsdk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testRPCMiddleware records each call and optionally replaces the request
+// and modifies the response.
+type testRPCMiddleware struct {
+	name           string
+	calls          *[]string
+	replaceRequest func(interface{}) interface{}
+	modify         func(*RPCResponse)
+}
+
+func (m testRPCMiddleware) HandleRPC(ctx context.Context, req RPCRequest, resp *RPCResponse) {
+	*m.calls = append(*m.calls, m.name+" "+string(req.Operation)+" "+req.TypeName)
+
+	request := req.Request
+
+	if m.replaceRequest != nil {
+		request = m.replaceRequest(request)
+	}
+
+	resp.Response, resp.Error = req.Next(ctx, request)
+
+	if m.modify != nil {
+		m.modify(resp)
+	}
+}
+
+func TestRPCMiddleware(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		middleware    func(calls *[]string) []RPCMiddleware
+		expectedCalls []string
+		expectedDiags []*tfprotov6.Diagnostic
+		expectedError string
+	}{
+		"none": {
+			middleware: func(_ *[]string) []RPCMiddleware {
+				return nil
+			},
+		},
+		"order": {
+			middleware: func(calls *[]string) []RPCMiddleware {
+				return []RPCMiddleware{
+					testRPCMiddleware{name: "first", calls: calls},
+					testRPCMiddleware{name: "second", calls: calls},
+				}
+			},
+			expectedCalls: []string{
+				"first ValidateResourceConfig test_one",
+				"second ValidateResourceConfig test_one",
+			},
+		},
+		"modify-response": {
+			middleware: func(calls *[]string) []RPCMiddleware {
+				return []RPCMiddleware{
+					testRPCMiddleware{name: "first", calls: calls, modify: func(resp *RPCResponse) {
+						r := resp.Response.(*tfprotov6.ValidateResourceConfigResponse)
+						r.Diagnostics = append(r.Diagnostics, &tfprotov6.Diagnostic{
+							Severity: tfprotov6.DiagnosticSeverityWarning,
+							Summary:  "Middleware Warning",
+						})
+					}},
+				}
+			},
+			expectedCalls: []string{
+				"first ValidateResourceConfig test_one",
+			},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityWarning,
+					Summary:  "Middleware Warning",
+				},
+			},
+		},
+		"replace-request": {
+			middleware: func(calls *[]string) []RPCMiddleware {
+				return []RPCMiddleware{
+					testRPCMiddleware{name: "first", calls: calls, replaceRequest: func(request interface{}) interface{} {
+						r := *request.(*tfprotov6.ValidateResourceConfigRequest)
+						r.TypeName = "test_missing"
+
+						return &r
+					}},
+					testRPCMiddleware{name: "second", calls: calls},
+				}
+			},
+			expectedCalls: []string{
+				"first ValidateResourceConfig test_one",
+				"second ValidateResourceConfig test_one",
+			},
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity: tfprotov6.DiagnosticSeverityError,
+					Summary:  "Resource not found",
+					Detail:   "No resource named \"test_missing\" is configured on the provider",
+				},
+			},
+		},
+		"unexpected-request-type": {
+			middleware: func(calls *[]string) []RPCMiddleware {
+				return []RPCMiddleware{
+					testRPCMiddleware{name: "first", calls: calls, replaceRequest: func(_ interface{}) interface{} {
+						return &tfprotov6.ReadResourceRequest{}
+					}},
+				}
+			},
+			expectedCalls: []string{
+				"first ValidateResourceConfig test_one",
+			},
+			expectedError: "RPC middleware passed unexpected ValidateResourceConfig request type: *tfprotov6.ReadResourceRequest",
+		},
+		"error": {
+			middleware: func(calls *[]string) []RPCMiddleware {
+				return []RPCMiddleware{
+					testRPCMiddleware{name: "first", calls: calls, modify: func(resp *RPCResponse) {
+						resp.Response = nil
+						resp.Error = errors.New("test error")
+					}},
+				}
+			},
+			expectedCalls: []string{
+				"first ValidateResourceConfig test_one",
+			},
+			expectedError: "test error",
+		},
+		"unexpected-response-type": {
+			middleware: func(calls *[]string) []RPCMiddleware {
+				return []RPCMiddleware{
+					testRPCMiddleware{name: "first", calls: calls, modify: func(resp *RPCResponse) {
+						resp.Response = &tfprotov6.ReadResourceResponse{}
+					}},
+				}
+			},
+			expectedCalls: []string{
+				"first ValidateResourceConfig test_one",
+			},
+			expectedError: "RPC middleware returned unexpected ValidateResourceConfig response type: *tfprotov6.ReadResourceResponse",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls []string
+
+			testServer := NewProtocol6ServerWithOptions(&testServeProvider{}, ServeOpts{
+				RPCMiddleware: tc.middleware(&calls),
+			})
+
+			config, err := tfprotov6.NewDynamicValue(testServeResourceTypeOneType, tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"name":              tftypes.NewValue(tftypes.String, "hello, world"),
+			}))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := testServer.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+				Config:   &config,
+				TypeName: "test_one",
+			})
+
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), tc.expectedError); diff != "" {
+					t.Errorf("unexpected error difference: %s", diff)
+				}
+			} else {
+				if tc.expectedError != "" {
+					t.Fatalf("expected error: %s", tc.expectedError)
+				}
+
+				if diff := cmp.Diff(got.Diagnostics, tc.expectedDiags); diff != "" {
+					t.Errorf("unexpected diagnostics difference: %s", diff)
+				}
+			}
+
+			if diff := cmp.Diff(calls, tc.expectedCalls); diff != "" {
+				t.Errorf("unexpected calls difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRPCMiddlewareStopProvider(t *testing.T) {
+	t.Parallel()
+
+	var calls []string
+
+	testServer := NewProtocol6ServerWithOptions(&testServeProvider{}, ServeOpts{
+		RPCMiddleware: []RPCMiddleware{
+			testRPCMiddleware{name: "first", calls: &calls},
+		},
+	})
+
+	if _, err := testServer.StopProvider(context.Background(), &tfprotov6.StopProviderRequest{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(calls) != 0 {
+		t.Errorf("expected no middleware calls, got: %v", calls)
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -109,7 +111,13 @@ func (s Schema) AttributeType() attr.Type {
 }
 
 // AttributeTypeAtPath returns the attr.Type of the attribute at the given path.
-func (s Schema) AttributeTypeAtPath(path *tftypes.AttributePath) (attr.Type, error) {
+func (s Schema) AttributeTypeAtPath(path path.Path) (attr.Type, error) {
+	return s.attributeTypeAtTerraformPath(totftypes.AttributePath(path))
+}
+
+// attributeTypeAtTerraformPath returns the attr.Type of the attribute at the
+// given terraform-plugin-go path.
+func (s Schema) attributeTypeAtTerraformPath(path *tftypes.AttributePath) (attr.Type, error) {
 	rawType, remaining, err := tftypes.WalkAttributePath(s, path)
 	if err != nil {
 		return nil, fmt.Errorf("%v still remains in the path: %w", remaining, err)
@@ -148,7 +156,14 @@ func (s Schema) TerraformType(ctx context.Context) tftypes.Type {
 // AttributeAtPath returns the Attribute at the passed path. If the path points
 // to an element or attribute of a complex type, rather than to an Attribute,
 // it will return an ErrPathInsideAtomicAttribute error.
-func (s Schema) AttributeAtPath(path *tftypes.AttributePath) (Attribute, error) {
+func (s Schema) AttributeAtPath(path path.Path) (Attribute, error) {
+	return s.attributeAtTerraformPath(totftypes.AttributePath(path))
+}
+
+// attributeAtTerraformPath returns the Attribute at the passed
+// terraform-plugin-go path, such as those used in tftypes.Transform
+// callbacks.
+func (s Schema) attributeAtTerraformPath(path *tftypes.AttributePath) (Attribute, error) {
 	res, remaining, err := tftypes.WalkAttributePath(s, path)
 	if err != nil {
 		return Attribute{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
@@ -173,8 +188,8 @@ func (s Schema) AttributeAtPath(path *tftypes.AttributePath) (Attribute, error)
 // blockAtPath returns the Block at the passed path. If the path points
 // to an element or attribute of a complex type, rather than to a Block,
 // it will return an ErrPathInsideAtomicAttribute error.
-func (s Schema) blockAtPath(path *tftypes.AttributePath) (Block, error) {
-	res, remaining, err := tftypes.WalkAttributePath(s, path)
+func (s Schema) blockAtPath(path path.Path) (Block, error) {
+	res, remaining, err := tftypes.WalkAttributePath(s, totftypes.AttributePath(path))
 	if err != nil {
 		return Block{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
@@ -268,7 +283,7 @@ func (s Schema) validate(ctx context.Context, req ValidateSchemaRequest, resp *V
 	for name, attribute := range s.Attributes {
 
 		attributeReq := ValidateAttributeRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
 		}
 		attributeResp := &ValidateAttributeResponse{
@@ -282,7 +297,7 @@ func (s Schema) validate(ctx context.Context, req ValidateSchemaRequest, resp *V
 
 	for name, block := range s.Blocks {
 		attributeReq := ValidateAttributeRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
 		}
 		attributeResp := &ValidateAttributeResponse{
@@ -306,11 +321,12 @@ func (s Schema) validate(ctx context.Context, req ValidateSchemaRequest, resp *V
 func (s Schema) modifyPlan(ctx context.Context, req ModifySchemaPlanRequest, resp *ModifySchemaPlanResponse) {
 	for name, attr := range s.Attributes {
 		attrReq := ModifyAttributePlanRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
 			Plan:          req.Plan,
 			ProviderMeta:  req.ProviderMeta,
+			ProviderData:  req.ProviderData,
 		}
 
 		attr.modifyPlan(ctx, attrReq, resp)
@@ -318,11 +334,12 @@ func (s Schema) modifyPlan(ctx context.Context, req ModifySchemaPlanRequest, res
 
 	for name, block := range s.Blocks {
 		blockReq := ModifyAttributePlanRequest{
-			AttributePath: tftypes.NewAttributePath().WithAttributeName(name),
+			AttributePath: path.Root(name),
 			Config:        req.Config,
 			State:         req.State,
 			Plan:          req.Plan,
 			ProviderMeta:  req.ProviderMeta,
+			ProviderData:  req.ProviderData,
 		}
 
 		block.modifyPlan(ctx, blockReq, resp)
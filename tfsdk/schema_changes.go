@@ -0,0 +1,310 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// SchemaChangeKind is an enum type of the ways an attribute or block can
+// differ between two versions of a schema.
+type SchemaChangeKind uint8
+
+const (
+	// SchemaChangeKindUnknown is an invalid change kind, used to catch when
+	// a change kind is expected and not set.
+	SchemaChangeKindUnknown SchemaChangeKind = 0
+
+	// SchemaChangeKindAdded is for attributes and blocks which only exist
+	// in the current schema.
+	SchemaChangeKindAdded SchemaChangeKind = 1
+
+	// SchemaChangeKindRemoved is for attributes and blocks which only exist
+	// in the prior schema.
+	SchemaChangeKindRemoved SchemaChangeKind = 2
+
+	// SchemaChangeKindTypeChanged is for attributes whose type or nesting
+	// mode changed, and blocks whose nesting mode changed.
+	SchemaChangeKindTypeChanged SchemaChangeKind = 3
+
+	// SchemaChangeKindBehaviorChanged is for attributes whose Required,
+	// Optional, or Computed definition changed.
+	SchemaChangeKindBehaviorChanged SchemaChangeKind = 4
+
+	// SchemaChangeKindItemsChanged is for blocks whose MinItems or MaxItems
+	// changed.
+	SchemaChangeKindItemsChanged SchemaChangeKind = 5
+
+	// SchemaChangeKindVersionChanged is for a changed schema Version.
+	SchemaChangeKindVersionChanged SchemaChangeKind = 6
+)
+
+// String returns a human readable name for the change kind.
+func (k SchemaChangeKind) String() string {
+	switch k {
+	case SchemaChangeKindAdded:
+		return "added"
+	case SchemaChangeKindRemoved:
+		return "removed"
+	case SchemaChangeKindTypeChanged:
+		return "type-changed"
+	case SchemaChangeKindBehaviorChanged:
+		return "behavior-changed"
+	case SchemaChangeKindItemsChanged:
+		return "items-changed"
+	case SchemaChangeKindVersionChanged:
+		return "version-changed"
+	default:
+		return "unknown"
+	}
+}
+
+// SchemaChange describes a single difference between two versions of a
+// schema.
+type SchemaChange struct {
+	// Path is the dot separated path of the attribute or block, as it
+	// would be written in configuration. It is empty for changes of the
+	// schema itself, such as its Version.
+	Path string
+
+	// Kind is the kind of change.
+	Kind SchemaChangeKind
+
+	// Breaking is true if the change can break existing configurations or
+	// state, such as a removed attribute or a new required attribute.
+	Breaking bool
+
+	// Description is a human readable description of the change.
+	Description string
+}
+
+// SchemaChanges returns the differences between the prior and current
+// versions of a schema, in lexical order of their path. Descriptions, plan
+// modifiers, validators, and other fields without an effect on
+// compatibility are not compared.
+//
+// This is intended for provider tests, which can fail on breaking changes
+// of a schema saved from a prior release, such as:
+//
+//	for _, change := range tfsdk.SchemaChanges(ctx, priorSchema, currentSchema) {
+//	    if change.Breaking {
+//	        t.Errorf("%s: %s", change.Path, change.Description)
+//	    }
+//	}
+func SchemaChanges(ctx context.Context, prior, current Schema) []SchemaChange {
+	var changes []SchemaChange
+
+	switch {
+	case current.Version < prior.Version:
+		changes = append(changes, SchemaChange{
+			Kind:        SchemaChangeKindVersionChanged,
+			Breaking:    true,
+			Description: fmt.Sprintf("Schema version decreased from %d to %d.", prior.Version, current.Version),
+		})
+	case current.Version > prior.Version:
+		changes = append(changes, SchemaChange{
+			Kind:        SchemaChangeKindVersionChanged,
+			Description: fmt.Sprintf("Schema version increased from %d to %d.", prior.Version, current.Version),
+		})
+	}
+
+	changes = append(changes, schemaAttributesChanges(ctx, nil, prior.Attributes, current.Attributes)...)
+	changes = append(changes, schemaBlocksChanges(ctx, nil, prior.Blocks, current.Blocks)...)
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes
+}
+
+// schemaAttributesChanges returns the differences between the attributes at
+// one level of the schema, recursing into nested attributes.
+func schemaAttributesChanges(ctx context.Context, parents []string, prior, current map[string]Attribute) []SchemaChange {
+	var changes []SchemaChange
+
+	for _, name := range sortedAttributeNames(prior) {
+		if _, ok := current[name]; !ok {
+			changes = append(changes, SchemaChange{
+				Path:        schemaDefinitionPath(parents, name),
+				Kind:        SchemaChangeKindRemoved,
+				Breaking:    true,
+				Description: "Attribute was removed.",
+			})
+		}
+	}
+
+	for _, name := range sortedAttributeNames(current) {
+		path := schemaDefinitionPath(parents, name)
+		currentAttribute := current[name]
+		priorAttribute, ok := prior[name]
+
+		if !ok {
+			changes = append(changes, SchemaChange{
+				Path:        path,
+				Kind:        SchemaChangeKindAdded,
+				Breaking:    currentAttribute.Required,
+				Description: fmt.Sprintf("Attribute was added as %s.", attributeBehavior(currentAttribute)),
+			})
+
+			continue
+		}
+
+		if priorBehavior, currentBehavior := attributeBehavior(priorAttribute), attributeBehavior(currentAttribute); priorBehavior != currentBehavior {
+			priorConfigurable := priorAttribute.Required || priorAttribute.Optional
+			currentConfigurable := currentAttribute.Required || currentAttribute.Optional
+
+			changes = append(changes, SchemaChange{
+				Path:        path,
+				Kind:        SchemaChangeKindBehaviorChanged,
+				Breaking:    (currentAttribute.Required && !priorAttribute.Required) || (priorConfigurable && !currentConfigurable),
+				Description: fmt.Sprintf("Attribute changed from %s to %s.", priorBehavior, currentBehavior),
+			})
+		}
+
+		switch {
+		case priorAttribute.definesAttributes() && currentAttribute.definesAttributes():
+			priorNestingMode := priorAttribute.Attributes.GetNestingMode()
+			currentNestingMode := currentAttribute.Attributes.GetNestingMode()
+
+			if priorNestingMode != currentNestingMode {
+				changes = append(changes, SchemaChange{
+					Path:        path,
+					Kind:        SchemaChangeKindTypeChanged,
+					Breaking:    true,
+					Description: fmt.Sprintf("Attribute nesting mode changed from %s to %s.", nestingModeName(priorNestingMode), nestingModeName(currentNestingMode)),
+				})
+
+				continue
+			}
+
+			changes = append(changes, schemaAttributesChanges(ctx, append(parents, name), priorAttribute.Attributes.GetAttributes(), currentAttribute.Attributes.GetAttributes())...)
+		case priorAttribute.definesAttributes() || currentAttribute.definesAttributes():
+			changes = append(changes, SchemaChange{
+				Path:        path,
+				Kind:        SchemaChangeKindTypeChanged,
+				Breaking:    true,
+				Description: fmt.Sprintf("Attribute type changed from %s to %s.", priorAttribute.terraformType(ctx), currentAttribute.terraformType(ctx)),
+			})
+		case priorAttribute.Type != nil && currentAttribute.Type != nil:
+			priorType := priorAttribute.Type.TerraformType(ctx)
+			currentType := currentAttribute.Type.TerraformType(ctx)
+
+			if !priorType.Equal(currentType) {
+				changes = append(changes, SchemaChange{
+					Path:        path,
+					Kind:        SchemaChangeKindTypeChanged,
+					Breaking:    true,
+					Description: fmt.Sprintf("Attribute type changed from %s to %s.", priorType, currentType),
+				})
+			}
+		}
+	}
+
+	return changes
+}
+
+// schemaBlocksChanges returns the differences between the blocks at one
+// level of the schema, recursing into their attributes and blocks.
+func schemaBlocksChanges(ctx context.Context, parents []string, prior, current map[string]Block) []SchemaChange {
+	var changes []SchemaChange
+
+	for _, name := range sortedBlockNames(prior) {
+		if _, ok := current[name]; !ok {
+			changes = append(changes, SchemaChange{
+				Path:        schemaDefinitionPath(parents, name),
+				Kind:        SchemaChangeKindRemoved,
+				Breaking:    true,
+				Description: "Block was removed.",
+			})
+		}
+	}
+
+	for _, name := range sortedBlockNames(current) {
+		path := schemaDefinitionPath(parents, name)
+		currentBlock := current[name]
+		priorBlock, ok := prior[name]
+
+		if !ok {
+			changes = append(changes, SchemaChange{
+				Path:        path,
+				Kind:        SchemaChangeKindAdded,
+				Breaking:    currentBlock.MinItems > 0,
+				Description: fmt.Sprintf("Block was added with MinItems %d.", currentBlock.MinItems),
+			})
+
+			continue
+		}
+
+		if priorBlock.NestingMode != currentBlock.NestingMode {
+			changes = append(changes, SchemaChange{
+				Path:        path,
+				Kind:        SchemaChangeKindTypeChanged,
+				Breaking:    true,
+				Description: fmt.Sprintf("Block nesting mode changed from %s to %s.", blockNestingModeName(priorBlock.NestingMode), blockNestingModeName(currentBlock.NestingMode)),
+			})
+
+			continue
+		}
+
+		if priorBlock.MinItems != currentBlock.MinItems || priorBlock.MaxItems != currentBlock.MaxItems {
+			// a MaxItems of 0 means there is no maximum
+			maxItemsDecreased := currentBlock.MaxItems > 0 && (priorBlock.MaxItems == 0 || currentBlock.MaxItems < priorBlock.MaxItems)
+
+			changes = append(changes, SchemaChange{
+				Path:        path,
+				Kind:        SchemaChangeKindItemsChanged,
+				Breaking:    currentBlock.MinItems > priorBlock.MinItems || maxItemsDecreased,
+				Description: fmt.Sprintf("Block items changed from MinItems %d and MaxItems %d to MinItems %d and MaxItems %d.", priorBlock.MinItems, priorBlock.MaxItems, currentBlock.MinItems, currentBlock.MaxItems),
+			})
+		}
+
+		changes = append(changes, schemaAttributesChanges(ctx, append(parents, name), priorBlock.Attributes, currentBlock.Attributes)...)
+		changes = append(changes, schemaBlocksChanges(ctx, append(parents, name), priorBlock.Blocks, currentBlock.Blocks)...)
+	}
+
+	return changes
+}
+
+// attributeBehavior returns a human readable description of the Required,
+// Optional, and Computed definition of the attribute.
+func attributeBehavior(a Attribute) string {
+	switch {
+	case a.Required:
+		return "required"
+	case a.Optional && a.Computed:
+		return "optional and computed"
+	case a.Optional:
+		return "optional"
+	case a.Computed:
+		return "computed"
+	default:
+		return "undefined"
+	}
+}
+
+func nestingModeName(mode NestingMode) string {
+	switch mode {
+	case NestingModeSingle:
+		return "single"
+	case NestingModeList:
+		return "list"
+	case NestingModeSet:
+		return "set"
+	case NestingModeMap:
+		return "map"
+	default:
+		return "unknown"
+	}
+}
+
+func blockNestingModeName(mode BlockNestingMode) string {
+	switch mode {
+	case BlockNestingModeList:
+		return "list"
+	case BlockNestingModeSet:
+		return "set"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,391 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		prior    Schema
+		current  Schema
+		expected []SchemaChange
+	}{
+		"no-changes": {
+			prior: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "prior",
+					},
+				},
+			},
+			current: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:        types.StringType,
+						Computed:    true,
+						Description: "current",
+					},
+				},
+			},
+		},
+		"version": {
+			prior:   Schema{Version: 2},
+			current: Schema{Version: 1},
+			expected: []SchemaChange{
+				{
+					Kind:        SchemaChangeKindVersionChanged,
+					Breaking:    true,
+					Description: "Schema version decreased from 2 to 1.",
+				},
+			},
+		},
+		"attributes-added-removed": {
+			prior: Schema{
+				Attributes: map[string]Attribute{
+					"removed": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: Schema{
+				Attributes: map[string]Attribute{
+					"added_optional": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+					"added_required": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			expected: []SchemaChange{
+				{
+					Path:        "added_optional",
+					Kind:        SchemaChangeKindAdded,
+					Description: "Attribute was added as optional.",
+				},
+				{
+					Path:        "added_required",
+					Kind:        SchemaChangeKindAdded,
+					Breaking:    true,
+					Description: "Attribute was added as required.",
+				},
+				{
+					Path:        "removed",
+					Kind:        SchemaChangeKindRemoved,
+					Breaking:    true,
+					Description: "Attribute was removed.",
+				},
+			},
+		},
+		"attribute-behavior": {
+			prior: Schema{
+				Attributes: map[string]Attribute{
+					"now_computed": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+					"now_optional": {
+						Type:     types.StringType,
+						Required: true,
+					},
+					"now_required": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: Schema{
+				Attributes: map[string]Attribute{
+					"now_computed": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"now_optional": {
+						Type:     types.StringType,
+						Optional: true,
+						Computed: true,
+					},
+					"now_required": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			expected: []SchemaChange{
+				{
+					Path:        "now_computed",
+					Kind:        SchemaChangeKindBehaviorChanged,
+					Breaking:    true,
+					Description: "Attribute changed from optional to computed.",
+				},
+				{
+					Path:        "now_optional",
+					Kind:        SchemaChangeKindBehaviorChanged,
+					Description: "Attribute changed from required to optional and computed.",
+				},
+				{
+					Path:        "now_required",
+					Kind:        SchemaChangeKindBehaviorChanged,
+					Breaking:    true,
+					Description: "Attribute changed from optional to required.",
+				},
+			},
+		},
+		"attribute-type": {
+			prior: Schema{
+				Attributes: map[string]Attribute{
+					"list": {
+						Type:     types.ListType{ElemType: types.StringType},
+						Optional: true,
+					},
+					"nested": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			current: Schema{
+				Attributes: map[string]Attribute{
+					"list": {
+						Type:     types.ListType{ElemType: types.Int64Type},
+						Optional: true,
+					},
+					"nested": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"value": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			expected: []SchemaChange{
+				{
+					Path:        "list",
+					Kind:        SchemaChangeKindTypeChanged,
+					Breaking:    true,
+					Description: "Attribute type changed from tftypes.List[tftypes.String] to tftypes.List[tftypes.Number].",
+				},
+				{
+					Path:        "nested",
+					Kind:        SchemaChangeKindTypeChanged,
+					Breaking:    true,
+					Description: `Attribute type changed from tftypes.String to tftypes.Object["value":tftypes.String].`,
+				},
+			},
+		},
+		"nested-attributes": {
+			prior: Schema{
+				Attributes: map[string]Attribute{
+					"list": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+					"mode": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+			},
+			current: Schema{
+				Attributes: map[string]Attribute{
+					"list": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+					"mode": {
+						Attributes: SetNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}, SetNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+			},
+			expected: []SchemaChange{
+				{
+					Path:        "list.name",
+					Kind:        SchemaChangeKindBehaviorChanged,
+					Breaking:    true,
+					Description: "Attribute changed from optional to required.",
+				},
+				{
+					Path:        "mode",
+					Kind:        SchemaChangeKindTypeChanged,
+					Breaking:    true,
+					Description: "Attribute nesting mode changed from list to set.",
+				},
+			},
+		},
+		"blocks": {
+			prior: Schema{
+				Blocks: map[string]Block{
+					"items": {
+						MaxItems:    2,
+						NestingMode: BlockNestingModeList,
+					},
+					"mode": {
+						NestingMode: BlockNestingModeList,
+					},
+					"nested": {
+						Attributes: map[string]Attribute{
+							"removed": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+					"removed": {
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			current: Schema{
+				Blocks: map[string]Block{
+					"added": {
+						MinItems:    1,
+						NestingMode: BlockNestingModeList,
+					},
+					"items": {
+						MaxItems:    1,
+						NestingMode: BlockNestingModeList,
+					},
+					"mode": {
+						NestingMode: BlockNestingModeSet,
+					},
+					"nested": {
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: []SchemaChange{
+				{
+					Path:        "added",
+					Kind:        SchemaChangeKindAdded,
+					Breaking:    true,
+					Description: "Block was added with MinItems 1.",
+				},
+				{
+					Path:        "items",
+					Kind:        SchemaChangeKindItemsChanged,
+					Breaking:    true,
+					Description: "Block items changed from MinItems 0 and MaxItems 2 to MinItems 0 and MaxItems 1.",
+				},
+				{
+					Path:        "mode",
+					Kind:        SchemaChangeKindTypeChanged,
+					Breaking:    true,
+					Description: "Block nesting mode changed from list to set.",
+				},
+				{
+					Path:        "nested.removed",
+					Kind:        SchemaChangeKindRemoved,
+					Breaking:    true,
+					Description: "Attribute was removed.",
+				},
+				{
+					Path:        "removed",
+					Kind:        SchemaChangeKindRemoved,
+					Breaking:    true,
+					Description: "Block was removed.",
+				},
+			},
+		},
+		"block-items-relaxed": {
+			prior: Schema{
+				Blocks: map[string]Block{
+					"items": {
+						MinItems:    1,
+						MaxItems:    2,
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			current: Schema{
+				Blocks: map[string]Block{
+					"items": {
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: []SchemaChange{
+				{
+					Path:        "items",
+					Kind:        SchemaChangeKindItemsChanged,
+					Description: "Block items changed from MinItems 1 and MaxItems 2 to MinItems 0 and MaxItems 0.",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := SchemaChanges(context.Background(), tc.prior, tc.current)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaChangeKindString(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[SchemaChangeKind]string{
+		SchemaChangeKindUnknown:         "unknown",
+		SchemaChangeKindAdded:           "added",
+		SchemaChangeKindRemoved:         "removed",
+		SchemaChangeKindTypeChanged:     "type-changed",
+		SchemaChangeKindBehaviorChanged: "behavior-changed",
+		SchemaChangeKindItemsChanged:    "items-changed",
+		SchemaChangeKindVersionChanged:  "version-changed",
+	}
+
+	for kind, expected := range testCases {
+		kind, expected := kind, expected
+
+		t.Run(expected, func(t *testing.T) {
+			t.Parallel()
+
+			if got := kind.String(); got != expected {
+				t.Errorf("expected %q, got %q", expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ SchemaData = Config{}
+	_ SchemaData = Plan{}
+	_ SchemaData = State{}
+)
+
+// SchemaData is the read access shared by Config, Plan, and State, so helper
+// functions, such as for validators or plan modifiers, can be written once
+// for any of them.
+type SchemaData interface {
+	// AttributeIsNull returns true if the value at the path is null.
+	AttributeIsNull(context.Context, path.Path) (bool, diag.Diagnostics)
+
+	// AttributeIsUnknown returns true if the value at the path is unknown.
+	AttributeIsUnknown(context.Context, path.Path) (bool, diag.Diagnostics)
+
+	// Get populates the target with the entire data.
+	Get(ctx context.Context, target interface{}) diag.Diagnostics
+
+	// GetAttribute populates the target with the value at the path.
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+
+	// PathMatches returns all paths in the data matching the expression.
+	PathMatches(context.Context, path.Expression) (path.Paths, diag.Diagnostics)
+}
@@ -0,0 +1,189 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	// reservedProviderAttributeNames are the top level names Terraform
+	// reserves in provider configuration blocks.
+	reservedProviderAttributeNames = []string{
+		"alias",
+		"version",
+	}
+
+	// reservedResourceAttributeNames are the top level names Terraform
+	// reserves in resource configuration blocks.
+	reservedResourceAttributeNames = []string{
+		"connection",
+		"count",
+		"depends_on",
+		"for_each",
+		"lifecycle",
+		"provider",
+		"provisioner",
+	}
+
+	// reservedDataSourceAttributeNames are the top level names Terraform
+	// reserves in data source configuration blocks.
+	reservedDataSourceAttributeNames = []string{
+		"count",
+		"depends_on",
+		"for_each",
+		"lifecycle",
+		"provider",
+	}
+)
+
+// validateDefinition checks the Schema for definitions Terraform or the
+// framework cannot handle, such as attributes which are not Required,
+// Optional, or Computed. The schemaName describes the schema in diagnostics,
+// e.g. `resource "example_thing"`, and reservedNames are the top level names
+// which cannot be used.
+func (s Schema) validateDefinition(ctx context.Context, schemaName string, reservedNames []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range reservedNames {
+		if _, ok := s.Attributes[name]; ok {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, name, "Name is reserved by Terraform and cannot be used."))
+		}
+
+		if _, ok := s.Blocks[name]; ok {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, name, "Name is reserved by Terraform and cannot be used."))
+		}
+	}
+
+	diags.Append(validateDefinitionAttributes(ctx, schemaName, nil, false, s.Attributes, s.Blocks)...)
+
+	return diags
+}
+
+// validateDefinitionAttributes checks the attributes and blocks defined at
+// one level of a schema, recursing into nested attributes and blocks. The
+// inCollection argument is true beneath list, map, or set nested attributes
+// and blocks.
+func validateDefinitionAttributes(ctx context.Context, schemaName string, parents []string, inCollection bool, attributes map[string]Attribute, blocks map[string]Block) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, name := range sortedAttributeNames(attributes) {
+		path := schemaDefinitionPath(parents, name)
+		attribute := attributes[name]
+
+		if _, ok := blocks[name]; ok {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Name cannot be used by both an attribute and a block."))
+		}
+
+		switch {
+		case !attribute.definesAttributes() && attribute.Type == nil:
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute must define either Attributes or Type."))
+		case attribute.definesAttributes() && attribute.Type != nil:
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute cannot define both Attributes and Type."))
+		case attribute.definesAttributes() && attribute.Attributes.GetNestingMode() == NestingModeUnknown:
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute Attributes must define a nesting mode."))
+		}
+
+		switch {
+		case !attribute.Required && !attribute.Optional && !attribute.Computed:
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute missing Required, Optional, or Computed definition."))
+		case attribute.Required && attribute.Optional:
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute cannot be both Required and Optional."))
+		case attribute.Required && attribute.Computed:
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute cannot be both Required and Computed."))
+		}
+
+		if attribute.PreserveState && !attribute.Computed {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute PreserveState requires Computed."))
+		}
+
+		if attribute.PreserveState && inCollection {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute PreserveState is not supported beneath list, map, or set nested attributes or blocks."))
+		}
+
+		if attribute.Codec != nil {
+			if attribute.Type == nil || !attribute.Type.TerraformType(ctx).Is(tftypes.String) {
+				diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute Codec requires a string Type."))
+			}
+
+			if !attribute.Computed || attribute.Required || attribute.Optional {
+				diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Attribute Codec requires Computed without Required or Optional."))
+			}
+		}
+
+		if attribute.definesAttributes() {
+			nestedInCollection := inCollection || attribute.Attributes.GetNestingMode() != NestingModeSingle
+
+			diags.Append(validateDefinitionAttributes(ctx, schemaName, append(parents, name), nestedInCollection, attribute.Attributes.GetAttributes(), nil)...)
+		}
+	}
+
+	for _, name := range sortedBlockNames(blocks) {
+		path := schemaDefinitionPath(parents, name)
+		block := blocks[name]
+
+		if block.NestingMode != BlockNestingModeList && block.NestingMode != BlockNestingModeSet {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Block must define a NestingMode of BlockNestingModeList or BlockNestingModeSet."))
+		}
+
+		if block.MinItems < 0 || block.MaxItems < 0 {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Block MinItems and MaxItems cannot be negative."))
+		}
+
+		if block.MaxItems > 0 && block.MinItems > block.MaxItems {
+			diags.Append(schemaDefinitionDiagnostic(schemaName, path, "Block MinItems cannot be greater than MaxItems."))
+		}
+
+		diags.Append(validateDefinitionAttributes(ctx, schemaName, append(parents, name), true, block.Attributes, block.Blocks)...)
+	}
+
+	return diags
+}
+
+// sortedAttributeNames returns the attribute names in lexical order, so
+// diagnostics are returned in a consistent order.
+func sortedAttributeNames(attributes map[string]Attribute) []string {
+	names := make([]string, 0, len(attributes))
+
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// sortedBlockNames returns the block names in lexical order, so
+// diagnostics are returned in a consistent order.
+func sortedBlockNames(blocks map[string]Block) []string {
+	names := make([]string, 0, len(blocks))
+
+	for name := range blocks {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// schemaDefinitionPath returns the dot separated path of an attribute or
+// block name, as it would be written in configuration.
+func schemaDefinitionPath(parents []string, name string) string {
+	path := make([]string, 0, len(parents)+1)
+	path = append(path, parents...)
+
+	return strings.Join(append(path, name), ".")
+}
+
+func schemaDefinitionDiagnostic(schemaName string, path string, problem string) diag.Diagnostic {
+	return diag.NewErrorDiagnostic(
+		"Invalid Schema Definition",
+		fmt.Sprintf("The %s schema defines an invalid %q: %s This is always a problem with the provider and should be reported to the provider developer.", schemaName, path, problem),
+	)
+}
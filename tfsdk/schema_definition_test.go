@@ -0,0 +1,432 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaValidateDefinition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema        Schema
+		reservedNames []string
+		expected      diag.Diagnostics
+	}{
+		"valid": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"nested": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"block": {
+						Attributes: map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+						MaxItems:    2,
+						MinItems:    1,
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			reservedNames: reservedResourceAttributeNames,
+		},
+		"reserved-names": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"count": {
+						Type:     types.Int64Type,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"lifecycle": {
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			reservedNames: reservedResourceAttributeNames,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "count": Name is reserved by Terraform and cannot be used. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "lifecycle": Name is reserved by Terraform and cannot be used. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"reserved-names-nested": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"nested": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"count": {
+								Type:     types.Int64Type,
+								Optional: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+			reservedNames: reservedResourceAttributeNames,
+		},
+		"attribute-and-block-name": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"test": {
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Name cannot be used by both an attribute and a block. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-missing-type": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute must define either Attributes or Type. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-attributes-and-type": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						}),
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute cannot define both Attributes and Type. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-missing-required-optional-computed": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type: types.StringType,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute missing Required, Optional, or Computed definition. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-required-optional": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:     types.StringType,
+						Required: true,
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute cannot be both Required and Optional. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-required-computed": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:     types.StringType,
+						Required: true,
+						Computed: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute cannot be both Required and Computed. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-preserve-state-not-computed": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:          types.StringType,
+						Optional:      true,
+						PreserveState: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute PreserveState requires Computed. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-preserve-state-single-nested": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"token": {
+								Type:          types.StringType,
+								Computed:      true,
+								PreserveState: true,
+							},
+						}),
+						Optional: true,
+					},
+				},
+			},
+		},
+		"attribute-preserve-state-list-nested": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"nested": {
+								Attributes: SingleNestedAttributes(map[string]Attribute{
+									"token": {
+										Type:          types.StringType,
+										Computed:      true,
+										PreserveState: true,
+									},
+								}),
+								Optional: true,
+							},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test.nested.token": Attribute PreserveState is not supported beneath list, map, or set nested attributes or blocks. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-preserve-state-block": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"test": {
+						Attributes: map[string]Attribute{
+							"token": {
+								Type:          types.StringType,
+								Computed:      true,
+								PreserveState: true,
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test.token": Attribute PreserveState is not supported beneath list, map, or set nested attributes or blocks. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-codec-not-string": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:     types.Int64Type,
+						Computed: true,
+						Codec:    GzipBase64Codec(),
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute Codec requires a string Type. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"attribute-codec-optional": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Type:     types.StringType,
+						Optional: true,
+						Computed: true,
+						Codec:    GzipBase64Codec(),
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Attribute Codec requires Computed without Required or Optional. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"nested-attribute-invalid": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"test": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"name": {
+								Type: types.StringType,
+							},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test.name": Attribute missing Required, Optional, or Computed definition. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"block-missing-nesting-mode": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"test": {
+						Attributes: map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Optional: true,
+							},
+						},
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Block must define a NestingMode of BlockNestingModeList or BlockNestingModeSet. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"block-negative-items": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"test": {
+						MinItems:    -1,
+						NestingMode: BlockNestingModeSet,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Block MinItems and MaxItems cannot be negative. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"block-min-items-greater-than-max-items": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"test": {
+						MaxItems:    1,
+						MinItems:    2,
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test": Block MinItems cannot be greater than MaxItems. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+		"block-nested-invalid": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"test": {
+						Blocks: map[string]Block{
+							"inner": {
+								Attributes: map[string]Attribute{
+									"name": {
+										Optional: true,
+									},
+								},
+								NestingMode: BlockNestingModeList,
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The resource "test" schema defines an invalid "test.inner.name": Attribute must define either Attributes or Type. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tc.schema.validateDefinition(context.Background(), `resource "test"`, tc.reservedNames)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
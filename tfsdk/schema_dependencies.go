@@ -0,0 +1,263 @@
+package tfsdk
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// AttributeReferencer is an optional interface for AttributeValidator and
+// AttributePlanModifier implementations which read the values of other
+// attributes, such as conflicting or dependent attributes. Declaring the
+// references allows tooling to display and check attribute dependencies.
+type AttributeReferencer interface {
+	// ReferencedAttributes returns the paths, from the root of the schema,
+	// of every attribute read by the implementation.
+	ReferencedAttributes(context.Context) path.Paths
+}
+
+// AttributeDependency is an edge in the attribute dependency graph of a
+// schema, where a validator or plan modifier of one attribute references
+// another attribute.
+type AttributeDependency struct {
+	// Attribute is the path of the attribute declaring the validator or
+	// plan modifier. The path of nested attributes and blocks omits
+	// element steps, such as list indexes, as dependencies are declared
+	// for every element.
+	Attribute path.Path
+
+	// Reference is the path of the referenced attribute.
+	Reference path.Path
+
+	// Description is the plain text description of the validator or plan
+	// modifier declaring the reference.
+	Description string
+}
+
+// AttributeDependencies returns the attribute dependency graph of the
+// schema, as declared by validators and plan modifiers implementing
+// AttributeReferencer, sorted by attribute and reference path.
+func (s Schema) AttributeDependencies(ctx context.Context) []AttributeDependency {
+	var deps []AttributeDependency
+
+	for name, attr := range s.Attributes {
+		deps = append(deps, attr.dependencies(ctx, path.Root(name))...)
+	}
+
+	for name, block := range s.Blocks {
+		deps = append(deps, block.dependencies(ctx, path.Root(name))...)
+	}
+
+	sort.SliceStable(deps, func(i, j int) bool {
+		if a, b := deps[i].Attribute.String(), deps[j].Attribute.String(); a != b {
+			return a < b
+		}
+
+		return deps[i].Reference.String() < deps[j].Reference.String()
+	})
+
+	return deps
+}
+
+// dependencies returns the attribute dependencies of the attribute and any
+// nested attributes.
+func (a Attribute) dependencies(ctx context.Context, path path.Path) []AttributeDependency {
+	deps := referencerDependencies(ctx, path, a.Validators, a.PlanModifiers)
+
+	if a.Attributes != nil {
+		for name, nestedAttr := range a.Attributes.GetAttributes() {
+			deps = append(deps, nestedAttr.dependencies(ctx, path.AtName(name))...)
+		}
+	}
+
+	return deps
+}
+
+// dependencies returns the attribute dependencies of the block and any
+// nested attributes and blocks.
+func (b Block) dependencies(ctx context.Context, path path.Path) []AttributeDependency {
+	deps := referencerDependencies(ctx, path, b.Validators, b.PlanModifiers)
+
+	for name, attr := range b.Attributes {
+		deps = append(deps, attr.dependencies(ctx, path.AtName(name))...)
+	}
+
+	for name, block := range b.Blocks {
+		deps = append(deps, block.dependencies(ctx, path.AtName(name))...)
+	}
+
+	return deps
+}
+
+func referencerDependencies(ctx context.Context, path path.Path, validators []AttributeValidator, planModifiers AttributePlanModifiers) []AttributeDependency {
+	var deps []AttributeDependency
+
+	for _, validator := range validators {
+		if referencer, ok := validator.(AttributeReferencer); ok {
+			for _, reference := range referencer.ReferencedAttributes(ctx) {
+				deps = append(deps, AttributeDependency{
+					Attribute:   path,
+					Reference:   reference,
+					Description: validator.Description(ctx),
+				})
+			}
+		}
+	}
+
+	for _, planModifier := range planModifiers {
+		if referencer, ok := planModifier.(AttributeReferencer); ok {
+			for _, reference := range referencer.ReferencedAttributes(ctx) {
+				deps = append(deps, AttributeDependency{
+					Attribute:   path,
+					Reference:   reference,
+					Description: planModifier.Description(ctx),
+				})
+			}
+		}
+	}
+
+	return deps
+}
+
+// AttributeDependencyCycles returns every cycle in the attribute dependency
+// graph, such as an attribute whose validator references a second attribute
+// whose validator references the first. Each cycle lists the attribute paths
+// in dependency order, starting with the lexically lowest path.
+func AttributeDependencyCycles(deps []AttributeDependency) []path.Paths {
+	paths := map[string]path.Path{}
+	edges := map[string][]string{}
+
+	for _, dep := range deps {
+		from, to := dep.Attribute.String(), dep.Reference.String()
+		paths[from] = dep.Attribute
+		paths[to] = dep.Reference
+		edges[from] = append(edges[from], to)
+	}
+
+	nodes := make([]string, 0, len(paths))
+
+	for node := range paths {
+		nodes = append(nodes, node)
+		sort.Strings(edges[node])
+	}
+
+	sort.Strings(nodes)
+
+	var cycles []path.Paths
+
+	// Find each elementary cycle once, by only searching for cycles where
+	// the start node is the lowest node in the cycle.
+	for idx, start := range nodes {
+		allowed := map[string]bool{}
+
+		for _, node := range nodes[idx:] {
+			allowed[node] = true
+		}
+
+		var stack []string
+		onStack := map[string]bool{}
+
+		var visit func(node string)
+		visit = func(node string) {
+			stack = append(stack, node)
+			onStack[node] = true
+
+			for _, next := range edges[node] {
+				if next == start {
+					cycle := make(path.Paths, 0, len(stack))
+
+					for _, cycleNode := range stack {
+						cycle = append(cycle, paths[cycleNode])
+					}
+
+					cycles = append(cycles, cycle)
+
+					continue
+				}
+
+				if allowed[next] && !onStack[next] {
+					visit(next)
+				}
+			}
+
+			stack = stack[:len(stack)-1]
+			onStack[node] = false
+		}
+
+		visit(start)
+	}
+
+	return cycles
+}
+
+// ProviderAttributeDependencies contains the attribute dependency graphs of
+// all schemas of a provider.
+type ProviderAttributeDependencies struct {
+	// Provider is the attribute dependency graph of the provider schema.
+	Provider []AttributeDependency
+
+	// Resources are the attribute dependency graphs of the resource type
+	// schemas, keyed by resource type name.
+	Resources map[string][]AttributeDependency
+
+	// DataSources are the attribute dependency graphs of the data source
+	// type schemas, keyed by data source type name.
+	DataSources map[string][]AttributeDependency
+}
+
+// GetProviderAttributeDependencies returns the attribute dependency graphs
+// of the provider, resource type, and data source type schemas.
+func GetProviderAttributeDependencies(ctx context.Context, p Provider) (ProviderAttributeDependencies, diag.Diagnostics) {
+	result := ProviderAttributeDependencies{
+		Resources:   map[string][]AttributeDependency{},
+		DataSources: map[string][]AttributeDependency{},
+	}
+
+	providerSchema, diags := p.GetSchema(ctx)
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	result.Provider = providerSchema.AttributeDependencies(ctx)
+
+	resourceTypes, resourceTypesDiags := providerResourceTypes(ctx, p)
+	diags.Append(resourceTypesDiags...)
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	for name, resourceType := range resourceTypes {
+		schema, schemaDiags := resourceType.GetSchema(ctx)
+		diags.Append(schemaDiags...)
+
+		if schemaDiags.HasError() {
+			continue
+		}
+
+		result.Resources[name] = schema.AttributeDependencies(ctx)
+	}
+
+	dataSourceTypes, dataSourceTypesDiags := p.GetDataSources(ctx)
+	diags.Append(dataSourceTypesDiags...)
+
+	if diags.HasError() {
+		return result, diags
+	}
+
+	for name, dataSourceType := range dataSourceTypes {
+		schema, schemaDiags := dataSourceType.GetSchema(ctx)
+		diags.Append(schemaDiags...)
+
+		if schemaDiags.HasError() {
+			continue
+		}
+
+		result.DataSources[name] = schema.AttributeDependencies(ctx)
+	}
+
+	return result, diags
+}
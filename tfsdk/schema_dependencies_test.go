@@ -0,0 +1,191 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testReferencingValidator struct {
+	references path.Paths
+}
+
+func (v testReferencingValidator) Description(_ context.Context) string {
+	return "test referencing validator"
+}
+
+func (v testReferencingValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v testReferencingValidator) Validate(_ context.Context, _ ValidateAttributeRequest, _ *ValidateAttributeResponse) {
+}
+
+func (v testReferencingValidator) ReferencedAttributes(_ context.Context) path.Paths {
+	return v.references
+}
+
+func TestSchemaAttributeDependencies(t *testing.T) {
+	t.Parallel()
+
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"a": {
+				Type:     types.StringType,
+				Optional: true,
+				Validators: []AttributeValidator{
+					testReferencingValidator{
+						references: path.Paths{
+							path.Root("c"),
+							path.Root("b"),
+						},
+					},
+				},
+			},
+			"b": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"nested": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"d": {
+						Type:     types.StringType,
+						Optional: true,
+						Validators: []AttributeValidator{
+							testReferencingValidator{
+								references: path.Paths{
+									path.Root("a"),
+								},
+							},
+						},
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+		},
+		Blocks: map[string]Block{
+			"block": {
+				Attributes: map[string]Attribute{
+					"e": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				},
+				NestingMode: BlockNestingModeList,
+				Validators: []AttributeValidator{
+					testReferencingValidator{
+						references: path.Paths{
+							path.Root("b"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []AttributeDependency{
+		{
+			Attribute:   path.Root("a"),
+			Reference:   path.Root("b"),
+			Description: "test referencing validator",
+		},
+		{
+			Attribute:   path.Root("a"),
+			Reference:   path.Root("c"),
+			Description: "test referencing validator",
+		},
+		{
+			Attribute:   path.Root("block"),
+			Reference:   path.Root("b"),
+			Description: "test referencing validator",
+		},
+		{
+			Attribute:   path.Root("nested").AtName("d"),
+			Reference:   path.Root("a"),
+			Description: "test referencing validator",
+		},
+	}
+
+	got := schema.AttributeDependencies(context.Background())
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestAttributeDependencyCycles(t *testing.T) {
+	t.Parallel()
+
+	a := path.Root("a")
+	b := path.Root("b")
+	c := path.Root("c")
+
+	testCases := map[string]struct {
+		deps     []AttributeDependency
+		expected []path.Paths
+	}{
+		"none": {},
+		"acyclic": {
+			deps: []AttributeDependency{
+				{Attribute: a, Reference: b},
+				{Attribute: b, Reference: c},
+				{Attribute: a, Reference: c},
+			},
+		},
+		"self": {
+			deps: []AttributeDependency{
+				{Attribute: a, Reference: a},
+			},
+			expected: []path.Paths{
+				{a},
+			},
+		},
+		"cycles": {
+			deps: []AttributeDependency{
+				{Attribute: c, Reference: a},
+				{Attribute: a, Reference: b},
+				{Attribute: b, Reference: c},
+				{Attribute: b, Reference: a},
+			},
+			expected: []path.Paths{
+				{a, b},
+				{a, b, c},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := AttributeDependencyCycles(tc.deps)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetProviderAttributeDependencies(t *testing.T) {
+	t.Parallel()
+
+	got, diags := GetProviderAttributeDependencies(context.Background(), &testServeProvider{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %s", diags)
+	}
+
+	if _, ok := got.Resources["test_one"]; !ok {
+		t.Errorf("expected test_one resource dependencies")
+	}
+
+	if _, ok := got.DataSources["test_one"]; !ok {
+		t.Errorf("expected test_one data source dependencies")
+	}
+}
@@ -0,0 +1,320 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// schemaStructTag is the struct tag key of the comma separated attribute
+// options used by SchemaFromStruct.
+const schemaStructTag = "tfsdk_schema"
+
+var (
+	attrValueReflectType = reflect.TypeOf((*attr.Value)(nil)).Elem()
+	bigFloatReflectType  = reflect.TypeOf(big.Float{})
+	bigIntReflectType    = reflect.TypeOf(big.Int{})
+)
+
+// SchemaFromStruct returns a Schema with the attributes of the struct passed
+// as `v`, so the struct used with Get and Set does not need to be kept in
+// sync with a separately declared schema. Each exported field must have a
+// `tfsdk` struct tag with the attribute name, or "-" to skip the field, and
+// a `tfsdk_schema` struct tag with comma separated options:
+//
+// * required, optional, or computed, of which optional and computed can be
+// combined, set the Attribute Required, Optional, and Computed fields.
+//
+// * sensitive sets the Attribute Sensitive field.
+//
+// The attribute type is derived from the field type. The types package
+// primitive values, such as types.String, map to their types, as do Go
+// strings, bools, numbers, *big.Float, and *big.Int. Go slices and maps with
+// string keys map to list and map types. Fields of Go struct types, and
+// slices or maps of them, map to single, list, or map nested attributes. The
+// types package collection and object values cannot be used, since their
+// element and attribute types cannot be derived.
+//
+// Fields of Go types cannot hold null or unknown values, so use the types
+// package values for all attributes which are optional or computed.
+//
+// The returned Schema can be extended, such as by adding descriptions,
+// validators, and plan modifiers, before being returned from GetSchema.
+func SchemaFromStruct(ctx context.Context, v interface{}) (Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	typ := reflect.TypeOf(v)
+
+	for typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		diags.AddError(
+			"Schema From Struct Error",
+			"An unexpected error was encountered trying to generate a schema from a struct. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+				fmt.Sprintf("Expected a struct, got %T.", v),
+		)
+		return Schema{}, diags
+	}
+
+	attributes, err := attributesFromStructType(ctx, typ, tftypes.NewAttributePath())
+
+	if err != nil {
+		diags.AddError(
+			"Schema From Struct Error",
+			"An unexpected error was encountered trying to generate a schema from a struct. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return Schema{}, diags
+	}
+
+	return Schema{
+		Attributes: attributes,
+	}, diags
+}
+
+// attributesFromStructType returns the attributes of the tagged fields of
+// the struct type.
+func attributesFromStructType(ctx context.Context, typ reflect.Type, path *tftypes.AttributePath) (map[string]Attribute, error) {
+	attributes := make(map[string]Attribute, typ.NumField())
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		name, ok, err := structFieldAttributeName(field, path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		attributePath := path.WithAttributeName(name)
+
+		if _, ok := attributes[name]; ok {
+			return nil, attributePath.NewErrorf("attribute name is used by multiple fields")
+		}
+
+		attribute, err := attributeFromStructField(ctx, field, attributePath)
+
+		if err != nil {
+			return nil, err
+		}
+
+		attributes[name] = attribute
+	}
+
+	return attributes, nil
+}
+
+// structFieldAttributeName returns the attribute name from the `tfsdk`
+// struct tag of the field and true, or false if the field is skipped.
+func structFieldAttributeName(field reflect.StructField, path *tftypes.AttributePath) (string, bool, error) {
+	// skip unexported fields
+	if field.PkgPath != "" {
+		return "", false, nil
+	}
+
+	name := field.Tag.Get("tfsdk")
+
+	switch name {
+	case "-":
+		return "", false, nil
+	case "":
+		return "", false, path.NewErrorf(`need a struct tag for "tfsdk" on %s`, field.Name)
+	}
+
+	return name, true, nil
+}
+
+// attributeFromStructField returns the Attribute of a struct field, with the
+// options of its `tfsdk_schema` struct tag.
+func attributeFromStructField(ctx context.Context, field reflect.StructField, path *tftypes.AttributePath) (Attribute, error) {
+	var attribute Attribute
+
+	if options := field.Tag.Get(schemaStructTag); options != "" {
+		for _, option := range strings.Split(options, ",") {
+			switch strings.TrimSpace(option) {
+			case "required":
+				attribute.Required = true
+			case "optional":
+				attribute.Optional = true
+			case "computed":
+				attribute.Computed = true
+			case "sensitive":
+				attribute.Sensitive = true
+			default:
+				return Attribute{}, path.NewErrorf("unknown %s struct tag option %q on %s", schemaStructTag, option, field.Name)
+			}
+		}
+	}
+
+	if !attribute.Required && !attribute.Optional && !attribute.Computed {
+		return Attribute{}, path.NewErrorf("%s struct tag on %s must include required, optional, or computed", schemaStructTag, field.Name)
+	}
+
+	nestedAttributes, err := nestedAttributesFromGoType(ctx, field.Type, path)
+
+	if err != nil {
+		return Attribute{}, err
+	}
+
+	if nestedAttributes != nil {
+		attribute.Attributes = nestedAttributes
+
+		return attribute, nil
+	}
+
+	attribute.Type, err = attrTypeFromGoType(ctx, field.Type, path)
+
+	if err != nil {
+		return Attribute{}, err
+	}
+
+	return attribute, nil
+}
+
+// nestedAttributesFromGoType returns the NestedAttributes of a Go struct
+// type, or slices and maps of it, otherwise nil.
+func nestedAttributesFromGoType(ctx context.Context, typ reflect.Type, path *tftypes.AttributePath) (NestedAttributes, error) {
+	typ = derefReflectType(typ)
+
+	switch {
+	case isNestedStructType(typ):
+		attributes, err := attributesFromStructType(ctx, typ, path)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return SingleNestedAttributes(attributes), nil
+	case typ.Kind() == reflect.Slice && isNestedStructType(derefReflectType(typ.Elem())):
+		attributes, err := attributesFromStructType(ctx, derefReflectType(typ.Elem()), path.WithElementKeyInt(0))
+
+		if err != nil {
+			return nil, err
+		}
+
+		return ListNestedAttributes(attributes, ListNestedAttributesOptions{}), nil
+	case typ.Kind() == reflect.Map && typ.Key().Kind() == reflect.String && isNestedStructType(derefReflectType(typ.Elem())):
+		attributes, err := attributesFromStructType(ctx, derefReflectType(typ.Elem()), path.WithElementKeyString(""))
+
+		if err != nil {
+			return nil, err
+		}
+
+		return MapNestedAttributes(attributes, MapNestedAttributesOptions{}), nil
+	default:
+		return nil, nil
+	}
+}
+
+// attrTypeFromGoType returns the attr.Type of a Go type.
+func attrTypeFromGoType(ctx context.Context, typ reflect.Type, path *tftypes.AttributePath) (attr.Type, error) {
+	typ = derefReflectType(typ)
+
+	switch typ {
+	case reflect.TypeOf(types.Bool{}):
+		return types.BoolType, nil
+	case reflect.TypeOf(types.Float64{}):
+		return types.Float64Type, nil
+	case reflect.TypeOf(types.Int64{}):
+		return types.Int64Type, nil
+	case reflect.TypeOf(types.Number{}):
+		return types.NumberType, nil
+	case reflect.TypeOf(types.String{}):
+		return types.StringType, nil
+	case bigFloatReflectType, bigIntReflectType:
+		return types.NumberType, nil
+	}
+
+	if typ.Implements(attrValueReflectType) || reflect.PtrTo(typ).Implements(attrValueReflectType) {
+		return nil, path.NewErrorf("cannot derive the attribute type of %s, use a Go slice, map, or struct instead", typ)
+	}
+
+	switch typ.Kind() {
+	case reflect.Bool:
+		return types.BoolType, nil
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return types.Int64Type, nil
+	case reflect.String:
+		return types.StringType, nil
+	case reflect.Slice:
+		elemType, err := attrTypeFromGoType(ctx, typ.Elem(), path.WithElementKeyInt(0))
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.ListType{ElemType: elemType}, nil
+	case reflect.Map:
+		if typ.Key().Kind() != reflect.String {
+			return nil, path.NewErrorf("cannot derive the attribute type of %s, map keys must be strings", typ)
+		}
+
+		elemType, err := attrTypeFromGoType(ctx, typ.Elem(), path.WithElementKeyString(""))
+
+		if err != nil {
+			return nil, err
+		}
+
+		return types.MapType{ElemType: elemType}, nil
+	case reflect.Struct:
+		attrTypes := make(map[string]attr.Type, typ.NumField())
+
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+
+			name, ok, err := structFieldAttributeName(field, path)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !ok {
+				continue
+			}
+
+			attrTypes[name], err = attrTypeFromGoType(ctx, field.Type, path.WithAttributeName(name))
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return types.ObjectType{AttrTypes: attrTypes}, nil
+	default:
+		return nil, path.NewErrorf("cannot derive the attribute type of %s", typ)
+	}
+}
+
+// isNestedStructType returns true if the type is a Go struct which is not a
+// value type, such as types.String or big.Float.
+func isNestedStructType(typ reflect.Type) bool {
+	if typ.Kind() != reflect.Struct || typ == bigFloatReflectType || typ == bigIntReflectType {
+		return false
+	}
+
+	return !typ.Implements(attrValueReflectType) && !reflect.PtrTo(typ).Implements(attrValueReflectType)
+}
+
+// derefReflectType returns the type pointed to by pointer types.
+func derefReflectType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
@@ -0,0 +1,246 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaFromStruct(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		Port types.Int64 `tfsdk:"port" tfsdk_schema:"required"`
+	}
+
+	type object struct {
+		Name string `tfsdk:"name"`
+	}
+
+	testCases := map[string]struct {
+		value         interface{}
+		expected      Schema
+		expectedError bool
+	}{
+		"primitives": {
+			value: struct {
+				ID       types.String  `tfsdk:"id" tfsdk_schema:"computed"`
+				Name     types.String  `tfsdk:"name" tfsdk_schema:"required"`
+				Enabled  types.Bool    `tfsdk:"enabled" tfsdk_schema:"optional, computed"`
+				Count    types.Int64   `tfsdk:"count" tfsdk_schema:"optional"`
+				Ratio    types.Float64 `tfsdk:"ratio" tfsdk_schema:"optional"`
+				Size     types.Number  `tfsdk:"size" tfsdk_schema:"optional"`
+				Password types.String  `tfsdk:"password" tfsdk_schema:"required,sensitive"`
+				Ignored  string        `tfsdk:"-"`
+				internal string
+			}{},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"id":       {Type: types.StringType, Computed: true},
+					"name":     {Type: types.StringType, Required: true},
+					"enabled":  {Type: types.BoolType, Optional: true, Computed: true},
+					"count":    {Type: types.Int64Type, Optional: true},
+					"ratio":    {Type: types.Float64Type, Optional: true},
+					"size":     {Type: types.NumberType, Optional: true},
+					"password": {Type: types.StringType, Required: true, Sensitive: true},
+				},
+			},
+		},
+		"go-types": {
+			value: &struct {
+				Name    string            `tfsdk:"name" tfsdk_schema:"required"`
+				Enabled *bool             `tfsdk:"enabled" tfsdk_schema:"required"`
+				Count   int               `tfsdk:"count" tfsdk_schema:"required"`
+				Ratio   float64           `tfsdk:"ratio" tfsdk_schema:"required"`
+				Size    *big.Float        `tfsdk:"size" tfsdk_schema:"required"`
+				Tags    map[string]string `tfsdk:"tags" tfsdk_schema:"required"`
+				Zones   []types.String    `tfsdk:"zones" tfsdk_schema:"required"`
+				Groups  [][]object        `tfsdk:"groups" tfsdk_schema:"required"`
+			}{},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"name":    {Type: types.StringType, Required: true},
+					"enabled": {Type: types.BoolType, Required: true},
+					"count":   {Type: types.Int64Type, Required: true},
+					"ratio":   {Type: types.Float64Type, Required: true},
+					"size":    {Type: types.NumberType, Required: true},
+					"tags":    {Type: types.MapType{ElemType: types.StringType}, Required: true},
+					"zones":   {Type: types.ListType{ElemType: types.StringType}, Required: true},
+					"groups": {
+						Type: types.ListType{
+							ElemType: types.ListType{
+								ElemType: types.ObjectType{
+									AttrTypes: map[string]attr.Type{
+										"name": types.StringType,
+									},
+								},
+							},
+						},
+						Required: true,
+					},
+				},
+			},
+		},
+		"nested-attributes": {
+			value: struct {
+				Single *nested           `tfsdk:"single" tfsdk_schema:"optional"`
+				List   []nested          `tfsdk:"list" tfsdk_schema:"optional"`
+				Map    map[string]nested `tfsdk:"map" tfsdk_schema:"optional"`
+			}{},
+			expected: Schema{
+				Attributes: map[string]Attribute{
+					"single": {
+						Attributes: SingleNestedAttributes(map[string]Attribute{
+							"port": {Type: types.Int64Type, Required: true},
+						}),
+						Optional: true,
+					},
+					"list": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"port": {Type: types.Int64Type, Required: true},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+					"map": {
+						Attributes: MapNestedAttributes(map[string]Attribute{
+							"port": {Type: types.Int64Type, Required: true},
+						}, MapNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+			},
+		},
+		"not-struct": {
+			value:         "test",
+			expectedError: true,
+		},
+		"nil": {
+			value:         nil,
+			expectedError: true,
+		},
+		"missing-tfsdk-tag": {
+			value: struct {
+				Name types.String `tfsdk_schema:"required"`
+			}{},
+			expectedError: true,
+		},
+		"missing-options": {
+			value: struct {
+				Name types.String `tfsdk:"name"`
+			}{},
+			expectedError: true,
+		},
+		"unknown-option": {
+			value: struct {
+				Name types.String `tfsdk:"name" tfsdk_schema:"required,secret"`
+			}{},
+			expectedError: true,
+		},
+		"duplicate-name": {
+			value: struct {
+				Name  types.String `tfsdk:"name" tfsdk_schema:"required"`
+				Other types.String `tfsdk:"name" tfsdk_schema:"required"`
+			}{},
+			expectedError: true,
+		},
+		"collection-value": {
+			value: struct {
+				Tags types.Map `tfsdk:"tags" tfsdk_schema:"optional"`
+			}{},
+			expectedError: true,
+		},
+		"nested-missing-options": {
+			value: struct {
+				List []object `tfsdk:"list" tfsdk_schema:"optional"`
+			}{},
+			expectedError: true,
+		},
+		"non-string-map-key": {
+			value: struct {
+				Values map[int]string `tfsdk:"values" tfsdk_schema:"optional"`
+			}{},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := SchemaFromStruct(context.Background(), tc.value)
+
+			if diags.HasError() {
+				if tc.expectedError {
+					return
+				}
+
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if tc.expectedError {
+				t.Fatal("expected error, got none")
+			}
+
+			if diff := cmp.Diff(got, tc.expected, cmp.Comparer(func(a, b Attribute) bool { return a.Equal(b) })); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaFromStructState(t *testing.T) {
+	t.Parallel()
+
+	type model struct {
+		ID    types.String   `tfsdk:"id" tfsdk_schema:"computed"`
+		Name  types.String   `tfsdk:"name" tfsdk_schema:"required"`
+		Zones []types.String `tfsdk:"zones" tfsdk_schema:"optional"`
+		Rules []struct {
+			Port types.Int64 `tfsdk:"port" tfsdk_schema:"required"`
+		} `tfsdk:"rules" tfsdk_schema:"optional"`
+	}
+
+	ctx := context.Background()
+	schema, diags := SchemaFromStruct(ctx, model{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	expected := model{
+		ID:    types.String{Value: "test-id"},
+		Name:  types.String{Value: "test-name"},
+		Zones: []types.String{{Value: "a"}},
+	}
+	expected.Rules = append(expected.Rules, struct {
+		Port types.Int64 `tfsdk:"port" tfsdk_schema:"required"`
+	}{Port: types.Int64{Value: 443}})
+
+	state := State{
+		Schema: schema,
+	}
+
+	diags = state.Set(ctx, expected)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected Set error: %v", diags)
+	}
+
+	var got model
+
+	diags = state.Get(ctx, &got)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected Get error: %v", diags)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,369 @@
+package tfsdk
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// schemaJSONFormatVersion is the format_version of the providers schema JSON
+// output of Terraform CLI which is rendered by ProviderSchemaJSON.
+const schemaJSONFormatVersion = "1.0"
+
+// The following types mirror the providers schema JSON output of the
+// Terraform CLI `terraform providers schema -json` command, which can be
+// decoded with the github.com/hashicorp/terraform-json Go module.
+
+type providerSchemasJSON struct {
+	FormatVersion string                         `json:"format_version"`
+	Schemas       map[string]*providerSchemaJSON `json:"provider_schemas,omitempty"`
+}
+
+type providerSchemaJSON struct {
+	Provider          *schemaJSON            `json:"provider,omitempty"`
+	ResourceSchemas   map[string]*schemaJSON `json:"resource_schemas,omitempty"`
+	DataSourceSchemas map[string]*schemaJSON `json:"data_source_schemas,omitempty"`
+}
+
+type schemaJSON struct {
+	Version int64            `json:"version"`
+	Block   *schemaBlockJSON `json:"block,omitempty"`
+}
+
+type schemaBlockJSON struct {
+	Attributes      map[string]*schemaAttributeJSON `json:"attributes,omitempty"`
+	BlockTypes      map[string]*schemaBlockTypeJSON `json:"block_types,omitempty"`
+	Description     string                          `json:"description,omitempty"`
+	DescriptionKind string                          `json:"description_kind,omitempty"`
+	Deprecated      bool                            `json:"deprecated,omitempty"`
+}
+
+type schemaBlockTypeJSON struct {
+	NestingMode string           `json:"nesting_mode,omitempty"`
+	Block       *schemaBlockJSON `json:"block,omitempty"`
+	MinItems    int64            `json:"min_items,omitempty"`
+	MaxItems    int64            `json:"max_items,omitempty"`
+}
+
+type schemaAttributeJSON struct {
+	AttributeType       json.RawMessage       `json:"type,omitempty"`
+	AttributeNestedType *schemaNestedTypeJSON `json:"nested_type,omitempty"`
+	Description         string                `json:"description,omitempty"`
+	DescriptionKind     string                `json:"description_kind,omitempty"`
+	Deprecated          bool                  `json:"deprecated,omitempty"`
+	Required            bool                  `json:"required,omitempty"`
+	Optional            bool                  `json:"optional,omitempty"`
+	Computed            bool                  `json:"computed,omitempty"`
+	Sensitive           bool                  `json:"sensitive,omitempty"`
+
+	// Bounds is not part of the Terraform CLI output. It is added for
+	// tooling which consumes schema constraints, such as documentation
+	// generators.
+	Bounds *AttributeBounds `json:"bounds,omitempty"`
+}
+
+type schemaNestedTypeJSON struct {
+	Attributes  map[string]*schemaAttributeJSON `json:"attributes,omitempty"`
+	NestingMode string                          `json:"nesting_mode,omitempty"`
+}
+
+// ToJSON renders the Schema as a single schema of the providers schema JSON
+// output of Terraform CLI, with the version and block of the schema. This
+// allows documentation and other tooling to consume the schema without
+// starting the provider server.
+func (s Schema) ToJSON(ctx context.Context) ([]byte, error) {
+	schema6, err := s.tfprotov6Schema(ctx)
+
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := schemaJSONFromTfprotov6(schema6)
+
+	if err != nil {
+		return nil, err
+	}
+
+	addSchemaJSONBounds(ctx, schema, s)
+
+	return json.Marshal(schema)
+}
+
+// ProviderSchemaJSON renders the provider, resource, and data source schemas
+// of the Provider in the providers schema JSON output format of Terraform
+// CLI, under the given provider source address, such as
+// "registry.terraform.io/example/example". The schemas are checked the same
+// way as when they are returned to Terraform.
+func ProviderSchemaJSON(ctx context.Context, p Provider, providerAddress string) ([]byte, diag.Diagnostics) {
+	s := &server{
+		p: p,
+	}
+	resp := new(getProviderSchemaResponse)
+
+	s.getProviderSchema(ctx, resp)
+
+	if resp.Diagnostics.HasError() {
+		return nil, resp.Diagnostics
+	}
+
+	providerSchema := &providerSchemaJSON{
+		ResourceSchemas:   make(map[string]*schemaJSON, len(resp.ResourceSchemas)),
+		DataSourceSchemas: make(map[string]*schemaJSON, len(resp.DataSourceSchemas)),
+	}
+
+	var err error
+
+	providerSchema.Provider, err = schemaJSONFromTfprotov6(resp.Provider)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting provider schema",
+			"The provider schema couldn't be converted into JSON. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, resp.Diagnostics
+	}
+
+	// The provider schema was checked by getProviderSchema, so only its
+	// bounds are needed here.
+	if schema, _ := p.GetSchema(ctx); providerSchema.Provider != nil {
+		addSchemaJSONBounds(ctx, providerSchema.Provider, schema)
+	}
+
+	for typeName, schema6 := range resp.ResourceSchemas {
+		providerSchema.ResourceSchemas[typeName], err = schemaJSONFromTfprotov6(schema6)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting resource schema",
+				"The schema for the resource \""+typeName+"\" couldn't be converted into JSON. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, resp.Diagnostics
+		}
+
+		addSchemaJSONBounds(ctx, providerSchema.ResourceSchemas[typeName], s.schemaCache.resourceSchemas[typeName].schema)
+	}
+
+	for typeName, schema6 := range resp.DataSourceSchemas {
+		providerSchema.DataSourceSchemas[typeName], err = schemaJSONFromTfprotov6(schema6)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error converting data source schema",
+				"The schema for the data source \""+typeName+"\" couldn't be converted into JSON. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return nil, resp.Diagnostics
+		}
+
+		addSchemaJSONBounds(ctx, providerSchema.DataSourceSchemas[typeName], s.schemaCache.dataSourceSchemas[typeName].schema)
+	}
+
+	result, err := json.Marshal(providerSchemasJSON{
+		FormatVersion: schemaJSONFormatVersion,
+		Schemas: map[string]*providerSchemaJSON{
+			providerAddress: providerSchema,
+		},
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error converting provider schemas",
+			"The provider schemas couldn't be converted into JSON. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, resp.Diagnostics
+	}
+
+	return result, resp.Diagnostics
+}
+
+// schemaJSONFromTfprotov6 returns the JSON representation of the schema
+// which would be sent to Terraform.
+func schemaJSONFromTfprotov6(schema6 *tfprotov6.Schema) (*schemaJSON, error) {
+	if schema6 == nil {
+		return nil, nil
+	}
+
+	block, err := schemaBlockJSONFromTfprotov6(schema6.Block)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &schemaJSON{
+		Version: schema6.Version,
+		Block:   block,
+	}, nil
+}
+
+func schemaBlockJSONFromTfprotov6(block6 *tfprotov6.SchemaBlock) (*schemaBlockJSON, error) {
+	if block6 == nil {
+		return nil, nil
+	}
+
+	block := &schemaBlockJSON{
+		Description:     block6.Description,
+		DescriptionKind: schemaJSONDescriptionKind(block6.DescriptionKind),
+		Deprecated:      block6.Deprecated,
+	}
+
+	if len(block6.Attributes) > 0 {
+		attributes, err := schemaAttributesJSONFromTfprotov6(block6.Attributes)
+
+		if err != nil {
+			return nil, err
+		}
+
+		block.Attributes = attributes
+	}
+
+	for _, blockType6 := range block6.BlockTypes {
+		nestedBlock, err := schemaBlockJSONFromTfprotov6(blockType6.Block)
+
+		if err != nil {
+			return nil, fmt.Errorf("block %q: %w", blockType6.TypeName, err)
+		}
+
+		var nestingMode string
+
+		switch blockType6.Nesting {
+		case tfprotov6.SchemaNestedBlockNestingModeSingle:
+			nestingMode = "single"
+		case tfprotov6.SchemaNestedBlockNestingModeList:
+			nestingMode = "list"
+		case tfprotov6.SchemaNestedBlockNestingModeSet:
+			nestingMode = "set"
+		case tfprotov6.SchemaNestedBlockNestingModeMap:
+			nestingMode = "map"
+		case tfprotov6.SchemaNestedBlockNestingModeGroup:
+			nestingMode = "group"
+		default:
+			return nil, fmt.Errorf("block %q: unrecognized nesting mode %v", blockType6.TypeName, blockType6.Nesting)
+		}
+
+		if block.BlockTypes == nil {
+			block.BlockTypes = make(map[string]*schemaBlockTypeJSON, len(block6.BlockTypes))
+		}
+
+		block.BlockTypes[blockType6.TypeName] = &schemaBlockTypeJSON{
+			NestingMode: nestingMode,
+			Block:       nestedBlock,
+			MinItems:    blockType6.MinItems,
+			MaxItems:    blockType6.MaxItems,
+		}
+	}
+
+	return block, nil
+}
+
+func schemaAttributesJSONFromTfprotov6(attributes6 []*tfprotov6.SchemaAttribute) (map[string]*schemaAttributeJSON, error) {
+	attributes := make(map[string]*schemaAttributeJSON, len(attributes6))
+
+	for _, attribute6 := range attributes6 {
+		attribute := &schemaAttributeJSON{
+			Description:     attribute6.Description,
+			DescriptionKind: schemaJSONDescriptionKind(attribute6.DescriptionKind),
+			Deprecated:      attribute6.Deprecated,
+			Required:        attribute6.Required,
+			Optional:        attribute6.Optional,
+			Computed:        attribute6.Computed,
+			Sensitive:       attribute6.Sensitive,
+		}
+
+		if attribute6.Type != nil {
+			attributeType, err := json.Marshal(attribute6.Type)
+
+			if err != nil {
+				return nil, fmt.Errorf("attribute %q: %w", attribute6.Name, err)
+			}
+
+			attribute.AttributeType = attributeType
+		}
+
+		if attribute6.NestedType != nil {
+			nestedAttributes, err := schemaAttributesJSONFromTfprotov6(attribute6.NestedType.Attributes)
+
+			if err != nil {
+				return nil, fmt.Errorf("attribute %q: %w", attribute6.Name, err)
+			}
+
+			var nestingMode string
+
+			switch attribute6.NestedType.Nesting {
+			case tfprotov6.SchemaObjectNestingModeSingle:
+				nestingMode = "single"
+			case tfprotov6.SchemaObjectNestingModeList:
+				nestingMode = "list"
+			case tfprotov6.SchemaObjectNestingModeSet:
+				nestingMode = "set"
+			case tfprotov6.SchemaObjectNestingModeMap:
+				nestingMode = "map"
+			default:
+				return nil, fmt.Errorf("attribute %q: unrecognized nesting mode %v", attribute6.Name, attribute6.NestedType.Nesting)
+			}
+
+			attribute.AttributeNestedType = &schemaNestedTypeJSON{
+				Attributes:  nestedAttributes,
+				NestingMode: nestingMode,
+			}
+		}
+
+		attributes[attribute6.Name] = attribute
+	}
+
+	return attributes, nil
+}
+
+// addSchemaJSONBounds sets the bounds of the attributes of the JSON
+// representation of the schema, including nested attributes and the
+// attributes of blocks.
+func addSchemaJSONBounds(ctx context.Context, schema *schemaJSON, s Schema) {
+	if schema == nil {
+		return
+	}
+
+	addSchemaBlockJSONBounds(ctx, schema.Block, s.Attributes, s.Blocks)
+}
+
+func addSchemaBlockJSONBounds(ctx context.Context, block *schemaBlockJSON, attributes map[string]Attribute, blocks map[string]Block) {
+	if block == nil {
+		return
+	}
+
+	addSchemaAttributesJSONBounds(ctx, block.Attributes, attributes)
+
+	for name, b := range blocks {
+		if blockType, ok := block.BlockTypes[name]; ok {
+			addSchemaBlockJSONBounds(ctx, blockType.Block, b.Attributes, b.Blocks)
+		}
+	}
+}
+
+func addSchemaAttributesJSONBounds(ctx context.Context, attributesJSON map[string]*schemaAttributeJSON, attributes map[string]Attribute) {
+	for name, a := range attributes {
+		attribute, ok := attributesJSON[name]
+
+		if !ok {
+			continue
+		}
+
+		if bounds := a.Bounds(ctx); !bounds.IsEmpty() {
+			attribute.Bounds = &bounds
+		}
+
+		if a.Attributes != nil && attribute.AttributeNestedType != nil {
+			addSchemaAttributesJSONBounds(ctx, attribute.AttributeNestedType.Attributes, a.Attributes.GetAttributes())
+		}
+	}
+}
+
+// schemaJSONDescriptionKind returns the description_kind of Terraform CLI,
+// which defaults to plain.
+func schemaJSONDescriptionKind(kind tfprotov6.StringKind) string {
+	if kind == tfprotov6.StringKindMarkdown {
+		return "markdown"
+	}
+
+	return "plain"
+}
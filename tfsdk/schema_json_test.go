@@ -0,0 +1,253 @@
+package tfsdk
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestSchemaToJSON(t *testing.T) {
+	t.Parallel()
+
+	int64Pointer := func(value int64) *int64 {
+		return &value
+	}
+
+	testCases := map[string]struct {
+		schema        Schema
+		expected      string
+		expectedError bool
+	}{
+		"empty": {
+			schema:   Schema{},
+			expected: `{"version":0,"block":{"description_kind":"plain"}}`,
+		},
+		"attributes": {
+			schema: Schema{
+				Version: 1,
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"tags": {
+						Type:        types.MapType{ElemType: types.StringType},
+						Optional:    true,
+						Description: "Tags to assign.",
+					},
+					"password": {
+						Type:                types.StringType,
+						Required:            true,
+						Sensitive:           true,
+						MarkdownDescription: "The `password`.",
+						DeprecationMessage:  "Use token instead.",
+					},
+				},
+				Description: "A resource.",
+			},
+			expected: `{"version":1,"block":{"attributes":{"id":{"type":"string","description_kind":"plain","computed":true},"password":{"type":"string","description":"The ` + "`password`" + `.","description_kind":"markdown","deprecated":true,"required":true,"sensitive":true},"tags":{"type":["map","string"],"description":"Tags to assign.","description_kind":"plain","optional":true}},"description":"A resource.","description_kind":"plain"}}`,
+		},
+		"nested-attributes": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"rules": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"port": {
+								Type:     types.Int64Type,
+								Required: true,
+							},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+			},
+			expected: `{"version":0,"block":{"attributes":{"rules":{"nested_type":{"attributes":{"port":{"type":"number","description_kind":"plain","required":true}},"nesting_mode":"list"},"description_kind":"plain","optional":true}},"description_kind":"plain"}}`,
+		},
+		"blocks": {
+			schema: Schema{
+				Blocks: map[string]Block{
+					"rule": {
+						Attributes: map[string]Attribute{
+							"port": {
+								Type:     types.Int64Type,
+								Required: true,
+							},
+						},
+						MinItems:    1,
+						MaxItems:    2,
+						NestingMode: BlockNestingModeSet,
+					},
+				},
+			},
+			expected: `{"version":0,"block":{"block_types":{"rule":{"nesting_mode":"set","block":{"attributes":{"port":{"type":"number","description_kind":"plain","required":true}},"description_kind":"plain"},"min_items":1,"max_items":2}},"description_kind":"plain"}}`,
+		},
+		"bounds": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"port": {
+						Type:     types.Int64Type,
+						Required: true,
+						Validators: []AttributeValidator{
+							testBoundsValidator{bounds: AttributeBounds{MinValue: big.NewFloat(1), MaxValue: big.NewFloat(65535)}},
+						},
+					},
+					"rules": {
+						Attributes: ListNestedAttributes(map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Required: true,
+								Validators: []AttributeValidator{
+									testBoundsValidator{bounds: AttributeBounds{MinLength: int64Pointer(1), MaxLength: int64Pointer(64)}},
+								},
+							},
+						}, ListNestedAttributesOptions{}),
+						Optional: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"tag": {
+						Attributes: map[string]Attribute{
+							"values": {
+								Type:     types.ListType{ElemType: types.StringType},
+								Optional: true,
+								Validators: []AttributeValidator{
+									testBoundsValidator{bounds: AttributeBounds{MaxItems: int64Pointer(10)}},
+								},
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+				},
+			},
+			expected: `{"version":0,"block":{"attributes":{"port":{"type":"number","description_kind":"plain","required":true,"bounds":{"min_value":"1","max_value":"65535"}},"rules":{"nested_type":{"attributes":{"name":{"type":"string","description_kind":"plain","required":true,"bounds":{"min_length":1,"max_length":64}}},"nesting_mode":"list"},"description_kind":"plain","optional":true}},"block_types":{"tag":{"nesting_mode":"list","block":{"attributes":{"values":{"type":["list","string"],"description_kind":"plain","optional":true,"bounds":{"max_items":10}}},"description_kind":"plain"}}},"description_kind":"plain"}}`,
+		},
+		"invalid": {
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Computed: true,
+					},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tc.schema.ToJSON(context.Background())
+
+			if err != nil {
+				if tc.expectedError {
+					return
+				}
+
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if tc.expectedError {
+				t.Fatal("expected error, got none")
+			}
+
+			if diff := cmp.Diff(string(got), tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+type testSchemaJSONProvider struct {
+	schema Schema
+}
+
+func (p testSchemaJSONProvider) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	return p.schema, nil
+}
+
+func (p testSchemaJSONProvider) Configure(_ context.Context, _ ConfigureProviderRequest, _ *ConfigureProviderResponse) {
+}
+
+func (p testSchemaJSONProvider) GetResources(_ context.Context) (map[string]ResourceType, diag.Diagnostics) {
+	return map[string]ResourceType{
+		"test_resource": testSchemaCacheResourceType{
+			calls: new(int32),
+		},
+	}, nil
+}
+
+func (p testSchemaJSONProvider) GetDataSources(_ context.Context) (map[string]DataSourceType, diag.Diagnostics) {
+	return map[string]DataSourceType{
+		"test_data_source": testServeDataSourceTypeOne{},
+	}, nil
+}
+
+func TestProviderSchemaJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		provider      testSchemaJSONProvider
+		expected      string
+		expectedDiags diag.Diagnostics
+	}{
+		"valid": {
+			provider: testSchemaJSONProvider{
+				schema: Schema{
+					Attributes: map[string]Attribute{
+						"endpoint": {
+							Type:     types.StringType,
+							Optional: true,
+						},
+					},
+				},
+			},
+			expected: `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/example/test":{` +
+				`"provider":{"version":0,"block":{"attributes":{"endpoint":{"type":"string","description_kind":"plain","optional":true}},"description_kind":"plain"}},` +
+				`"resource_schemas":{"test_resource":{"version":0,"block":{"attributes":{"id":{"type":"string","description_kind":"plain","computed":true}},"description_kind":"plain"}}},` +
+				`"data_source_schemas":{"test_data_source":{"version":0,"block":{"attributes":{"current_date":{"type":"string","description_kind":"plain","computed":true},"current_time":{"type":"string","description_kind":"plain","computed":true},"is_dst":{"type":"bool","description_kind":"plain","computed":true}},"description_kind":"plain"}}}}}}`,
+		},
+		"invalid-schema-definition": {
+			provider: testSchemaJSONProvider{
+				schema: Schema{
+					Attributes: map[string]Attribute{
+						"alias": {
+							Type:     types.StringType,
+							Optional: true,
+						},
+					},
+				},
+			},
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic(
+					"Invalid Schema Definition",
+					`The provider schema defines an invalid "alias": Name is reserved by Terraform and cannot be used. This is always a problem with the provider and should be reported to the provider developer.`,
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := ProviderSchemaJSON(context.Background(), tc.provider, "registry.terraform.io/example/test")
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(string(got), tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@ package tfsdk
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // ModifySchemaPlanRequest represents a request for a schema to run all
@@ -19,6 +19,10 @@ type ModifySchemaPlanRequest struct {
 
 	// ProviderMeta is metadata from the provider_meta block of the module.
 	ProviderMeta Config
+
+	// ProviderData is the ResourceData set by the Provider's Configure
+	// method, or nil if the provider has not been configured yet.
+	ProviderData interface{}
 }
 
 // ModifySchemaPlanResponse represents a response to a ModifySchemaPlanRequest.
@@ -30,7 +34,7 @@ type ModifySchemaPlanResponse struct {
 	// resource to be replaced. They should point to the specific field
 	// that changed that requires the resource to be destroyed and
 	// recreated.
-	RequiresReplace []*tftypes.AttributePath
+	RequiresReplace path.Paths
 
 	// Diagnostics report errors or warnings related to running all attribute
 	// plan modifiers. Returning an empty slice indicates a successful
@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -17,19 +18,19 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 
 	testCases := map[string]struct {
 		schema      Schema
-		path        *tftypes.AttributePath
+		path        path.Path
 		expected    Attribute
 		expectedErr string
 	}{
 		"empty-root": {
 			schema:      Schema{},
-			path:        tftypes.NewAttributePath(),
+			path:        path.Empty(),
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
 		"empty-nil": {
 			schema:      Schema{},
-			path:        nil,
+			path:        path.Path{},
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
@@ -42,7 +43,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath(),
+			path:        path.Empty(),
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
@@ -55,7 +56,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        nil,
+			path:        path.Path{},
 			expected:    Attribute{},
 			expectedErr: "got unexpected type tfsdk.Schema",
 		},
@@ -72,7 +73,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -100,7 +101,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't apply tftypes.AttributeName to ListNestedAttributes",
 		},
@@ -126,7 +127,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -152,7 +153,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -180,7 +181,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to ListNestedAttributes",
 		},
@@ -206,7 +207,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't apply tftypes.ElementKeyValue to ListNestedAttributes",
 		},
@@ -243,7 +244,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't apply tftypes.AttributeName to block NestingModeList",
 		},
@@ -280,7 +281,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -317,7 +318,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -356,7 +357,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to block NestingModeList",
 		},
@@ -393,7 +394,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't apply tftypes.ElementKeyValue to block NestingModeList",
 		},
@@ -419,7 +420,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't use tftypes.AttributeName on maps",
 		},
@@ -445,7 +446,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't use tftypes.ElementKeyInt on maps",
 		},
@@ -471,7 +472,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -497,7 +498,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path: path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -525,7 +526,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't use tftypes.ElementKeyValue on maps",
 		},
@@ -551,7 +552,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't use tftypes.AttributeName on sets",
 		},
@@ -577,7 +578,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't use tftypes.ElementKeyInt on sets",
 		},
@@ -603,7 +604,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't use tftypes.ElementKeyString on sets",
 		},
@@ -629,7 +630,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -655,7 +656,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")).WithAttributeName("sub_test"),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -694,7 +695,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "AttributeName(\"sub_test\") still remains in the path: can't apply tftypes.AttributeName to block NestingModeSet",
 		},
@@ -731,7 +732,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't apply tftypes.ElementKeyInt to block NestingModeSet",
 		},
@@ -768,7 +769,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to block NestingModeSet",
 		},
@@ -805,7 +806,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -842,7 +843,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")).WithAttributeName("sub_test"),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")).AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -870,7 +871,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path: path.Root("test").AtName("sub_test"),
 			expected: Attribute{
 				Type:     types.StringType,
 				Required: true,
@@ -898,7 +899,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: can't apply tftypes.ElementKeyInt to Attributes",
 		},
@@ -924,7 +925,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:        path.Root("test").AtMapKey("sub_test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"sub_test\") still remains in the path: can't apply tftypes.ElementKeyString to Attributes",
 		},
@@ -950,7 +951,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "sub_test")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "sub_test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"sub_test\">) still remains in the path: can't apply tftypes.ElementKeyValue to Attributes",
 		},
@@ -971,7 +972,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:        path.Root("test").AtName("sub_test"),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -988,7 +989,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyInt to types.StringType",
 		},
@@ -1007,7 +1008,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:        path.Root("test").AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -1024,7 +1025,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element"),
+			path:        path.Root("test").AtMapKey("element"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"element\") still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyString to types.StringType",
 		},
@@ -1043,7 +1044,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element"),
+			path:        path.Root("test").AtMapKey("element"),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -1060,7 +1061,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"element\">) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyValue to types.StringType",
 		},
@@ -1079,7 +1080,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")),
+			path:        path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "element")),
 			expected:    Attribute{},
 			expectedErr: ErrPathInsideAtomicAttribute.Error(),
 		},
@@ -1092,7 +1093,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithElementKeyInt(0),
+			path:        path.Empty().AtListIndex(0),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyInt(0) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyInt to schema",
 		},
@@ -1105,7 +1106,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithElementKeyString("test"),
+			path:        path.Empty().AtMapKey("test"),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyString(\"test\") still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyString to schema",
 		},
@@ -1118,7 +1119,7 @@ func TestSchemaAttributeAtPath(t *testing.T) {
 					},
 				},
 			},
-			path:        tftypes.NewAttributePath().WithElementKeyValue(tftypes.NewValue(tftypes.String, "test")),
+			path:        path.Empty().AtSetValue(tftypes.NewValue(tftypes.String, "test")),
 			expected:    Attribute{},
 			expectedErr: "ElementKeyValue(tftypes.String<\"test\">) still remains in the path: cannot apply AttributePathStep tftypes.ElementKeyValue to schema",
 		},
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/proto6"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -18,9 +20,57 @@ import (
 var _ tfprotov6.ProviderServer = &server{}
 
 type server struct {
-	p                Provider
-	contextCancels   []context.CancelFunc
-	contextCancelsMu sync.Mutex
+	p Provider
+
+	// contextCancels are the cancel functions of the contexts of in-flight
+	// requests, keyed by a counter, which StopProvider calls to interrupt
+	// them. Requests started after StopProvider are canceled immediately.
+	contextCancels     map[uint64]context.CancelFunc
+	contextCancelsNext uint64
+	contextCancelsMu   sync.Mutex
+	stopped            bool
+
+	// validateCreatedID enables checking that Create sets the
+	// IDAttributeName attribute, when declared.
+	validateCreatedID bool
+
+	// clock and rand, if set, are added to every request context.
+	clock Clock
+	rand  Rand
+
+	// correlationIDInDiagnostics appends the correlation ID of the request
+	// to every diagnostic detail.
+	correlationIDInDiagnostics bool
+
+	// tracer, if set, creates spans around RPCs and method calls.
+	tracer Tracer
+
+	// metrics, if set, records measurements of RPCs and method calls.
+	metrics Metrics
+
+	// maxDynamicValueSize is the maximum size of returned plans and
+	// states. Zero uses DefaultMaxDynamicValueSize and a negative size
+	// disables the check.
+	maxDynamicValueSize int
+
+	// operationLimit, if set, bounds concurrent resource and data source
+	// method calls.
+	operationLimit operationLimit
+
+	// schemaCache memoizes resource and data source schemas.
+	schemaCache schemaCache
+
+	// dataSourceData and resourceData are set by the Provider's Configure
+	// method and passed to the Configure method of each data source and
+	// resource instance.
+	dataSourceData interface{}
+	resourceData   interface{}
+
+	// terraformVersion is sent by Terraform when configuring the provider
+	// and added to every later request context.
+	terraformVersion string
+
+	providerDataMu sync.RWMutex
 }
 
 // ServeOpts are options for serving the provider.
@@ -34,6 +84,64 @@ type ServeOpts struct {
 	// needed for Terraform CLI to connect to the provider is output to stdout.
 	// os.Interrupt (Ctrl-c) can be used to stop the provider.
 	Debug bool
+
+	// ValidateCreatedID returns an error diagnostic when Create does not
+	// set a non-empty value for the IDAttributeName attribute, if the
+	// resource schema declares it. This catches provider development
+	// errors, which Terraform would otherwise only report on a later
+	// operation.
+	ValidateCreatedID bool
+
+	// Clock, if set, replaces the time package as the source of time for
+	// framework functionality and is returned by ClockFromContext.
+	Clock Clock
+
+	// Rand, if set, replaces the math/rand package as the source of
+	// pseudo-random numbers for framework functionality and is returned by
+	// RandFromContext. Terraform may send requests concurrently, so the
+	// implementation must be safe for concurrent use, unlike *rand.Rand.
+	Rand Rand
+
+	// CorrelationIDInDiagnostics appends the unique identifier of each RPC,
+	// which is returned by CorrelationIDFromContext, to the detail of every
+	// diagnostic returned to Terraform. This allows practitioner error
+	// reports to be matched with provider and API logs.
+	CorrelationIDInDiagnostics bool
+
+	// Tracer, if set, creates spans around the handling of each RPC and
+	// each call of a resource or data source method, such as with
+	// OpenTelemetry.
+	Tracer Tracer
+
+	// Metrics, if set, records the duration and diagnostics counts of the
+	// handling of each RPC and each call of a resource or data source
+	// method, such as for exporting Prometheus metrics.
+	Metrics Metrics
+
+	// RPCMiddleware wraps the handling of protocol RPCs, outermost
+	// first, so the first middleware is called first and calls into the
+	// second.
+	RPCMiddleware []RPCMiddleware
+
+	// MaxDynamicValueSize is the maximum size in bytes of an encoded plan
+	// or state returned to Terraform. Larger values are replaced with an
+	// error diagnostic, since Terraform would otherwise fail to receive the
+	// response with a transport error, and values approaching the size
+	// return a warning diagnostic. Each plan or state is measured on its
+	// own, not the whole response message it is returned in. Zero uses
+	// DefaultMaxDynamicValueSize, which is the transport limit, and a
+	// negative size disables the check.
+	MaxDynamicValueSize int
+
+	// MaxConcurrentOperations, if positive, is the maximum number of
+	// resource Create, Read, Update, and Delete and data source Read calls
+	// which run at the same time. Terraform calls resources concurrently,
+	// up to its -parallelism flag, so this allows providers for APIs with
+	// strict rate or concurrency limits to queue calls without each
+	// resource locking a global mutex. Calls wait after any Interceptors,
+	// so interceptors which retry calls do not hold a slot between
+	// attempts.
+	MaxConcurrentOperations int
 }
 
 // NewProtocol6Server returns a tfprotov6.ProviderServer implementation based
@@ -44,6 +152,22 @@ func NewProtocol6Server(p Provider) tfprotov6.ProviderServer {
 	}
 }
 
+// NewProtocol6ServerWithOptions returns a tfprotov6.ProviderServer
+// implementation based on the passed Provider implementation, configured
+// with the framework behaviors of the passed ServeOpts. The Name option and
+// the process management of the Debug option are ignored, since they only
+// apply to Serve.
+//
+// The returned server dispatches requests directly to the Provider in the
+// same process, without go-plugin or gRPC, so it is suitable for unit tests
+// and fuzzing of the full request handling of a provider on any platform.
+// Each test should create its own server, since the server retains state,
+// such as memoized schemas and request contexts which are canceled by
+// StopProvider.
+func NewProtocol6ServerWithOptions(p Provider, opts ServeOpts) tfprotov6.ProviderServer {
+	return withRPCMiddleware(newServer(p, opts), opts.RPCMiddleware)
+}
+
 // Serve serves a provider, blocking until the context is canceled.
 func Serve(ctx context.Context, providerFunc func() Provider, opts ServeOpts) error {
 	var tf6serverOpts []tf6server.ServeOpt
@@ -53,23 +177,67 @@ func Serve(ctx context.Context, providerFunc func() Provider, opts ServeOpts) er
 	}
 
 	return tf6server.Serve(opts.Name, func() tfprotov6.ProviderServer {
-		return &server{
-			p: providerFunc(),
-		}
+		return withRPCMiddleware(newServer(providerFunc(), opts), opts.RPCMiddleware)
 	}, tf6serverOpts...)
 }
 
-func (s *server) registerContext(in context.Context) context.Context {
+// newServer returns a server for the Provider with the framework behaviors
+// of the ServeOpts.
+func newServer(p Provider, opts ServeOpts) *server {
+	return &server{
+		p:     p,
+		clock: opts.Clock,
+		rand:  opts.Rand,
+
+		validateCreatedID:          opts.ValidateCreatedID,
+		correlationIDInDiagnostics: opts.CorrelationIDInDiagnostics,
+		tracer:                     opts.Tracer,
+		metrics:                    opts.Metrics,
+		maxDynamicValueSize:        opts.MaxDynamicValueSize,
+		operationLimit:             newOperationLimit(opts.MaxConcurrentOperations),
+	}
+}
+
+// registerContext returns the context of a request, which is canceled by
+// StopProvider, and a function which must be called when the request is
+// complete to release it.
+func (s *server) registerContext(in context.Context) (context.Context, func()) {
 	ctx, cancel := context.WithCancel(in)
+	ctx = contextWithCorrelationID(ctx)
+	ctx = contextWithFrameworkLogger(ctx)
+	if s.clock != nil {
+		ctx = ContextWithClock(ctx, s.clock)
+	}
+	if s.rand != nil {
+		ctx = ContextWithRand(ctx, s.rand)
+	}
+	s.providerDataMu.RLock()
+	ctx = contextWithTerraformVersion(ctx, s.terraformVersion)
+	s.providerDataMu.RUnlock()
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
-	s.contextCancels = append(s.contextCancels, cancel)
-	return ctx
+	if s.stopped {
+		cancel()
+		return ctx, cancel
+	}
+	if s.contextCancels == nil {
+		s.contextCancels = make(map[uint64]context.CancelFunc)
+	}
+	key := s.contextCancelsNext
+	s.contextCancelsNext++
+	s.contextCancels[key] = cancel
+	return ctx, func() {
+		s.contextCancelsMu.Lock()
+		delete(s.contextCancels, key)
+		s.contextCancelsMu.Unlock()
+		cancel()
+	}
 }
 
 func (s *server) cancelRegisteredContexts(_ context.Context) {
 	s.contextCancelsMu.Lock()
 	defer s.contextCancelsMu.Unlock()
+	s.stopped = true
 	for _, cancel := range s.contextCancels {
 		cancel()
 	}
@@ -77,7 +245,7 @@ func (s *server) cancelRegisteredContexts(_ context.Context) {
 }
 
 func (s *server) getResourceType(ctx context.Context, typ string) (ResourceType, diag.Diagnostics) {
-	resourceTypes, diags := s.p.GetResources(ctx)
+	resourceTypes, diags := s.getResourceTypes(ctx)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -93,7 +261,7 @@ func (s *server) getResourceType(ctx context.Context, typ string) (ResourceType,
 }
 
 func (s *server) getDataSourceType(ctx context.Context, typ string) (DataSourceType, diag.Diagnostics) {
-	dataSourceTypes, diags := s.p.GetDataSources(ctx)
+	dataSourceTypes, diags := s.getDataSourceTypes(ctx)
 	if diags.HasError() {
 		return nil, diags
 	}
@@ -128,11 +296,19 @@ func (r getProviderSchemaResponse) toTfprotov6() *tfprotov6.GetProviderSchemaRes
 }
 
 func (s *server) GetProviderSchema(ctx context.Context, _ *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationGetProviderSchema})
+
 	resp := new(getProviderSchemaResponse)
 
 	s.getProviderSchema(ctx, resp)
 
+	resp.Diagnostics = s.onError(ctx, OperationGetProviderSchema, "", resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -143,6 +319,10 @@ func (s *server) getProviderSchema(ctx context.Context, resp *getProviderSchemaR
 	if diags.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(providerSchema.validateDefinition(ctx, "provider", reservedProviderAttributeNames)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	// convert the provider schema to a *tfprotov6.Schema
 	provider6Schema, err := providerSchema.tfprotov6Schema(ctx)
 	if err != nil {
@@ -168,6 +348,11 @@ func (s *server) getProviderSchema(ctx context.Context, resp *getProviderSchemaR
 			return
 		}
 
+		resp.Diagnostics.Append(providerMetaSchema.validateDefinition(ctx, "provider_meta", nil)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		pm6Schema, err := providerMetaSchema.tfprotov6Schema(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -180,18 +365,22 @@ func (s *server) getProviderSchema(ctx context.Context, resp *getProviderSchemaR
 	}
 
 	// get our resource schemas
-	resourceSchemas, diags := s.p.GetResources(ctx)
+	resourceSchemas, diags := s.getResourceTypes(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	resource6Schemas := map[string]*tfprotov6.Schema{}
 	for k, v := range resourceSchemas {
-		schema, diags := v.GetSchema(ctx)
+		schema, diags := s.getResourceSchema(ctx, k, v)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		resp.Diagnostics.Append(schema.validateDefinition(ctx, "resource \""+k+"\"", reservedResourceAttributeNames)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		schema6, err := schema.tfprotov6Schema(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -204,18 +393,22 @@ func (s *server) getProviderSchema(ctx context.Context, resp *getProviderSchemaR
 	}
 
 	// get our data source schemas
-	dataSourceSchemas, diags := s.p.GetDataSources(ctx)
+	dataSourceSchemas, diags := s.getDataSourceTypes(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	dataSource6Schemas := map[string]*tfprotov6.Schema{}
 	for k, v := range dataSourceSchemas {
-		schema, diags := v.GetSchema(ctx)
+		schema, diags := s.getDataSourceSchema(ctx, k, v)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
+		resp.Diagnostics.Append(schema.validateDefinition(ctx, "data source \""+k+"\"", reservedDataSourceAttributeNames)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		schema6, err := schema.tfprotov6Schema(ctx)
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -249,7 +442,10 @@ func (r validateProviderConfigResponse) toTfprotov6() *tfprotov6.ValidateProvide
 }
 
 func (s *server) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationValidateProviderConfig})
+
 	resp := &validateProviderConfigResponse{
 		// This RPC allows a modified configuration to be returned. This was
 		// previously used to allow a "required" provider attribute (as defined
@@ -264,6 +460,11 @@ func (s *server) ValidateProviderConfig(ctx context.Context, req *tfprotov6.Vali
 
 	s.validateProviderConfig(ctx, req, resp)
 
+	resp.Diagnostics = s.onError(ctx, OperationValidateProviderConfig, "", resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -342,15 +543,29 @@ func (r configureProviderResponse) toTfprotov6() *tfprotov6.ConfigureProviderRes
 }
 
 func (s *server) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationConfigureProvider})
+
 	resp := &configureProviderResponse{}
 
 	s.configureProvider(ctx, req, resp)
 
+	resp.Diagnostics = s.onError(ctx, OperationConfigureProvider, "", resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
 func (s *server) configureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest, resp *configureProviderResponse) {
+	s.providerDataMu.Lock()
+	s.terraformVersion = req.TerraformVersion
+	s.providerDataMu.Unlock()
+
+	ctx = contextWithTerraformVersion(ctx, req.TerraformVersion)
+
 	schema, diags := s.p.GetSchema(ctx)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -374,6 +589,67 @@ func (s *server) configureProvider(ctx context.Context, req *tfprotov6.Configure
 	res := &ConfigureProviderResponse{}
 	s.p.Configure(ctx, r, res)
 	resp.Diagnostics.Append(res.Diagnostics...)
+
+	s.providerDataMu.Lock()
+	s.dataSourceData = res.DataSourceData
+	s.resourceData = res.ResourceData
+	s.providerDataMu.Unlock()
+}
+
+// newResource creates a resource instance of the resource type, configuring
+// it if it implements ResourceWithConfigure.
+func (s *server) newResource(ctx context.Context, resourceType ResourceType) (Resource, diag.Diagnostics) {
+	resource, diags := resourceType.NewResource(ctx, s.p)
+
+	if diags.HasError() {
+		return resource, diags
+	}
+
+	resourceWithConfigure, ok := resource.(ResourceWithConfigure)
+
+	if !ok {
+		return resource, diags
+	}
+
+	s.providerDataMu.RLock()
+	req := ConfigureResourceRequest{
+		ProviderData: s.resourceData,
+	}
+	s.providerDataMu.RUnlock()
+
+	resp := &ConfigureResourceResponse{}
+	resourceWithConfigure.Configure(ctx, req, resp)
+	diags.Append(resp.Diagnostics...)
+
+	return resource, diags
+}
+
+// newDataSource creates a data source instance of the data source type,
+// configuring it if it implements DataSourceWithConfigure.
+func (s *server) newDataSource(ctx context.Context, dataSourceType DataSourceType) (DataSource, diag.Diagnostics) {
+	dataSource, diags := dataSourceType.NewDataSource(ctx, s.p)
+
+	if diags.HasError() {
+		return dataSource, diags
+	}
+
+	dataSourceWithConfigure, ok := dataSource.(DataSourceWithConfigure)
+
+	if !ok {
+		return dataSource, diags
+	}
+
+	s.providerDataMu.RLock()
+	req := ConfigureDataSourceRequest{
+		ProviderData: s.dataSourceData,
+	}
+	s.providerDataMu.RUnlock()
+
+	resp := &ConfigureDataSourceResponse{}
+	dataSourceWithConfigure.Configure(ctx, req, resp)
+	diags.Append(resp.Diagnostics...)
+
+	return dataSource, diags
 }
 
 func (s *server) StopProvider(ctx context.Context, _ *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
@@ -394,11 +670,19 @@ func (r validateResourceConfigResponse) toTfprotov6() *tfprotov6.ValidateResourc
 }
 
 func (s *server) ValidateResourceConfig(ctx context.Context, req *tfprotov6.ValidateResourceConfigRequest) (*tfprotov6.ValidateResourceConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationValidateResourceConfig, TypeName: req.TypeName})
+
 	resp := &validateResourceConfigResponse{}
 
 	s.validateResourceConfig(ctx, req, resp)
 
+	resp.Diagnostics = s.onError(ctx, OperationValidateResourceConfig, req.TypeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -414,7 +698,7 @@ func (s *server) validateResourceConfig(ctx context.Context, req *tfprotov6.Vali
 
 	// Get the schema from the resource type, so we can embed it in the
 	// config
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, resourceSchemaType, diags := s.getResourceSchemaWithType(ctx, req.TypeName, resourceType)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -423,14 +707,14 @@ func (s *server) validateResourceConfig(ctx context.Context, req *tfprotov6.Vali
 
 	// Create the resource instance, so we can call its methods and handle
 	// the request
-	resource, diags := resourceType.NewResource(ctx, s.p)
+	resource, diags := s.newResource(ctx, resourceType)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	config, err := req.Config.Unmarshal(resourceSchema.TerraformType(ctx))
+	config, err := req.Config.Unmarshal(resourceSchemaType)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -500,11 +784,34 @@ func (r upgradeResourceStateResponse) toTfprotov6() *tfprotov6.UpgradeResourceSt
 }
 
 func (s *server) UpgradeResourceState(ctx context.Context, req *tfprotov6.UpgradeResourceStateRequest) (*tfprotov6.UpgradeResourceStateResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+
+	var typeName string
+
+	if req != nil {
+		typeName = req.TypeName
+	}
+
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationUpgradeResourceState, TypeName: typeName})
+
 	resp := &upgradeResourceStateResponse{}
 
 	s.upgradeResourceState(ctx, req, resp)
 
+	if diags := s.checkDynamicValueSize(ctx, "upgraded state of the "+typeName+" resource", resp.UpgradedState); len(diags) > 0 {
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			resp.UpgradedState = nil
+		}
+	}
+
+	resp.Diagnostics = s.onError(ctx, OperationUpgradeResourceState, typeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -528,17 +835,54 @@ func (s *server) upgradeResourceState(ctx context.Context, req *tfprotov6.Upgrad
 		return
 	}
 
-	// This implementation assumes the current schema is the only valid schema
-	// for the given resource and will return an error if any mismatched prior
-	// state is given. This matches prior behavior of the framework, but is now
-	// more explicit in error handling, rather than just passing through any
-	// potentially errant prior state, which should have resulted in a similar
-	// error further in the resource lifecycle.
-	//
-	// TODO: Implement resource state upgrades, rather than just using the
-	//       current resource schema.
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/42
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, resourceSchemaType, diags := s.getResourceSchemaWithType(ctx, req.TypeName, resourceType)
+
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Terraform CLI can call UpgradeResourceState even if the stored state
+	// version matches the current schema. Presumably this is to account for
+	// the previous terraform-plugin-sdk implementation, which handled some
+	// state fixups on behalf of Terraform CLI. When this happens, the state
+	// data is decoded with the current schema and passed through.
+	if req.Version == resourceSchema.Version {
+		rawStateValue, err := req.RawState.Unmarshal(resourceSchemaType)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Previously Saved State for UpgradeResourceState",
+				"There was an error reading the saved resource state using the current resource schema.\n\n"+
+					"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
+					"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
+					"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		// NewDynamicValue will ensure the Msgpack field is set for Terraform CLI
+		// 0.12 through 0.14 compatibility when using terraform-plugin-mux tf6to5server.
+		// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/262
+		upgradedStateValue, err := tfprotov6.NewDynamicValue(resourceSchemaType, rawStateValue)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Convert Previously Saved State for UpgradeResourceState",
+				"There was an error converting the saved resource state using the current resource schema. "+
+					"This is always an issue in the Terraform Provider SDK used to implement the resource and should be reported to the provider developers.\n\n"+
+					"Please report this to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		resp.UpgradedState = &upgradedStateValue
+
+		return
+	}
+
+	resource, diags := s.newResource(ctx, resourceType)
 
 	resp.Diagnostics.Append(diags...)
 
@@ -546,33 +890,98 @@ func (s *server) upgradeResourceState(ctx context.Context, req *tfprotov6.Upgrad
 		return
 	}
 
-	resourceSchemaType := resourceSchema.TerraformType(ctx)
+	resourceWithUpgradeState, ok := resource.(ResourceWithUpgradeState)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource State",
+			"This resource was implemented without an UpgradeState() method, "+
+				fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
+
+	resourceStateUpgrader, ok := resourceWithUpgradeState.UpgradeState(ctx)[req.Version]
+
+	if !ok || resourceStateUpgrader.StateUpgrader == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Upgrade Resource State",
+			"This resource was implemented with an UpgradeState() method, "+
+				fmt.Sprintf("however Terraform was expecting an implementation for version %d upgrade.\n\n", req.Version)+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
+
+	upgradeReq := UpgradeResourceStateRequest{
+		RawState: req.RawState,
+	}
+
+	if resourceStateUpgrader.PriorSchema != nil {
+		rawStateValue, err := rawStateValue(req.RawState, resourceStateUpgrader.PriorSchema.TerraformType(ctx))
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Previously Saved State for UpgradeResourceState",
+				fmt.Sprintf("There was an error reading the saved resource state using the prior resource schema defined for version %d upgrade.\n\n", req.Version)+
+					"Please report this to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		upgradeReq.State = &State{
+			Raw:    rawStateValue,
+			Schema: *resourceStateUpgrader.PriorSchema,
+		}
+	}
+
+	upgradeResp := UpgradeResourceStateResponse{
+		State: State{
+			Raw:    tftypes.NewValue(resourceSchemaType, nil),
+			Schema: resourceSchema,
+		},
+	}
+
+	resourceStateUpgrader.StateUpgrader(ctx, upgradeReq, &upgradeResp)
+
+	resp.Diagnostics.Append(upgradeResp.Diagnostics...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if upgradeResp.DynamicValue != nil {
+		resp.UpgradedState = upgradeResp.DynamicValue
+		return
+	}
 
-	rawStateValue, err := req.RawState.Unmarshal(resourceSchemaType)
+	if upgradeResp.State.Raw.Type() == nil || upgradeResp.State.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			"Missing Upgraded Resource State",
+			fmt.Sprintf("After attempting a resource state upgrade to version %d, the provider did not return any state data. ", resourceSchema.Version)+
+				"Preventing the unexpected loss of resource state data. "+
+				"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+		)
+		return
+	}
 
+	upgradedStateRaw, err := encodeAttributeValues(ctx, resourceSchema, upgradeResp.State.Raw)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read Previously Saved State for UpgradeResourceState",
-			"There was an error reading the saved resource state using the current resource schema. "+
-				"This resource was implemented in a Terraform Provider SDK that does not support upgrading resource state yet.\n\n"+
-				"If the resource previously implemented different resource state versions, the provider developers will need to revert back to the previous implementation. "+
-				"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. "+
-				"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. "+
-				"Otherwise, please report this to the provider developer:\n\n"+err.Error(),
+			"Error encoding upgraded state",
+			"An unexpected error was encountered trying to encode the upgraded state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
 		return
 	}
 
-	// NewDynamicValue will ensure the Msgpack field is set for Terraform CLI
-	// 0.12 through 0.14 compatibility when using terraform-plugin-mux tf6to5server.
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/262
-	upgradedStateValue, err := tfprotov6.NewDynamicValue(resourceSchemaType, rawStateValue)
+	upgradedStateValue, err := tfprotov6.NewDynamicValue(resourceSchemaType, upgradedStateRaw)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Convert Previously Saved State for UpgradeResourceState",
-			"There was an error converting the saved resource state using the current resource schema. "+
-				"This is always an issue in the Terraform Provider SDK used to implement the resource and should be reported to the provider developers.\n\n"+
+			"Unable to Convert Upgraded Resource State",
+			"An unexpected error was encountered when converting the state returned by the resource state upgrade into a usable type. "+
+				"This is always an issue in the Terraform Provider and should be reported to the provider developer.\n\n"+
 				"Please report this to the provider developer:\n\n"+err.Error(),
 		)
 		return
@@ -597,11 +1006,27 @@ func (r readResourceResponse) toTfprotov6() *tfprotov6.ReadResourceResponse {
 }
 
 func (s *server) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationReadResource, TypeName: req.TypeName})
+
 	resp := &readResourceResponse{}
 
 	s.readResource(ctx, req, resp)
 
+	if diags := s.checkDynamicValueSize(ctx, "new state of the "+req.TypeName+" resource", resp.NewState); len(diags) > 0 {
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			resp.NewState = nil
+		}
+	}
+
+	resp.Diagnostics = s.onError(ctx, OperationReadResource, req.TypeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -611,17 +1036,17 @@ func (s *server) readResource(ctx context.Context, req *tfprotov6.ReadResourceRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, resourceSchemaType, diags := s.getResourceSchemaWithType(ctx, req.TypeName, resourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	resource, diags := resourceType.NewResource(ctx, s.p)
+	resource, diags := s.newResource(ctx, resourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	state, err := req.CurrentState.Unmarshal(resourceSchema.TerraformType(ctx))
+	state, err := req.CurrentState.Unmarshal(resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing current state",
@@ -629,11 +1054,21 @@ func (s *server) readResource(ctx context.Context, req *tfprotov6.ReadResourceRe
 		)
 		return
 	}
+
+	state, err = decodeAttributeValues(ctx, resourceSchema, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error decoding current state",
+			"An unexpected error was encountered trying to decode the current state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return
+	}
 	readReq := ReadResourceRequest{
 		State: State{
 			Raw:    state,
 			Schema: resourceSchema,
 		},
+		Private: newPrivateState(ctx, req.Private),
 	}
 	if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 		pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -663,14 +1098,45 @@ func (s *server) readResource(ctx context.Context, req *tfprotov6.ReadResourceRe
 			Raw:    state,
 			Schema: resourceSchema,
 		},
+		Private:     readReq.Private.clone(),
 		Diagnostics: resp.Diagnostics,
 	}
-	resource.Read(ctx, readReq, &readResp)
+	initialReadResp := readResp
+	readResp.Diagnostics = s.intercept(ctx, InterceptedMethodReadResource, req.TypeName, initialReadResp.Diagnostics, func(ctx context.Context) diag.Diagnostics {
+		readResp = initialReadResp
+		readResp.Private = initialReadResp.Private.clone()
+		readResp.Diagnostics = nil
+		resource.Read(ctx, readReq, &readResp)
+		return readResp.Diagnostics
+	})
 	resp.Diagnostics = readResp.Diagnostics
 	// don't return even if we have error diagnostics, we need to set the
 	// state on the response, first
 
-	newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), readResp.State.Raw)
+	// restore any PreserveState attribute values, which must not be
+	// refreshed, unless the resource was removed
+	if !state.IsNull() && !readResp.State.Raw.IsNull() {
+		preservedState, err := tftypes.Transform(readResp.State.Raw, preserveStateValues(ctx, state, resourceSchema))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error preserving state",
+				"An unexpected error was encountered when restoring preserved values in the read response. This is always a problem with the provider. Please give the following information to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+		readResp.State.Raw = preservedState
+	}
+
+	readResp.State.Raw, err = encodeAttributeValues(ctx, resourceSchema, readResp.State.Raw)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encoding read response state",
+			"An unexpected error was encountered trying to encode the read response state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return
+	}
+
+	newState, err := tfprotov6.NewDynamicValue(resourceSchemaType, readResp.State.Raw)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting read response",
@@ -679,6 +1145,9 @@ func (s *server) readResource(ctx context.Context, req *tfprotov6.ReadResourceRe
 		return
 	}
 	resp.NewState = &newState
+
+	resp.Private, diags = readResp.Private.bytes()
+	resp.Diagnostics.Append(diags...)
 }
 
 func markComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resourceSchema Schema) func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error) {
@@ -695,7 +1164,7 @@ func markComputedNilsAsUnknown(ctx context.Context, config tftypes.Value, resour
 			tfsdklog.Trace(ctx, "attribute not null in config, not marking unknown", "path", path)
 			return val, nil
 		}
-		attribute, err := resourceSchema.AttributeAtPath(path)
+		attribute, err := resourceSchema.attributeAtTerraformPath(path)
 		if err != nil {
 			if errors.Is(err, ErrPathInsideAtomicAttribute) {
 				// ignore attributes/elements inside schema.Attributes, they have no schema of their own
@@ -732,11 +1201,27 @@ func (r planResourceChangeResponse) toTfprotov6() *tfprotov6.PlanResourceChangeR
 }
 
 func (s *server) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationPlanResourceChange, TypeName: req.TypeName})
+
 	resp := &planResourceChangeResponse{}
 
 	s.planResourceChange(ctx, req, resp)
 
+	if diags := s.checkDynamicValueSize(ctx, "planned state of the "+req.TypeName+" resource", resp.PlannedState); len(diags) > 0 {
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			resp.PlannedState = nil
+		}
+	}
+
+	resp.Diagnostics = s.onError(ctx, OperationPlanResourceChange, req.TypeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -751,13 +1236,13 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 
 	// get the schema from the resource type, so we can embed it in the
 	// config and plan
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, resourceSchemaType, diags := s.getResourceSchemaWithType(ctx, req.TypeName, resourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	config, err := req.Config.Unmarshal(resourceSchema.TerraformType(ctx))
+	config, err := req.Config.Unmarshal(resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing configuration",
@@ -766,7 +1251,7 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		return
 	}
 
-	plan, err := req.ProposedNewState.Unmarshal(resourceSchema.TerraformType(ctx))
+	plan, err := req.ProposedNewState.Unmarshal(resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing plan",
@@ -775,7 +1260,16 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		return
 	}
 
-	state, err := req.PriorState.Unmarshal(resourceSchema.TerraformType(ctx))
+	plan, err = decodeAttributeValues(ctx, resourceSchema, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error decoding plan",
+			"An unexpected error was encountered trying to decode the plan attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return
+	}
+
+	state, err := req.PriorState.Unmarshal(resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing prior state",
@@ -784,11 +1278,24 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		return
 	}
 
+	state, err = decodeAttributeValues(ctx, resourceSchema, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error decoding prior state",
+			"An unexpected error was encountered trying to decode the prior state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return
+	}
+
 	resp.PlannedState = req.ProposedNewState
 
+	// Private state is passed through unless modified by a resource-level
+	// ModifyPlan method.
+	resp.PlannedPrivate = req.PriorPrivate
+
 	// create the resource instance, so we can call its methods and handle
 	// the request
-	resource, diags := resourceType.NewResource(ctx, s.p)
+	resource, diags := s.newResource(ctx, resourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -860,12 +1367,31 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		plan = modifiedPlan
 	}
 
+	// Use the prior state values of any PreserveState attributes when
+	// updating, so they are never diffed. This happens after marking
+	// computed attributes as unknown, so those values are not marked.
+	if !plan.IsNull() && !state.IsNull() {
+		modifiedPlan, err := tftypes.Transform(plan, preserveStateValues(ctx, state, resourceSchema))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error modifying plan",
+				"There was an unexpected error updating the plan. This is always a problem with the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+		plan = modifiedPlan
+	}
+
 	// Execute any AttributePlanModifiers again. This allows overwriting
 	// any unknown values.
 	//
 	// We only do this if there's a plan to modify; otherwise, it
 	// represents a resource being deleted and there's no point.
 	if !plan.IsNull() {
+		s.providerDataMu.RLock()
+		providerData := s.resourceData
+		s.providerDataMu.RUnlock()
+
 		modifySchemaPlanReq := ModifySchemaPlanRequest{
 			Config: Config{
 				Schema: resourceSchema,
@@ -879,6 +1405,7 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 				Schema: resourceSchema,
 				Raw:    plan,
 			},
+			ProviderData: providerData,
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -915,7 +1442,7 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		}
 
 		resourceSchema.modifyPlan(ctx, modifySchemaPlanReq, &modifySchemaPlanResp)
-		resp.RequiresReplace = append(resp.RequiresReplace, modifySchemaPlanResp.RequiresReplace...)
+		resp.RequiresReplace = append(resp.RequiresReplace, totftypes.AttributePaths(modifySchemaPlanResp.RequiresReplace)...)
 		plan = modifySchemaPlanResp.Plan.Raw
 		resp.Diagnostics = modifySchemaPlanResp.Diagnostics
 		if resp.Diagnostics.HasError() {
@@ -946,6 +1473,7 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 				Schema: resourceSchema,
 				Raw:    plan,
 			},
+			Private: newPrivateState(ctx, req.PriorPrivate),
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -976,12 +1504,25 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 				Schema: resourceSchema,
 				Raw:    plan,
 			},
-			RequiresReplace: []*tftypes.AttributePath{},
+			RequiresReplace: path.Paths{},
+			Private:         modifyPlanReq.Private.clone(),
 			Diagnostics:     resp.Diagnostics,
 		}
 		resource.ModifyPlan(ctx, modifyPlanReq, &modifyPlanResp)
 		resp.Diagnostics = modifyPlanResp.Diagnostics
 		plan = modifyPlanResp.Plan.Raw
+
+		resp.PlannedPrivate, diags = modifyPlanResp.Private.bytes()
+		resp.Diagnostics.Append(diags...)
+	}
+
+	plan, err = encodeAttributeValues(ctx, resourceSchema, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error encoding plan",
+			"An unexpected error was encountered trying to encode the plan attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return
 	}
 
 	plannedState, err := tfprotov6.NewDynamicValue(plan.Type(), plan)
@@ -993,7 +1534,7 @@ func (s *server) planResourceChange(ctx context.Context, req *tfprotov6.PlanReso
 		return
 	}
 	resp.PlannedState = &plannedState
-	resp.RequiresReplace = append(resp.RequiresReplace, modifyPlanResp.RequiresReplace...)
+	resp.RequiresReplace = append(resp.RequiresReplace, totftypes.AttributePaths(modifyPlanResp.RequiresReplace)...)
 
 	// ensure deterministic RequiresReplace by sorting and deduplicating
 	resp.RequiresReplace = normaliseRequiresReplace(ctx, resp.RequiresReplace)
@@ -1043,7 +1584,10 @@ func normaliseRequiresReplace(ctx context.Context, rs []*tftypes.AttributePath)
 }
 
 func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationApplyResourceChange, TypeName: req.TypeName})
+
 	resp := &applyResourceChangeResponse{
 		// default to the prior state, so the state won't change unless
 		// we choose to change it
@@ -1052,6 +1596,21 @@ func (s *server) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 
 	s.applyResourceChange(ctx, req, resp)
 
+	if diags := s.checkDynamicValueSize(ctx, "new state of the "+req.TypeName+" resource", resp.NewState); len(diags) > 0 {
+		resp.Diagnostics.Append(diags...)
+
+		// The prior state was received from Terraform, so it is always
+		// small enough to return.
+		if diags.HasError() {
+			resp.NewState = req.PriorState
+		}
+	}
+
+	resp.Diagnostics = s.onError(ctx, OperationApplyResourceChange, req.TypeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -1066,7 +1625,7 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 
 	// get the schema from the resource type, so we can embed it in the
 	// config and plan
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, resourceSchemaType, diags := s.getResourceSchemaWithType(ctx, req.TypeName, resourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -1074,13 +1633,13 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 
 	// create the resource instance, so we can call its methods and handle
 	// the request
-	resource, diags := resourceType.NewResource(ctx, s.p)
+	resource, diags := s.newResource(ctx, resourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	config, err := req.Config.Unmarshal(resourceSchema.TerraformType(ctx))
+	config, err := req.Config.Unmarshal(resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing configuration",
@@ -1089,7 +1648,7 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		return
 	}
 
-	plan, err := req.PlannedState.Unmarshal(resourceSchema.TerraformType(ctx))
+	plan, err := req.PlannedState.Unmarshal(resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing plan",
@@ -1098,7 +1657,16 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		return
 	}
 
-	priorState, err := req.PriorState.Unmarshal(resourceSchema.TerraformType(ctx))
+	plan, err = decodeAttributeValues(ctx, resourceSchema, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error decoding plan",
+			"An unexpected error was encountered trying to decode the plan attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return
+	}
+
+	priorState, err := req.PriorState.Unmarshal(resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing prior state",
@@ -1107,8 +1675,17 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		return
 	}
 
+	priorState, err = decodeAttributeValues(ctx, resourceSchema, priorState)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error decoding prior state",
+			"An unexpected error was encountered trying to decode the prior state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return
+	}
+
 	// figure out what kind of request we're serving
-	create, err := proto6.IsCreate(ctx, req, resourceSchema.TerraformType(ctx))
+	create, err := proto6.IsCreate(ctx, req, resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error understanding request",
@@ -1116,7 +1693,7 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		)
 		return
 	}
-	update, err := proto6.IsUpdate(ctx, req, resourceSchema.TerraformType(ctx))
+	update, err := proto6.IsUpdate(ctx, req, resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error understanding request",
@@ -1124,7 +1701,7 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 		)
 		return
 	}
-	destroy, err := proto6.IsDestroy(ctx, req, resourceSchema.TerraformType(ctx))
+	destroy, err := proto6.IsDestroy(ctx, req, resourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error understanding request",
@@ -1145,6 +1722,7 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 				Schema: resourceSchema,
 				Raw:    plan,
 			},
+			Private: newPrivateState(ctx, req.PlannedPrivate),
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -1174,11 +1752,47 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 				Schema: resourceSchema,
 				Raw:    priorState,
 			},
+			Private:     createReq.Private.clone(),
 			Diagnostics: resp.Diagnostics,
 		}
-		resource.Create(ctx, createReq, &createResp)
+		initialCreateResp := createResp
+		createResp.Diagnostics = s.intercept(ctx, InterceptedMethodCreateResource, req.TypeName, initialCreateResp.Diagnostics, func(ctx context.Context) diag.Diagnostics {
+			createResp = initialCreateResp
+			createResp.Private = initialCreateResp.Private.clone()
+			createResp.Diagnostics = nil
+			resource.Create(ctx, createReq, &createResp)
+			return createResp.Diagnostics
+		})
 		resp.Diagnostics = createResp.Diagnostics
-		newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), createResp.State.Raw)
+		if s.validateCreatedID && !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(validateCreatedID(ctx, createResp.State)...)
+		}
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(checkApplyConsistency(ctx, resource, req.TypeName, plan, createResp.State.Raw)...)
+		}
+		// Post-apply hooks only observe new state that Terraform accepts.
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(postApply(ctx, resource, PostApplyRequest{
+				Operation: PostApplyOperationCreate,
+				TypeName:  req.TypeName,
+				PriorState: State{
+					Schema: resourceSchema,
+					Raw:    priorState,
+				},
+				State:   createResp.State,
+				Private: createResp.Private.clone(),
+			})...)
+		}
+		createResp.State.Raw, err = encodeAttributeValues(ctx, resourceSchema, createResp.State.Raw)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error encoding create response state",
+				"An unexpected error was encountered trying to encode the create response state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		newState, err := tfprotov6.NewDynamicValue(resourceSchemaType, createResp.State.Raw)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error converting create response",
@@ -1187,6 +1801,9 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 			return
 		}
 		resp.NewState = &newState
+
+		resp.Private, diags = createResp.Private.bytes()
+		resp.Diagnostics.Append(diags...)
 	case !create && update && !destroy:
 		tfsdklog.Trace(ctx, "running update")
 		updateReq := UpdateResourceRequest{
@@ -1202,6 +1819,7 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 				Schema: resourceSchema,
 				Raw:    priorState,
 			},
+			Private: newPrivateState(ctx, req.PlannedPrivate),
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -1231,11 +1849,40 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 				Schema: resourceSchema,
 				Raw:    priorState,
 			},
+			Private:     updateReq.Private.clone(),
 			Diagnostics: resp.Diagnostics,
 		}
-		resource.Update(ctx, updateReq, &updateResp)
+		initialUpdateResp := updateResp
+		updateResp.Diagnostics = s.intercept(ctx, InterceptedMethodUpdateResource, req.TypeName, initialUpdateResp.Diagnostics, func(ctx context.Context) diag.Diagnostics {
+			updateResp = initialUpdateResp
+			updateResp.Private = initialUpdateResp.Private.clone()
+			updateResp.Diagnostics = nil
+			resource.Update(ctx, updateReq, &updateResp)
+			return updateResp.Diagnostics
+		})
 		resp.Diagnostics = updateResp.Diagnostics
-		newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), updateResp.State.Raw)
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(checkApplyConsistency(ctx, resource, req.TypeName, plan, updateResp.State.Raw)...)
+		}
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(postApply(ctx, resource, PostApplyRequest{
+				Operation:  PostApplyOperationUpdate,
+				TypeName:   req.TypeName,
+				PriorState: updateReq.State,
+				State:      updateResp.State,
+				Private:    updateResp.Private.clone(),
+			})...)
+		}
+		updateResp.State.Raw, err = encodeAttributeValues(ctx, resourceSchema, updateResp.State.Raw)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error encoding update response state",
+				"An unexpected error was encountered trying to encode the update response state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		newState, err := tfprotov6.NewDynamicValue(resourceSchemaType, updateResp.State.Raw)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error converting update response",
@@ -1244,6 +1891,9 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 			return
 		}
 		resp.NewState = &newState
+
+		resp.Private, diags = updateResp.Private.bytes()
+		resp.Diagnostics.Append(diags...)
 	case !create && !update && destroy:
 		tfsdklog.Trace(ctx, "running delete")
 		destroyReq := DeleteResourceRequest{
@@ -1251,6 +1901,7 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 				Schema: resourceSchema,
 				Raw:    priorState,
 			},
+			Private: newPrivateState(ctx, req.PlannedPrivate),
 		}
 		if pm, ok := s.p.(ProviderWithProviderMeta); ok {
 			pmSchema, diags := pm.GetMetaSchema(ctx)
@@ -1282,9 +1933,32 @@ func (s *server) applyResourceChange(ctx context.Context, req *tfprotov6.ApplyRe
 			},
 			Diagnostics: resp.Diagnostics,
 		}
-		resource.Delete(ctx, destroyReq, &destroyResp)
+		initialDestroyResp := destroyResp
+		destroyResp.Diagnostics = s.intercept(ctx, InterceptedMethodDeleteResource, req.TypeName, initialDestroyResp.Diagnostics, func(ctx context.Context) diag.Diagnostics {
+			destroyResp = initialDestroyResp
+			destroyResp.Diagnostics = nil
+			resource.Delete(ctx, destroyReq, &destroyResp)
+			return destroyResp.Diagnostics
+		})
 		resp.Diagnostics = destroyResp.Diagnostics
-		newState, err := tfprotov6.NewDynamicValue(resourceSchema.TerraformType(ctx), destroyResp.State.Raw)
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(postApply(ctx, resource, PostApplyRequest{
+				Operation:  PostApplyOperationDelete,
+				TypeName:   req.TypeName,
+				PriorState: destroyReq.State,
+				State:      destroyResp.State,
+			})...)
+		}
+		destroyResp.State.Raw, err = encodeAttributeValues(ctx, resourceSchema, destroyResp.State.Raw)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error encoding destroy response state",
+				"An unexpected error was encountered trying to encode the destroy response state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+			return
+		}
+
+		newState, err := tfprotov6.NewDynamicValue(resourceSchemaType, destroyResp.State.Raw)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error converting delete response",
@@ -1313,11 +1987,19 @@ func (r validateDataResourceConfigResponse) toTfprotov6() *tfprotov6.ValidateDat
 }
 
 func (s *server) ValidateDataResourceConfig(ctx context.Context, req *tfprotov6.ValidateDataResourceConfigRequest) (*tfprotov6.ValidateDataResourceConfigResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationValidateDataResourceConfig, TypeName: req.TypeName})
+
 	resp := &validateDataResourceConfigResponse{}
 
 	s.validateDataResourceConfig(ctx, req, resp)
 
+	resp.Diagnostics = s.onError(ctx, OperationValidateDataResourceConfig, req.TypeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -1334,7 +2016,7 @@ func (s *server) validateDataResourceConfig(ctx context.Context, req *tfprotov6.
 
 	// Get the schema from the data source type, so we can embed it in the
 	// config
-	dataSourceSchema, diags := dataSourceType.GetSchema(ctx)
+	dataSourceSchema, dataSourceSchemaType, diags := s.getDataSourceSchemaWithType(ctx, req.TypeName, dataSourceType)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -1343,14 +2025,14 @@ func (s *server) validateDataResourceConfig(ctx context.Context, req *tfprotov6.
 
 	// Create the data source instance, so we can call its methods and handle
 	// the request
-	dataSource, diags := dataSourceType.NewDataSource(ctx, s.p)
+	dataSource, diags := s.newDataSource(ctx, dataSourceType)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	config, err := req.Config.Unmarshal(dataSourceSchema.TerraformType(ctx))
+	config, err := req.Config.Unmarshal(dataSourceSchemaType)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -1419,11 +2101,27 @@ func (r readDataSourceResponse) toTfprotov6() *tfprotov6.ReadDataSourceResponse
 }
 
 func (s *server) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationReadDataSource, TypeName: req.TypeName})
+
 	resp := &readDataSourceResponse{}
 
 	s.readDataSource(ctx, req, resp)
 
+	if diags := s.checkDynamicValueSize(ctx, "state of the "+req.TypeName+" data source", resp.State); len(diags) > 0 {
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			resp.State = nil
+		}
+	}
+
+	resp.Diagnostics = s.onError(ctx, OperationReadDataSource, req.TypeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
 	return resp.toTfprotov6(), nil
 }
 
@@ -1433,17 +2131,17 @@ func (s *server) readDataSource(ctx context.Context, req *tfprotov6.ReadDataSour
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	dataSourceSchema, diags := dataSourceType.GetSchema(ctx)
+	dataSourceSchema, dataSourceSchemaType, diags := s.getDataSourceSchemaWithType(ctx, req.TypeName, dataSourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	dataSource, diags := dataSourceType.NewDataSource(ctx, s.p)
+	dataSource, diags := s.newDataSource(ctx, dataSourceType)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	config, err := req.Config.Unmarshal(dataSourceSchema.TerraformType(ctx))
+	config, err := req.Config.Unmarshal(dataSourceSchemaType)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing current state",
@@ -1490,12 +2188,18 @@ func (s *server) readDataSource(ctx context.Context, req *tfprotov6.ReadDataSour
 		},
 		Diagnostics: resp.Diagnostics,
 	}
-	dataSource.Read(ctx, readReq, &readResp)
+	initialReadResp := readResp
+	readResp.Diagnostics = s.intercept(ctx, InterceptedMethodReadDataSource, req.TypeName, initialReadResp.Diagnostics, func(ctx context.Context) diag.Diagnostics {
+		readResp = initialReadResp
+		readResp.Diagnostics = nil
+		dataSource.Read(ctx, readReq, &readResp)
+		return readResp.Diagnostics
+	})
 	resp.Diagnostics = readResp.Diagnostics
 	// don't return even if we have error diagnostics, we need to set the
 	// state on the response, first
 
-	state, err := tfprotov6.NewDynamicValue(dataSourceSchema.TerraformType(ctx), readResp.State.Raw)
+	state, err := tfprotov6.NewDynamicValue(dataSourceSchemaType, readResp.State.Raw)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error converting read response",
@@ -0,0 +1,149 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testServeProviderWithConfigureData struct {
+	*testServeProvider
+}
+
+func (p testServeProviderWithConfigureData) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	return Schema{}, nil
+}
+
+func (p testServeProviderWithConfigureData) Configure(_ context.Context, _ ConfigureProviderRequest, resp *ConfigureProviderResponse) {
+	resp.DataSourceData = "test data source data"
+	resp.ResourceData = "test resource data"
+}
+
+type testServeResourceTypeConfigure struct {
+	diags diag.Diagnostics
+}
+
+func (rt testServeResourceTypeConfigure) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	return Schema{}, nil
+}
+
+func (rt testServeResourceTypeConfigure) NewResource(_ context.Context, _ Provider) (Resource, diag.Diagnostics) {
+	return &testServeResourceConfigure{
+		diags: rt.diags,
+	}, nil
+}
+
+type testServeResourceConfigure struct {
+	Resource
+
+	diags        diag.Diagnostics
+	providerData interface{}
+}
+
+func (r *testServeResourceConfigure) Configure(_ context.Context, req ConfigureResourceRequest, resp *ConfigureResourceResponse) {
+	r.providerData = req.ProviderData
+	resp.Diagnostics.Append(r.diags...)
+}
+
+type testServeDataSourceTypeConfigure struct{}
+
+func (dt testServeDataSourceTypeConfigure) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	return Schema{}, nil
+}
+
+func (dt testServeDataSourceTypeConfigure) NewDataSource(_ context.Context, _ Provider) (DataSource, diag.Diagnostics) {
+	return &testServeDataSourceConfigure{}, nil
+}
+
+type testServeDataSourceConfigure struct {
+	DataSource
+
+	providerData interface{}
+}
+
+func (d *testServeDataSourceConfigure) Configure(_ context.Context, req ConfigureDataSourceRequest, _ *ConfigureDataSourceResponse) {
+	d.providerData = req.ProviderData
+}
+
+func TestServerNewResource(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		configure            bool
+		diags                diag.Diagnostics
+		expectedProviderData interface{}
+		expectedDiags        diag.Diagnostics
+	}{
+		"unconfigured": {},
+		"configured": {
+			configure:            true,
+			expectedProviderData: "test resource data",
+		},
+		"diags": {
+			configure: true,
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expectedProviderData: "test resource data",
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			testServer := &server{
+				p: testServeProviderWithConfigureData{&testServeProvider{}},
+			}
+
+			if tc.configure {
+				config, err := tfprotov6.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				resp := &configureProviderResponse{}
+				testServer.configureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &config}, resp)
+
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected configure diagnostics: %v", resp.Diagnostics)
+				}
+			}
+
+			resource, diags := testServer.newResource(ctx, testServeResourceTypeConfigure{diags: tc.diags})
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+
+			got := resource.(*testServeResourceConfigure).providerData
+
+			if diff := cmp.Diff(got, tc.expectedProviderData); diff != "" {
+				t.Errorf("unexpected provider data difference: %s", diff)
+			}
+
+			dataSource, diags := testServer.newDataSource(ctx, testServeDataSourceTypeConfigure{})
+
+			if diags.HasError() {
+				t.Fatalf("unexpected data source diagnostics: %v", diags)
+			}
+
+			if tc.configure {
+				if diff := cmp.Diff(dataSource.(*testServeDataSourceConfigure).providerData, "test data source data"); diff != "" {
+					t.Errorf("unexpected data source provider data difference: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -25,7 +25,17 @@ func (r importedResource) toTfprotov6(ctx context.Context) (*tfprotov6.ImportedR
 		TypeName: r.TypeName,
 	}
 
-	stateProto6, err := tfprotov6.NewDynamicValue(r.State.Schema.TerraformType(ctx), r.State.Raw)
+	stateRaw, err := encodeAttributeValues(ctx, r.State.Schema, r.State.Raw)
+
+	if err != nil {
+		diags.AddError(
+			"Error encoding imported resource state",
+			"An unexpected error was encountered trying to encode the imported resource state attribute values. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return nil, diags
+	}
+
+	stateProto6, err := tfprotov6.NewDynamicValue(r.State.Schema.TerraformType(ctx), stateRaw)
 
 	if err != nil {
 		diags.AddError(
@@ -43,24 +53,14 @@ func (r importedResource) toTfprotov6(ctx context.Context) (*tfprotov6.ImportedR
 // importResourceStateResponse is a thin abstraction to allow native Diagnostics usage
 type importResourceStateResponse struct {
 	Diagnostics       diag.Diagnostics
-	ImportedResources []importedResource
+	ImportedResources []*tfprotov6.ImportedResource
 }
 
-func (r importResourceStateResponse) toTfprotov6(ctx context.Context) *tfprotov6.ImportResourceStateResponse {
-	resp := &tfprotov6.ImportResourceStateResponse{
-		Diagnostics: r.Diagnostics.ToTfprotov6Diagnostics(),
-	}
-
-	for _, ir := range r.ImportedResources {
-		irProto6, diags := ir.toTfprotov6(ctx)
-		resp.Diagnostics = append(resp.Diagnostics, diags.ToTfprotov6Diagnostics()...)
-		if diags.HasError() {
-			continue
-		}
-		resp.ImportedResources = append(resp.ImportedResources, irProto6)
+func (r importResourceStateResponse) toTfprotov6() *tfprotov6.ImportResourceStateResponse {
+	return &tfprotov6.ImportResourceStateResponse{
+		Diagnostics:       r.Diagnostics.ToTfprotov6Diagnostics(),
+		ImportedResources: r.ImportedResources,
 	}
-
-	return resp
 }
 
 func (s *server) importResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest, resp *importResourceStateResponse) {
@@ -71,21 +71,21 @@ func (s *server) importResourceState(ctx context.Context, req *tfprotov6.ImportR
 		return
 	}
 
-	resourceSchema, diags := resourceType.GetSchema(ctx)
+	resourceSchema, resourceSchemaType, diags := s.getResourceSchemaWithType(ctx, req.TypeName, resourceType)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resource, diags := resourceType.NewResource(ctx, s.p)
+	resource, diags := s.newResource(ctx, resourceType)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	emptyState := tftypes.NewValue(resourceSchema.TerraformType(ctx), nil)
+	emptyState := tftypes.NewValue(resourceSchemaType, nil)
 	importReq := ImportResourceStateRequest{
 		ID: req.ID,
 	}
@@ -112,20 +112,58 @@ func (s *server) importResourceState(ctx context.Context, req *tfprotov6.ImportR
 		return
 	}
 
-	resp.ImportedResources = []importedResource{
-		{
-			State:    importResp.State,
-			TypeName: req.TypeName,
-		},
+	private, diags := importResp.Private.bytes()
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	importedResource := importedResource{
+		Private:  private,
+		State:    importResp.State,
+		TypeName: req.TypeName,
 	}
+
+	irProto6, diags := importedResource.toTfprotov6(ctx)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.ImportedResources = []*tfprotov6.ImportedResource{irProto6}
 }
 
 // ImportResourceState satisfies the tfprotov6.ProviderServer interface.
 func (s *server) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
-	ctx = s.registerContext(ctx)
+	ctx, done := s.registerContext(ctx)
+	defer done()
+	ctx, endSpan := s.startSpan(ctx, TraceSpan{Operation: OperationImportResourceState, TypeName: req.TypeName})
+
 	resp := &importResourceStateResponse{}
 
 	s.importResourceState(ctx, req, resp)
 
-	return resp.toTfprotov6(ctx), nil
+	var importedResources []*tfprotov6.ImportedResource
+
+	for _, importedResource := range resp.ImportedResources {
+		diags := s.checkDynamicValueSize(ctx, "imported state of the "+importedResource.TypeName+" resource", importedResource.State)
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			continue
+		}
+
+		importedResources = append(importedResources, importedResource)
+	}
+
+	resp.ImportedResources = importedResources
+
+	resp.Diagnostics = s.onError(ctx, OperationImportResourceState, req.TypeName, resp.Diagnostics)
+	resp.Diagnostics = s.correlateDiagnostics(ctx, resp.Diagnostics)
+
+	endSpan(resp.Diagnostics)
+
+	return resp.toTfprotov6(), nil
 }
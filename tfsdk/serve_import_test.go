@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -67,7 +68,7 @@ func TestServerImportResourceState(t *testing.T) {
 			},
 
 			impl: func(ctx context.Context, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
-				ResourceImportStatePassthroughID(ctx, tftypes.NewAttributePath().WithAttributeName("id"), req, resp)
+				ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 			},
 
 			resp: &tfprotov6.ImportResourceStateResponse{
@@ -95,6 +96,43 @@ func TestServerImportResourceState(t *testing.T) {
 				},
 			},
 		},
+		"Private": {
+			req: &tfprotov6.ImportResourceStateRequest{
+				ID:       "test",
+				TypeName: "test_import_state",
+			},
+
+			impl: func(ctx context.Context, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "etag", []byte("1"))...)
+				ResourceImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+			},
+
+			resp: &tfprotov6.ImportResourceStateResponse{
+				ImportedResources: []*tfprotov6.ImportedResource{
+					{
+						Private: []byte(`{"etag":"MQ=="}`),
+						State: func() *tfprotov6.DynamicValue {
+							val, err := tfprotov6.NewDynamicValue(
+								testServeResourceTypeImportStateTftype,
+								tftypes.NewValue(
+									testServeResourceTypeImportStateTftype,
+									map[string]tftypes.Value{
+										"id":              tftypes.NewValue(tftypes.String, "test"),
+										"optional_string": tftypes.NewValue(tftypes.String, nil),
+										"required_string": tftypes.NewValue(tftypes.String, nil),
+									},
+								),
+							)
+							if err != nil {
+								panic(err)
+							}
+							return &val
+						}(),
+						TypeName: "test_import_state",
+					},
+				},
+			},
+		},
 		"ResourceImportStateNotImplemented": {
 			req: &tfprotov6.ImportResourceStateRequest{
 				ID:       "test",
@@ -23,11 +23,6 @@ type testServeProvider struct {
 	validateResourceConfigCalledResourceType string
 	validateResourceConfigImpl               func(context.Context, ValidateResourceConfigRequest, *ValidateResourceConfigResponse)
 
-	// upgrade resource state
-	// TODO: Implement with UpgradeResourceState support
-	// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/42
-	// upgradeResourceStateCalledResourceType string
-
 	// read resource request
 	readResourceCurrentStateValue  tftypes.Value
 	readResourceCurrentStateSchema Schema
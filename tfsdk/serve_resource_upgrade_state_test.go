@@ -2,6 +2,7 @@ package tfsdk
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -10,10 +11,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-// This resource is a placeholder for UpgradeResourceState testing,
-// so it is decoupled from other test resources.
-// TODO: Implement UpgradeResourceState support, when added
-// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/42
+// This resource is for UpgradeResourceState testing, so it is decoupled
+// from other test resources.
 type testServeResourceTypeUpgradeState struct{}
 
 func (t testServeResourceTypeUpgradeState) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
@@ -32,6 +31,7 @@ func (t testServeResourceTypeUpgradeState) GetSchema(_ context.Context) (Schema,
 				Required: true,
 			},
 		},
+		Version: 4,
 	}, nil
 }
 
@@ -69,21 +69,14 @@ var testServeResourceTypeUpgradeStateSchema = &tfprotov6.Schema{
 			},
 		},
 	},
+	Version: 4,
 }
 
-// var testServeResourceTypeUpgradeStateTftype = tftypes.Object{
-// 	AttributeTypes: map[string]tftypes.Type{
-// 		"id":              tftypes.String,
-// 		"optional_string": tftypes.String,
-// 		"required_string": tftypes.String,
-// 	},
-// }
-
-// type testServeResourceUpgradeStateData struct {
-// 	Id             string  `tfsdk:"id"`
-// 	OptionalString *string `tfsdk:"optional_string"`
-// 	RequiredString string  `tfsdk:"required_string"`
-// }
+type testServeResourceUpgradeStateData struct {
+	Id             string  `tfsdk:"id"`
+	OptionalString *string `tfsdk:"optional_string"`
+	RequiredString string  `tfsdk:"required_string"`
+}
 
 type testServeResourceUpgradeState struct {
 	provider *testServeProvider
@@ -104,3 +97,95 @@ func (r testServeResourceUpgradeState) Delete(ctx context.Context, req DeleteRes
 func (r testServeResourceUpgradeState) ImportState(ctx context.Context, req ImportResourceStateRequest, resp *ImportResourceStateResponse) {
 	ResourceImportStateNotImplemented(ctx, "intentionally not implemented", resp)
 }
+
+func (r testServeResourceUpgradeState) UpgradeState(ctx context.Context) map[int64]ResourceStateUpgrader {
+	return map[int64]ResourceStateUpgrader{
+		// Prior schema with a number attribute, which was changed to a
+		// string and is decoded into State.
+		0: {
+			PriorSchema: &Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.Int64Type,
+						Computed: true,
+					},
+					"optional_string": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+					"required_string": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+			},
+			StateUpgrader: func(ctx context.Context, req UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
+				var priorStateData struct {
+					Id             int64   `tfsdk:"id"`
+					OptionalString *string `tfsdk:"optional_string"`
+					RequiredString string  `tfsdk:"required_string"`
+				}
+
+				resp.Diagnostics.Append(req.State.Get(ctx, &priorStateData)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgradedStateData := testServeResourceUpgradeStateData{
+					Id:             fmt.Sprintf("%d", priorStateData.Id),
+					OptionalString: priorStateData.OptionalString,
+					RequiredString: priorStateData.RequiredString,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgradedStateData)...)
+			},
+		},
+		// Raw state data, which is decoded by the implementation and returned
+		// as a DynamicValue.
+		1: {
+			StateUpgrader: func(ctx context.Context, req UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {
+				var rawState struct {
+					Id             string `json:"id"`
+					RequiredString string `json:"required_string"`
+				}
+
+				if err := json.Unmarshal(req.RawState.JSON, &rawState); err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Unmarshal Prior State",
+						err.Error(),
+					)
+					return
+				}
+
+				schemaType := tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"id":              tftypes.String,
+						"optional_string": tftypes.String,
+						"required_string": tftypes.String,
+					},
+				}
+
+				dynamicValue, err := tfprotov6.NewDynamicValue(schemaType, tftypes.NewValue(schemaType, map[string]tftypes.Value{
+					"id":              tftypes.NewValue(tftypes.String, rawState.Id),
+					"optional_string": tftypes.NewValue(tftypes.String, nil),
+					"required_string": tftypes.NewValue(tftypes.String, rawState.RequiredString),
+				}))
+
+				if err != nil {
+					resp.Diagnostics.AddError(
+						"Unable to Create Upgraded State",
+						err.Error(),
+					)
+					return
+				}
+
+				resp.DynamicValue = &dynamicValue
+			},
+		},
+		// Returns no state data.
+		2: {
+			StateUpgrader: func(ctx context.Context, req UpgradeResourceStateRequest, resp *UpgradeResourceStateResponse) {},
+		},
+	}
+}
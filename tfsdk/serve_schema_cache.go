@@ -0,0 +1,183 @@
+package tfsdk
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// schemaCache memoizes the resource and data source types of the provider
+// and the schemas of resource and data source types, along with their
+// Terraform types, so each is only constructed and converted the first time
+// it is used rather than during every RPC. The zero value is ready for use
+// and it is safe for concurrent use.
+type schemaCache struct {
+	mu                sync.Mutex
+	resourceSchemas   map[string]schemaCacheEntry
+	dataSourceSchemas map[string]schemaCacheEntry
+
+	// resourceTypes is nil until the resource types are successfully
+	// determined, and resourceTypesDiags holds their warning diagnostics.
+	resourceTypes      map[string]ResourceType
+	resourceTypesDiags diag.Diagnostics
+
+	// dataSourceTypes is nil until the data source types are successfully
+	// determined, and dataSourceTypesDiags holds their warning diagnostics.
+	dataSourceTypes      map[string]DataSourceType
+	dataSourceTypesDiags diag.Diagnostics
+}
+
+// schemaCacheEntry is a successfully constructed schema and its Terraform
+// type, along with any warning diagnostics, which are returned with every use
+// of the schema.
+type schemaCacheEntry struct {
+	schema        Schema
+	terraformType tftypes.Type
+	diags         diag.Diagnostics
+}
+
+// get returns the cached schema from entries, otherwise it calls getSchema
+// and caches the result if there are no error diagnostics. The lock is not
+// held while calling getSchema, so constructing one schema does not block
+// the use of others. Concurrent first uses of the same schema may each
+// construct it, in which case the first result is kept.
+func (c *schemaCache) get(ctx context.Context, entries *map[string]schemaCacheEntry, typeName string, getSchema func() (Schema, diag.Diagnostics)) (Schema, tftypes.Type, diag.Diagnostics) {
+	c.mu.Lock()
+	entry, ok := (*entries)[typeName]
+	c.mu.Unlock()
+
+	if ok {
+		return entry.schema, entry.terraformType, entry.diags
+	}
+
+	start := ClockFromContext(ctx).Now()
+	schema, diags := getSchema()
+	logSchemaFetch(ctx, typeName, start, diags)
+
+	if diags.HasError() {
+		return schema, nil, diags
+	}
+
+	terraformType := schema.TerraformType(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if entry, ok := (*entries)[typeName]; ok {
+		return entry.schema, entry.terraformType, entry.diags
+	}
+
+	if *entries == nil {
+		*entries = make(map[string]schemaCacheEntry)
+	}
+
+	(*entries)[typeName] = schemaCacheEntry{
+		schema:        schema,
+		terraformType: terraformType,
+		diags:         diags,
+	}
+
+	return schema, terraformType, diags
+}
+
+// getResourceTypes returns the resource types of the provider, determining
+// them on first use. With ProviderWithResources, determining the types calls
+// every resource factory and Metadata method, which would otherwise happen
+// during every RPC.
+func (s *server) getResourceTypes(ctx context.Context) (map[string]ResourceType, diag.Diagnostics) {
+	s.schemaCache.mu.Lock()
+	resourceTypes, diags := s.schemaCache.resourceTypes, s.schemaCache.resourceTypesDiags
+	s.schemaCache.mu.Unlock()
+
+	if resourceTypes != nil {
+		return resourceTypes, diags
+	}
+
+	resourceTypes, diags = providerResourceTypes(ctx, s.p)
+
+	if diags.HasError() {
+		return resourceTypes, diags
+	}
+
+	if resourceTypes == nil {
+		resourceTypes = map[string]ResourceType{}
+	}
+
+	s.schemaCache.mu.Lock()
+	defer s.schemaCache.mu.Unlock()
+
+	if s.schemaCache.resourceTypes == nil {
+		s.schemaCache.resourceTypes = resourceTypes
+		s.schemaCache.resourceTypesDiags = diags
+	}
+
+	return s.schemaCache.resourceTypes, s.schemaCache.resourceTypesDiags
+}
+
+// getDataSourceTypes returns the data source types of the provider,
+// determining them on first use, as with getResourceTypes.
+func (s *server) getDataSourceTypes(ctx context.Context) (map[string]DataSourceType, diag.Diagnostics) {
+	s.schemaCache.mu.Lock()
+	dataSourceTypes, diags := s.schemaCache.dataSourceTypes, s.schemaCache.dataSourceTypesDiags
+	s.schemaCache.mu.Unlock()
+
+	if dataSourceTypes != nil {
+		return dataSourceTypes, diags
+	}
+
+	dataSourceTypes, diags = s.p.GetDataSources(ctx)
+
+	if diags.HasError() {
+		return dataSourceTypes, diags
+	}
+
+	if dataSourceTypes == nil {
+		dataSourceTypes = map[string]DataSourceType{}
+	}
+
+	s.schemaCache.mu.Lock()
+	defer s.schemaCache.mu.Unlock()
+
+	if s.schemaCache.dataSourceTypes == nil {
+		s.schemaCache.dataSourceTypes = dataSourceTypes
+		s.schemaCache.dataSourceTypesDiags = diags
+	}
+
+	return s.schemaCache.dataSourceTypes, s.schemaCache.dataSourceTypesDiags
+}
+
+// getResourceSchema returns the schema of the resource type, constructing it
+// on first use.
+func (s *server) getResourceSchema(ctx context.Context, typeName string, resourceType ResourceType) (Schema, diag.Diagnostics) {
+	schema, _, diags := s.getResourceSchemaWithType(ctx, typeName, resourceType)
+
+	return schema, diags
+}
+
+// getResourceSchemaWithType returns the schema of the resource type and its
+// Terraform type, constructing and converting them on first use. RPCs should
+// use the returned type rather than calling Schema.TerraformType, which
+// walks the entire schema on every call.
+func (s *server) getResourceSchemaWithType(ctx context.Context, typeName string, resourceType ResourceType) (Schema, tftypes.Type, diag.Diagnostics) {
+	return s.schemaCache.get(ctx, &s.schemaCache.resourceSchemas, typeName, func() (Schema, diag.Diagnostics) {
+		return resourceType.GetSchema(ctx)
+	})
+}
+
+// getDataSourceSchema returns the schema of the data source type,
+// constructing it on first use.
+func (s *server) getDataSourceSchema(ctx context.Context, typeName string, dataSourceType DataSourceType) (Schema, diag.Diagnostics) {
+	schema, _, diags := s.getDataSourceSchemaWithType(ctx, typeName, dataSourceType)
+
+	return schema, diags
+}
+
+// getDataSourceSchemaWithType returns the schema of the data source type and
+// its Terraform type, constructing and converting them on first use.
+func (s *server) getDataSourceSchemaWithType(ctx context.Context, typeName string, dataSourceType DataSourceType) (Schema, tftypes.Type, diag.Diagnostics) {
+	return s.schemaCache.get(ctx, &s.schemaCache.dataSourceSchemas, typeName, func() (Schema, diag.Diagnostics) {
+		return dataSourceType.GetSchema(ctx)
+	})
+}
@@ -0,0 +1,420 @@
+package tfsdk
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testSchemaCacheResourceType struct {
+	calls *int32
+	diags diag.Diagnostics
+}
+
+func (t testSchemaCacheResourceType) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	atomic.AddInt32(t.calls, 1)
+
+	return Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+		},
+	}, t.diags
+}
+
+func (t testSchemaCacheResourceType) NewResource(_ context.Context, _ Provider) (Resource, diag.Diagnostics) {
+	return nil, nil
+}
+
+type testSchemaCacheDataSourceType struct {
+	testServeDataSourceTypeOne
+
+	calls *int32
+}
+
+func (t testSchemaCacheDataSourceType) GetSchema(ctx context.Context) (Schema, diag.Diagnostics) {
+	atomic.AddInt32(t.calls, 1)
+
+	return t.testServeDataSourceTypeOne.GetSchema(ctx)
+}
+
+func TestServerGetResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		diags         diag.Diagnostics
+		expectedCalls int32
+	}{
+		"success": {
+			expectedCalls: 1,
+		},
+		"warning": {
+			diags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test summary", "test detail"),
+			},
+			expectedCalls: 1,
+		},
+		"error": {
+			diags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test summary", "test detail"),
+			},
+			expectedCalls: 3,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			testServer := &server{}
+			resourceType := testSchemaCacheResourceType{
+				calls: new(int32),
+				diags: tc.diags,
+			}
+			expectedSchema, _ := resourceType.GetSchema(ctx)
+			atomic.StoreInt32(resourceType.calls, 0)
+
+			for i := 0; i < 3; i++ {
+				got, diags := testServer.getResourceSchema(ctx, "test_resource", resourceType)
+
+				if diff := cmp.Diff(diags, tc.diags); diff != "" {
+					t.Errorf("unexpected diagnostics difference: %s", diff)
+				}
+
+				if !got.Attributes["id"].Equal(expectedSchema.Attributes["id"]) {
+					t.Errorf("unexpected schema: %#v", got)
+				}
+			}
+
+			if got := atomic.LoadInt32(resourceType.calls); got != tc.expectedCalls {
+				t.Errorf("expected %d GetSchema calls, got %d", tc.expectedCalls, got)
+			}
+		})
+	}
+}
+
+func TestServerGetResourceSchemaWithType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testServer := &server{}
+	resourceType := testSchemaCacheResourceType{
+		calls: new(int32),
+	}
+	expectedSchema, _ := resourceType.GetSchema(ctx)
+	expectedType := expectedSchema.TerraformType(ctx)
+	atomic.StoreInt32(resourceType.calls, 0)
+
+	for i := 0; i < 3; i++ {
+		_, got, diags := testServer.getResourceSchemaWithType(ctx, "test_resource", resourceType)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error diagnostics: %s", diags)
+		}
+
+		if !got.Equal(expectedType) {
+			t.Errorf("expected type %s, got %s", expectedType, got)
+		}
+	}
+
+	if calls := atomic.LoadInt32(resourceType.calls); calls != 1 {
+		t.Errorf("expected 1 GetSchema call, got %d", calls)
+	}
+
+	_, got, diags := testServer.getDataSourceSchemaWithType(ctx, "test_one", testSchemaCacheDataSourceType{calls: new(int32)})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", diags)
+	}
+
+	dataSourceSchema, _ := testServeDataSourceTypeOne{}.GetSchema(ctx)
+
+	if expected := dataSourceSchema.TerraformType(ctx); !got.Equal(expected) {
+		t.Errorf("expected type %s, got %s", expected, got)
+	}
+}
+
+func TestServerGetResourceSchema_concurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testServer := &server{}
+	resourceTypes := map[string]testSchemaCacheResourceType{
+		"test_one": {calls: new(int32)},
+		"test_two": {calls: new(int32)},
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		for typeName, resourceType := range resourceTypes {
+			typeName, resourceType := typeName, resourceType
+
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, diags := testServer.getResourceSchema(ctx, typeName, resourceType)
+
+				if diags.HasError() {
+					t.Errorf("unexpected error diagnostics: %s", diags)
+				}
+			}()
+		}
+	}
+
+	wg.Wait()
+
+	for typeName, resourceType := range resourceTypes {
+		if calls := atomic.LoadInt32(resourceType.calls); calls < 1 {
+			t.Errorf("expected %s GetSchema to be called, got %d calls", typeName, calls)
+		}
+
+		// After the first uses complete, the cached schema must be used.
+		before := atomic.LoadInt32(resourceType.calls)
+
+		if _, diags := testServer.getResourceSchema(ctx, typeName, resourceType); diags.HasError() {
+			t.Errorf("unexpected error diagnostics: %s", diags)
+		}
+
+		if after := atomic.LoadInt32(resourceType.calls); after != before {
+			t.Errorf("expected %s GetSchema to not be called again, got %d calls", typeName, after)
+		}
+	}
+}
+
+func TestServerGetResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	calls := new(int32)
+	testServer := &server{
+		p: testServeProviderWithResources{
+			testServeProvider: &testServeProvider{},
+			resources: []func() ResourceWithMetadata{
+				func() ResourceWithMetadata {
+					atomic.AddInt32(calls, 1)
+
+					return testResourceWithMetadata{typeName: "test_factory"}
+				},
+			},
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		got, diags := testServer.getResourceTypes(ctx)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error diagnostics: %s", diags)
+		}
+
+		if _, ok := got["test_factory"]; !ok {
+			t.Errorf("expected test_factory resource type, got: %v", got)
+		}
+
+		if _, ok := got["test_one"]; !ok {
+			t.Errorf("expected test_one resource type, got: %v", got)
+		}
+	}
+
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("expected 1 factory call, got %d", got)
+	}
+}
+
+// testSchemaCacheProvider counts the calls of GetDataSources.
+type testSchemaCacheProvider struct {
+	*testServeProvider
+
+	dataSourcesCalls *int32
+}
+
+func (p testSchemaCacheProvider) GetDataSources(ctx context.Context) (map[string]DataSourceType, diag.Diagnostics) {
+	atomic.AddInt32(p.dataSourcesCalls, 1)
+
+	return p.testServeProvider.GetDataSources(ctx)
+}
+
+func TestServerGetDataSourceTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	calls := new(int32)
+	testServer := &server{
+		p: testSchemaCacheProvider{
+			testServeProvider: &testServeProvider{},
+			dataSourcesCalls:  calls,
+		},
+	}
+
+	for i := 0; i < 3; i++ {
+		got, diags := testServer.getDataSourceTypes(ctx)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error diagnostics: %s", diags)
+		}
+
+		if _, ok := got["test_one"]; !ok {
+			t.Errorf("expected test_one data source type, got: %v", got)
+		}
+	}
+
+	if _, diags := testServer.getDataSourceType(ctx, "test_two"); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", diags)
+	}
+
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("expected 1 GetDataSources call, got %d", got)
+	}
+}
+
+func TestServerGetDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testServer := &server{}
+	dataSourceType := testSchemaCacheDataSourceType{
+		calls: new(int32),
+	}
+
+	for i := 0; i < 3; i++ {
+		got, diags := testServer.getDataSourceSchema(ctx, "test_one", dataSourceType)
+
+		if diags.HasError() {
+			t.Fatalf("unexpected error diagnostics: %s", diags)
+		}
+
+		if _, ok := got.Attributes["current_date"]; !ok {
+			t.Errorf("unexpected schema: %#v", got)
+		}
+	}
+
+	if calls := atomic.LoadInt32(dataSourceType.calls); calls != 1 {
+		t.Errorf("expected 1 GetSchema call, got %d", calls)
+	}
+
+	// Resource and data source schemas with the same type name are cached
+	// separately.
+	if _, diags := testServer.getResourceSchema(ctx, "test_one", testSchemaCacheResourceType{calls: new(int32)}); diags.HasError() {
+		t.Fatalf("unexpected error diagnostics: %s", diags)
+	}
+
+	got, _ := testServer.getDataSourceSchema(ctx, "test_one", dataSourceType)
+
+	if _, ok := got.Attributes["current_date"]; !ok {
+		t.Errorf("unexpected schema: %#v", got)
+	}
+}
+
+// testSchemaCacheResource has a schema of attributeCount string attributes
+// and a Read method which keeps the current state.
+type testSchemaCacheResource struct {
+	testResourceWithMetadata
+
+	attributeCount int
+}
+
+func (r testSchemaCacheResource) GetSchema(_ context.Context) (Schema, diag.Diagnostics) {
+	attributes := make(map[string]Attribute, r.attributeCount)
+
+	for idx := 0; idx < r.attributeCount; idx++ {
+		attributes["attr"+strconv.Itoa(idx)] = Attribute{
+			Type:     types.StringType,
+			Optional: true,
+		}
+	}
+
+	return Schema{
+		Attributes: attributes,
+	}, nil
+}
+
+func (r testSchemaCacheResource) Read(_ context.Context, _ ReadResourceRequest, _ *ReadResourceResponse) {
+}
+
+// benchmarkServerReadResource benchmarks ReadResource requests across every
+// resource of a provider with resourceCount resources.
+func benchmarkServerReadResource(b *testing.B, resourceCount int) {
+	ctx := context.Background()
+	attributeCount := 50
+	resources := make([]func() ResourceWithMetadata, 0, resourceCount)
+	typeNames := make([]string, 0, resourceCount)
+
+	for idx := 0; idx < resourceCount; idx++ {
+		resource := testSchemaCacheResource{
+			testResourceWithMetadata: testResourceWithMetadata{typeName: "test_resource" + strconv.Itoa(idx)},
+			attributeCount:           attributeCount,
+		}
+
+		resources = append(resources, func() ResourceWithMetadata {
+			return resource
+		})
+		typeNames = append(typeNames, resource.typeName)
+	}
+
+	attributeTypes := make(map[string]tftypes.Type, attributeCount)
+	attributeValues := make(map[string]tftypes.Value, attributeCount)
+
+	for idx := 0; idx < attributeCount; idx++ {
+		name := "attr" + strconv.Itoa(idx)
+		attributeTypes[name] = tftypes.String
+		attributeValues[name] = tftypes.NewValue(tftypes.String, "value")
+	}
+
+	stateType := tftypes.Object{AttributeTypes: attributeTypes}
+	currentState, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, attributeValues))
+
+	if err != nil {
+		b.Fatalf("unexpected error: %s", err)
+	}
+
+	testServer := &server{
+		p: testServeProviderWithResources{
+			testServeProvider: &testServeProvider{},
+			resources:         resources,
+		},
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		resp, err := testServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+			CurrentState: &currentState,
+			TypeName:     typeNames[n%resourceCount],
+		})
+
+		if err != nil {
+			b.Fatalf("unexpected error: %s", err)
+		}
+
+		if len(resp.Diagnostics) > 0 {
+			b.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	}
+}
+
+func BenchmarkServerReadResource10(b *testing.B) {
+	benchmarkServerReadResource(b, 10)
+}
+
+func BenchmarkServerReadResource100(b *testing.B) {
+	benchmarkServerReadResource(b, 100)
+}
+
+func BenchmarkServerReadResource500(b *testing.B) {
+	benchmarkServerReadResource(b, 500)
+}
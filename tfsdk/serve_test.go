@@ -11,6 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
@@ -31,8 +32,8 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			ctx := context.Background()
-			ctx = s.registerContext(ctx)
+			ctx, done := s.registerContext(context.Background())
+			defer done()
 			select {
 			case <-time.After(time.Second * 10):
 				t.Error("timed out waiting to be canceled")
@@ -56,6 +57,37 @@ func TestServerCancelInFlightContexts(t *testing.T) {
 	// canceled, or we have an error reported
 }
 
+func TestServerRegisterContext(t *testing.T) {
+	t.Parallel()
+
+	s := &server{}
+
+	ctx, done := s.registerContext(context.Background())
+
+	if len(s.contextCancels) != 1 {
+		t.Fatalf("expected 1 registered context, got %d", len(s.contextCancels))
+	}
+
+	done()
+
+	if len(s.contextCancels) != 0 {
+		t.Errorf("expected no registered contexts after done, got %d", len(s.contextCancels))
+	}
+
+	if ctx.Err() == nil {
+		t.Error("expected context to be canceled after done")
+	}
+
+	s.cancelRegisteredContexts(context.Background())
+
+	ctx, done = s.registerContext(context.Background())
+	defer done()
+
+	if ctx.Err() == nil {
+		t.Error("expected context registered after stop to be canceled")
+	}
+}
+
 func TestMarkComputedNilsAsUnknown(t *testing.T) {
 	t.Parallel()
 
@@ -260,6 +292,58 @@ func TestNormaliseRequiresReplace(t *testing.T) {
 	}
 }
 
+func TestNewProtocol6ServerWithOptions(t *testing.T) {
+	t.Parallel()
+
+	clock := testClock{now: time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)}
+	testProvider := new(testServeProvider)
+
+	got := NewProtocol6ServerWithOptions(testProvider, ServeOpts{
+		Name:                       "registry.terraform.io/hashicorp/test",
+		Debug:                      true,
+		Clock:                      clock,
+		CorrelationIDInDiagnostics: true,
+		ValidateCreatedID:          true,
+	})
+
+	testServer, ok := got.(*server)
+
+	if !ok {
+		t.Fatalf("expected *server, got %T", got)
+	}
+
+	if testServer.p != testProvider {
+		t.Errorf("expected provider %v, got %v", testProvider, testServer.p)
+	}
+
+	if !testServer.validateCreatedID {
+		t.Error("expected created ID validation to be enabled")
+	}
+
+	if testServer.clock != clock {
+		t.Errorf("expected clock %v, got %v", clock, testServer.clock)
+	}
+
+	if testServer.rand != nil {
+		t.Errorf("expected no rand, got %v", testServer.rand)
+	}
+
+	if !testServer.correlationIDInDiagnostics {
+		t.Error("expected correlation IDs in diagnostics to be enabled")
+	}
+
+	// Requests are dispatched directly to the provider.
+	resp, err := got.GetProviderSchema(context.Background(), new(tfprotov6.GetProviderSchemaRequest))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(resp.Provider, testServeProviderProviderSchema); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestServerGetProviderSchema(t *testing.T) {
 	t.Parallel()
 
@@ -651,7 +735,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 					validateProviderConfigImpl: func(_ context.Context, req ValidateProviderConfigRequest, resp *ValidateProviderConfigResponse) {
 						if len(resp.Diagnostics) == 0 {
 							resp.Diagnostics.AddAttributeWarning(
-								tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+								path.Root("disks").AtListIndex(0),
 								"This is a warning",
 								"This is your final warning",
 							)
@@ -661,7 +745,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 							)
 						} else {
 							resp.Diagnostics.AddAttributeWarning(
-								tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+								path.Root("disks").AtListIndex(0),
 								"This is another warning",
 								"This is really your final warning",
 							)
@@ -744,7 +828,7 @@ func TestServerValidateProviderConfig(t *testing.T) {
 				&testServeProvider{
 					validateProviderConfigImpl: func(_ context.Context, req ValidateProviderConfigRequest, resp *ValidateProviderConfigResponse) {
 						resp.Diagnostics.AddAttributeWarning(
-							tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+							path.Root("disks").AtListIndex(0),
 							"This is a warning",
 							"This is your final warning",
 						)
@@ -1255,7 +1339,7 @@ func TestServerValidateResourceConfig(t *testing.T) {
 			impl: func(_ context.Context, req ValidateResourceConfigRequest, resp *ValidateResourceConfigResponse) {
 				if len(resp.Diagnostics) == 0 {
 					resp.Diagnostics.AddAttributeWarning(
-						tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+						path.Root("disks").AtListIndex(0),
 						"This is a warning",
 						"This is your final warning",
 					)
@@ -1265,7 +1349,7 @@ func TestServerValidateResourceConfig(t *testing.T) {
 					)
 				} else {
 					resp.Diagnostics.AddAttributeWarning(
-						tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+						path.Root("disks").AtListIndex(0),
 						"This is another warning",
 						"This is really your final warning",
 					)
@@ -1342,7 +1426,7 @@ func TestServerValidateResourceConfig(t *testing.T) {
 
 			impl: func(_ context.Context, req ValidateResourceConfigRequest, resp *ValidateResourceConfigResponse) {
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+					path.Root("disks").AtListIndex(0),
 					"This is a warning",
 					"This is your final warning",
 				)
@@ -1436,6 +1520,16 @@ func TestServerUpgradeResourceState(t *testing.T) {
 		t.Fatalf("unable to create UpgradedState: %s", err)
 	}
 
+	validUpgradedStateFromNumberID, err := tfprotov6.NewDynamicValue(schemaType, tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "123"),
+		"optional_string": tftypes.NewValue(tftypes.String, nil),
+		"required_string": tftypes.NewValue(tftypes.String, "test-required-value"),
+	}))
+
+	if err != nil {
+		t.Fatalf("unable to create UpgradedState: %s", err)
+	}
+
 	testCases := map[string]struct {
 		request          *tfprotov6.UpgradeResourceStateRequest
 		expectedResponse *tfprotov6.UpgradeResourceStateResponse
@@ -1448,6 +1542,7 @@ func TestServerUpgradeResourceState(t *testing.T) {
 		"RawState-Flatmap": {
 			request: &tfprotov6.UpgradeResourceStateRequest{
 				TypeName: "test_upgrade_state",
+				Version:  4,
 				RawState: &tfprotov6.RawState{
 					Flatmap: map[string]string{
 						"flatmap": "is not supported",
@@ -1459,9 +1554,7 @@ func TestServerUpgradeResourceState(t *testing.T) {
 					{
 						Severity: tfprotov6.DiagnosticSeverityError,
 						Summary:  "Unable to Read Previously Saved State for UpgradeResourceState",
-						Detail: "There was an error reading the saved resource state using the current resource schema. " +
-							"This resource was implemented in a Terraform Provider SDK that does not support upgrading resource state yet.\n\n" +
-							"If the resource previously implemented different resource state versions, the provider developers will need to revert back to the previous implementation. " +
+						Detail: "There was an error reading the saved resource state using the current resource schema.\n\n" +
 							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. " +
 							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. " +
 							"Otherwise, please report this to the provider developer:\n\n" +
@@ -1473,6 +1566,7 @@ func TestServerUpgradeResourceState(t *testing.T) {
 		"RawState-JSON-passthrough": {
 			request: &tfprotov6.UpgradeResourceStateRequest{
 				TypeName: "test_upgrade_state",
+				Version:  4,
 				RawState: &validRawState,
 			},
 			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
@@ -1482,6 +1576,7 @@ func TestServerUpgradeResourceState(t *testing.T) {
 		"RawState-JSON-mismatch": {
 			request: &tfprotov6.UpgradeResourceStateRequest{
 				TypeName: "test_upgrade_state",
+				Version:  4,
 				RawState: &tfprotov6.RawState{
 					JSON: []byte(`{"nonexistent_attribute":"value"}`),
 				},
@@ -1491,9 +1586,7 @@ func TestServerUpgradeResourceState(t *testing.T) {
 					{
 						Severity: tfprotov6.DiagnosticSeverityError,
 						Summary:  "Unable to Read Previously Saved State for UpgradeResourceState",
-						Detail: "There was an error reading the saved resource state using the current resource schema. " +
-							"This resource was implemented in a Terraform Provider SDK that does not support upgrading resource state yet.\n\n" +
-							"If the resource previously implemented different resource state versions, the provider developers will need to revert back to the previous implementation. " +
+						Detail: "There was an error reading the saved resource state using the current resource schema.\n\n" +
 							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. " +
 							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. " +
 							"Otherwise, please report this to the provider developer:\n\n" +
@@ -1506,15 +1599,14 @@ func TestServerUpgradeResourceState(t *testing.T) {
 			request: &tfprotov6.UpgradeResourceStateRequest{
 				RawState: &tfprotov6.RawState{},
 				TypeName: "test_upgrade_state",
+				Version:  4,
 			},
 			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
 				Diagnostics: []*tfprotov6.Diagnostic{
 					{
 						Severity: tfprotov6.DiagnosticSeverityError,
 						Summary:  "Unable to Read Previously Saved State for UpgradeResourceState",
-						Detail: "There was an error reading the saved resource state using the current resource schema. " +
-							"This resource was implemented in a Terraform Provider SDK that does not support upgrading resource state yet.\n\n" +
-							"If the resource previously implemented different resource state versions, the provider developers will need to revert back to the previous implementation. " +
+						Detail: "There was an error reading the saved resource state using the current resource schema.\n\n" +
 							"If this resource state was last refreshed with Terraform CLI 0.11 and earlier, it must be refreshed or applied with an older provider version first. " +
 							"If you manually modified the resource state, you will need to manually modify it to match the current resource schema. " +
 							"Otherwise, please report this to the provider developer:\n\n" +
@@ -1555,6 +1647,115 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				},
 			},
 		},
+		"Version-0-PriorSchema": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "test_upgrade_state",
+				Version:  0,
+				RawState: &tfprotov6.RawState{
+					JSON: []byte(`{"id":123,"optional_string":null,"required_string":"test-required-value"}`),
+				},
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				UpgradedState: &validUpgradedStateFromNumberID,
+			},
+		},
+		"Version-0-PriorSchema-RawState-Flatmap": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "test_upgrade_state",
+				Version:  0,
+				RawState: &tfprotov6.RawState{
+					Flatmap: map[string]string{
+						"id":              "123",
+						"required_string": "test-required-value",
+					},
+				},
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				UpgradedState: &validUpgradedStateFromNumberID,
+			},
+		},
+		"Version-0-PriorSchema-RawState-empty": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "test_upgrade_state",
+				Version:  0,
+				RawState: &tfprotov6.RawState{},
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Unable to Read Previously Saved State for UpgradeResourceState",
+						Detail: "There was an error reading the saved resource state using the prior resource schema defined for version 0 upgrade.\n\n" +
+							"Please report this to the provider developer:\n\n" +
+							"RawState had no JSON or flatmap data set",
+					},
+				},
+			},
+		},
+		"Version-1-RawState": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "test_upgrade_state",
+				Version:  1,
+				RawState: &validRawState,
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				UpgradedState: &validUpgradedState,
+			},
+		},
+		"Version-2-missing-state": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "test_upgrade_state",
+				Version:  2,
+				RawState: &validRawState,
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Missing Upgraded Resource State",
+						Detail: "After attempting a resource state upgrade to version 4, the provider did not return any state data. " +
+							"Preventing the unexpected loss of resource state data. " +
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					},
+				},
+			},
+		},
+		"Version-3-unimplemented": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "test_upgrade_state",
+				Version:  3,
+				RawState: &validRawState,
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Unable to Upgrade Resource State",
+						Detail: "This resource was implemented with an UpgradeState() method, " +
+							"however Terraform was expecting an implementation for version 3 upgrade.\n\n" +
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					},
+				},
+			},
+		},
+		"Version-without-UpgradeState": {
+			request: &tfprotov6.UpgradeResourceStateRequest{
+				TypeName: "test_one",
+				Version:  0,
+				RawState: &validRawState,
+			},
+			expectedResponse: &tfprotov6.UpgradeResourceStateResponse{
+				Diagnostics: []*tfprotov6.Diagnostic{
+					{
+						Severity: tfprotov6.DiagnosticSeverityError,
+						Summary:  "Unable to Upgrade Resource State",
+						Detail: "This resource was implemented without an UpgradeState() method, " +
+							"however Terraform was expecting an implementation for version 0 upgrade.\n\n" +
+							"This is always an issue with the Terraform Provider and should be reported to the provider developer.",
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1585,13 +1786,6 @@ func TestServerUpgradeResourceState(t *testing.T) {
 				t.Fatalf("got no error, expected: %s", testCase.expectedError)
 			}
 
-			// TODO: Implement with UpgradeResourceState support
-			// Reference: https://github.com/hashicorp/terraform-plugin-framework/issues/42
-			// if testCase.request != nil && testProvider.upgradeResourceStateCalledResourceType != testCase.request.TypeName {
-			// 	t.Errorf("expected to call resource %q, called: %s", testCase.request.TypeName, testProvider.upgradeResourceStateCalledResourceType)
-			// 	return
-			// }
-
 			if diff := cmp.Diff(got, testCase.expectedResponse); diff != "" {
 				t.Errorf("unexpected difference in response: %s", diff)
 			}
@@ -1883,7 +2077,7 @@ func TestServerReadResource(t *testing.T) {
 					}),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+					path.Root("disks").AtListIndex(0),
 					"This is a warning",
 					"This is your final warning",
 				)
@@ -1951,6 +2145,50 @@ func TestServerReadResource(t *testing.T) {
 				},
 			},
 		},
+		"one_private_passthrough": {
+			currentState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
+			}),
+			private:      []byte(`{"etag":"MQ=="}`),
+			resource:     "test_one",
+			resourceType: testServeResourceTypeOneType,
+
+			impl: func(ctx context.Context, req ReadResourceRequest, resp *ReadResourceResponse) {
+				if got := string(req.Private.GetKey(ctx, "etag")); got != "1" {
+					resp.Diagnostics.AddError("Unexpected Private State", "expected etag 1, got: "+got)
+				}
+			},
+
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
+			}),
+			expectedPrivate: []byte(`{"etag":"MQ=="}`),
+		},
+		"one_private_set": {
+			currentState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
+			}),
+			private:      []byte(`{"etag":"MQ=="}`),
+			resource:     "test_one",
+			resourceType: testServeResourceTypeOneType,
+
+			impl: func(ctx context.Context, req ReadResourceRequest, resp *ReadResourceResponse) {
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "etag", []byte("2"))...)
+			},
+
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "foo"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "now"),
+			}),
+			expectedPrivate: []byte(`{"etag":"Mg=="}`),
+		},
 	}
 
 	for name, tc := range tests {
@@ -2167,6 +2405,33 @@ func TestServerPlanResourceChange(t *testing.T) {
 			resourceType:         testServeResourceTypeTwoType,
 			expectedPlannedState: tftypes.NewValue(testServeResourceTypeTwoType, nil),
 		},
+		"one_private_passthrough": {
+			priorState:             tftypes.NewValue(testServeResourceTypeOneType, nil),
+			proposedNewState:       tftypes.NewValue(testServeResourceTypeOneType, nil),
+			config:                 tftypes.NewValue(testServeResourceTypeOneType, nil),
+			priorPrivate:           []byte(`{"etag":"MQ=="}`),
+			resource:               "test_one",
+			resourceType:           testServeResourceTypeOneType,
+			expectedPlannedState:   tftypes.NewValue(testServeResourceTypeOneType, nil),
+			expectedPlannedPrivate: []byte(`{"etag":"MQ=="}`),
+		},
+		"two_modifyplan_private": {
+			priorState:       tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			proposedNewState: tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			config:           tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			priorPrivate:     []byte(`{"etag":"MQ==","other":"Mw=="}`),
+			resource:         "test_two",
+			resourceType:     testServeResourceTypeTwoType,
+			modifyPlanFunc: func(ctx context.Context, req ModifyResourcePlanRequest, resp *ModifyResourcePlanResponse) {
+				if got := string(req.Private.GetKey(ctx, "etag")); got != "1" {
+					resp.Diagnostics.AddError("Unexpected Private State", "expected etag 1, got: "+got)
+				}
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "etag", []byte("2"))...)
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "other", nil)...)
+			},
+			expectedPlannedState:   tftypes.NewValue(testServeResourceTypeTwoType, nil),
+			expectedPlannedPrivate: []byte(`{"etag":"Mg=="}`),
+		},
 		"two_delete": {
 			priorState: tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
 				"id": tftypes.NewValue(tftypes.String, "123456"),
@@ -2902,7 +3167,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			}),
 			modifyPlanFunc: func(ctx context.Context, req ModifyResourcePlanRequest, resp *ModifyResourcePlanResponse) {
-				resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")}
+				resp.RequiresReplace = path.Paths{path.Root("id")}
 			},
 			expectedRequiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")},
 		},
@@ -3058,7 +3323,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			}),
 			modifyPlanFunc: func(ctx context.Context, req ModifyResourcePlanRequest, resp *ModifyResourcePlanResponse) {
-				resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")}
+				resp.RequiresReplace = path.Paths{path.Root("id")}
 				resp.Diagnostics.AddWarning("I'm warning you", "You have been warned")
 			},
 			expectedRequiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")},
@@ -3222,7 +3487,7 @@ func TestServerPlanResourceChange(t *testing.T) {
 				}),
 			}),
 			modifyPlanFunc: func(ctx context.Context, req ModifyResourcePlanRequest, resp *ModifyResourcePlanResponse) {
-				resp.RequiresReplace = []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")}
+				resp.RequiresReplace = path.Paths{path.Root("id")}
 				resp.Diagnostics.AddError("This is an error", "More details about the error")
 			},
 			expectedRequiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("id")},
@@ -4106,14 +4371,15 @@ func TestServerApplyResourceChange(t *testing.T) {
 
 	type testCase struct {
 		// request input
-		priorState     tftypes.Value
-		plannedState   tftypes.Value
-		config         tftypes.Value
-		plannedPrivate []byte
-		providerMeta   tftypes.Value
-		resource       string
-		action         string
-		resourceType   tftypes.Type
+		priorState        tftypes.Value
+		plannedState      tftypes.Value
+		config            tftypes.Value
+		plannedPrivate    []byte
+		providerMeta      tftypes.Value
+		resource          string
+		action            string
+		resourceType      tftypes.Type
+		validateCreatedID bool
 
 		create  func(context.Context, CreateResourceRequest, *CreateResourceResponse)
 		update  func(context.Context, UpdateResourceRequest, *UpdateResourceResponse)
@@ -4161,6 +4427,39 @@ func TestServerApplyResourceChange(t *testing.T) {
 				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 			}),
 		},
+		"one_create_private": {
+			plannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, nil),
+			}),
+			plannedPrivate: []byte(`{"planned":"MQ=="}`),
+			resource:       "test_one",
+			action:         "create",
+			resourceType:   testServeResourceTypeOneType,
+			create: func(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+				if got := string(req.Private.GetKey(ctx, "planned")); got != "1" {
+					resp.Diagnostics.AddError("Unexpected Private State", "expected planned 1, got: "+got)
+				}
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, "etag", []byte("2"))...)
+				resp.State.Raw = tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+					"name":              tftypes.NewValue(tftypes.String, "hello, world"),
+					"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+				})
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
+				"name":              tftypes.NewValue(tftypes.String, "hello, world"),
+				"favorite_colors":   tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+				"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
+			}),
+			expectedPrivate: []byte(`{"etag":"Mg==","planned":"MQ=="}`),
+		},
 		"one_create_diags": {
 			plannedState: tftypes.NewValue(testServeResourceTypeOneType, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "hello, world"),
@@ -4188,7 +4487,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("favorite_colors").WithElementKeyInt(0),
+					path.Root("favorite_colors").AtListIndex(0),
 					"This is a warning",
 					"I'm warning you",
 				)
@@ -4299,7 +4598,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					"I'm warning you...",
 					"This is a warning!",
 				)
@@ -4362,7 +4661,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 					"created_timestamp": tftypes.NewValue(tftypes.String, "right now I guess"),
 				})
 				resp.Diagnostics.AddAttributeError(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					"Oops!",
 					"This is an error! Don't update the state!",
 				)
@@ -4415,7 +4714,7 @@ func TestServerApplyResourceChange(t *testing.T) {
 			destroy: func(ctx context.Context, req DeleteResourceRequest, resp *DeleteResourceResponse) {
 				resp.State.Raw = tftypes.NewValue(testServeResourceTypeOneType, nil)
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("created_timestamp"),
+					path.Root("created_timestamp"),
 					"This is a warning",
 					"just a warning diagnostic, no behavior changes",
 				)
@@ -4585,6 +4884,75 @@ func TestServerApplyResourceChange(t *testing.T) {
 				}),
 			}),
 		},
+		"two_create_debug_missing_id": {
+			plannedState: tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"disks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name":    tftypes.String,
+						"size_gb": tftypes.Number,
+						"boot":    tftypes.Bool,
+					},
+				}}, nil),
+				"list_nested_blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"required_bool":   tftypes.Bool,
+						"required_number": tftypes.Number,
+						"required_string": tftypes.String,
+					},
+				}}, nil),
+			}),
+			config: tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, nil),
+				"disks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name":    tftypes.String,
+						"size_gb": tftypes.Number,
+						"boot":    tftypes.Bool,
+					},
+				}}, nil),
+				"list_nested_blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"required_bool":   tftypes.Bool,
+						"required_number": tftypes.Number,
+						"required_string": tftypes.String,
+					},
+				}}, nil),
+			}),
+			resource:          "test_two",
+			action:            "create",
+			resourceType:      testServeResourceTypeTwoType,
+			validateCreatedID: true,
+			create: func(ctx context.Context, req CreateResourceRequest, resp *CreateResourceResponse) {
+				resp.State.Raw = req.Plan.Raw.Copy()
+				resp.Diagnostics.Append(resp.State.SetID(ctx, "")...)
+			},
+			expectedNewState: tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, ""),
+				"disks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name":    tftypes.String,
+						"size_gb": tftypes.Number,
+						"boot":    tftypes.Bool,
+					},
+				}}, nil),
+				"list_nested_blocks": tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"required_bool":   tftypes.Bool,
+						"required_number": tftypes.Number,
+						"required_string": tftypes.String,
+					},
+				}}, nil),
+			}),
+			expectedDiags: []*tfprotov6.Diagnostic{
+				{
+					Severity:  tfprotov6.DiagnosticSeverityError,
+					Summary:   "Missing Resource Identifier",
+					Detail:    "The resource Create method did not set a value for the \"id\" attribute. This is always an error in the provider. Please report the following to the provider developer:\n\nCreate must set a non-empty identifier, such as with the State SetID method.",
+					Attribute: tftypes.NewAttributePath().WithAttributeName("id"),
+				},
+			},
+		},
 		"two_update": {
 			priorState: tftypes.NewValue(testServeResourceTypeTwoType, map[string]tftypes.Value{
 				"id": tftypes.NewValue(tftypes.String, "test-instance"),
@@ -5519,7 +5887,8 @@ func TestServerApplyResourceChange(t *testing.T) {
 				deleteFunc: tc.destroy,
 			}
 			testServer := &server{
-				p: s,
+				p:                 s,
+				validateCreatedID: tc.validateCreatedID,
 			}
 			var pmSchema Schema
 			if tc.providerMeta.Type() != nil {
@@ -5726,7 +6095,7 @@ func TestServerValidateDataResourceConfig(t *testing.T) {
 			impl: func(_ context.Context, req ValidateDataSourceConfigRequest, resp *ValidateDataSourceConfigResponse) {
 				if len(resp.Diagnostics) == 0 {
 					resp.Diagnostics.AddAttributeWarning(
-						tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+						path.Root("disks").AtListIndex(0),
 						"This is a warning",
 						"This is your final warning",
 					)
@@ -5736,7 +6105,7 @@ func TestServerValidateDataResourceConfig(t *testing.T) {
 					)
 				} else {
 					resp.Diagnostics.AddAttributeWarning(
-						tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+						path.Root("disks").AtListIndex(0),
 						"This is another warning",
 						"This is really your final warning",
 					)
@@ -5813,7 +6182,7 @@ func TestServerValidateDataResourceConfig(t *testing.T) {
 
 			impl: func(_ context.Context, req ValidateDataSourceConfigRequest, resp *ValidateDataSourceConfigResponse) {
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+					path.Root("disks").AtListIndex(0),
 					"This is a warning",
 					"This is your final warning",
 				)
@@ -5999,7 +6368,7 @@ func TestServerReadDataSource(t *testing.T) {
 					"id":     tftypes.NewValue(tftypes.String, "a random id or something I dunno"),
 				})
 				resp.Diagnostics.AddAttributeWarning(
-					tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+					path.Root("disks").AtListIndex(0),
 					"This is a warning",
 					"This is your final warning",
 				)
@@ -8,6 +8,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/internal/totftypes"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -19,12 +21,23 @@ type State struct {
 
 // Get populates the struct passed as `target` with the entire state.
 func (s State) Get(ctx context.Context, target interface{}) diag.Diagnostics {
-	return reflect.Into(ctx, s.Schema.AttributeType(), s.Raw, target, reflect.Options{})
+	return reflect.Into(ctx, s.Schema.AttributeType(), s.Raw, target, reflect.Options{}, path.Empty())
+}
+
+// GetWithOptions populates the struct passed as `target` with the entire
+// state, like Get. The options allow null and unknown values to be read into
+// Go types which cannot represent them, such as string, as their empty value
+// rather than returning an error.
+func (s State) GetWithOptions(ctx context.Context, target interface{}, opts GetOptions) diag.Diagnostics {
+	return reflect.Into(ctx, s.Schema.AttributeType(), s.Raw, target, opts.reflectOptions(), path.Empty())
 }
 
 // GetAttribute retrieves the attribute found at `path` and populates the
-// `target` with the value.
-func (s State) GetAttribute(ctx context.Context, path *tftypes.AttributePath, target interface{}) diag.Diagnostics {
+// `target` with the value. A nested object or list element, such as an
+// element of a list nested attribute, can be read directly into a struct
+// tagged with the nested attribute names. Diagnostics for nested values are
+// returned at their full path, such as `disks[0].size`.
+func (s State) GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics {
 	attrValue, diags := s.getAttributeValue(ctx, path)
 
 	if diags.HasError() {
@@ -41,22 +54,50 @@ func (s State) GetAttribute(ctx context.Context, path *tftypes.AttributePath, ta
 		return diags
 	}
 
-	valueAsDiags := ValueAs(ctx, attrValue, target)
+	diags.Append(valueAs(ctx, attrValue, target, path)...)
 
-	// ValueAs does not have path information for its Diagnostics.
-	for idx, valueAsDiag := range valueAsDiags {
-		valueAsDiags[idx] = diag.WithPath(path, valueAsDiag)
-	}
+	return diags
+}
 
-	diags.Append(valueAsDiags...)
+// AttributeIsNull returns true if the value at `path` is null, without
+// converting it into an attr.Value. Values which cannot be reached, such as
+// an attribute of a null object, are null unless a parent value is unknown.
+func (s State) AttributeIsNull(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	isNull, _, diags := attributeNullOrUnknown(ctx, s.Schema, s.Raw, path, "State Read Error", "state")
 
-	return diags
+	return isNull, diags
+}
+
+// AttributeIsUnknown returns true if the value at `path` is unknown, or
+// cannot be reached because a parent value is unknown, without converting it
+// into an attr.Value.
+func (s State) AttributeIsUnknown(ctx context.Context, path path.Path) (bool, diag.Diagnostics) {
+	_, isUnknown, diags := attributeNullOrUnknown(ctx, s.Schema, s.Raw, path, "State Read Error", "state")
+
+	return isUnknown, diags
+}
+
+// PathMatches returns all paths in the state matching the path
+// expression, such as every element of a list nested attribute. An error
+// diagnostic is returned if the expression does not follow the schema.
+// Relative expressions are resolved from the root of the schema.
+func (s State) PathMatches(ctx context.Context, expression path.Expression) (path.Paths, diag.Diagnostics) {
+	return pathMatches(ctx, s.Schema, s.Raw, expression)
+}
+
+// GetAttributes retrieves the values found at each of the paths with a
+// single walk of the state, keyed by the String() of each path. Consumers
+// should assert the type of each returned value with the desired attr.Type.
+// This avoids walking the whole state for each path when many attributes
+// are inspected, such as in plan modifiers.
+func (s State) GetAttributes(ctx context.Context, paths path.Paths) (map[string]attr.Value, diag.Diagnostics) {
+	return getAttributeValues(ctx, s.Schema, s.Raw, paths, "State Read Error", "state")
 }
 
 // getAttributeValue retrieves the attribute found at `path` and returns it as an
 // attr.Value. Consumers should assert the type of the returned value with the
 // desired attr.Type.
-func (s State) getAttributeValue(ctx context.Context, path *tftypes.AttributePath) (attr.Value, diag.Diagnostics) {
+func (s State) getAttributeValue(ctx context.Context, path path.Path) (attr.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attrType, err := s.Schema.AttributeTypeAtPath(path)
@@ -128,7 +169,7 @@ func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 			),
 		}
 	}
-	newStateAttrValue, diags := reflect.FromValue(ctx, s.Schema.AttributeType(), val, tftypes.NewAttributePath())
+	newStateAttrValue, diags := reflect.FromValue(ctx, s.Schema.AttributeType(), val, path.Empty())
 	if diags.HasError() {
 		return diags
 	}
@@ -155,7 +196,36 @@ func (s *State) Set(ctx context.Context, val interface{}) diag.Diagnostics {
 // paths as necessary.
 //
 // Lists can only have the next element added according to the current length.
-func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, val interface{}) diag.Diagnostics {
+func (s *State) SetAttribute(ctx context.Context, path path.Path, val interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tfVal, tfValDiags := s.attributeWriteValue(ctx, path, val)
+	diags.Append(tfValDiags...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(s.setAttributeValue(ctx, path, tfVal)...)
+
+	return diags
+}
+
+// SetAttributes sets each of the writes in order, as if by calling
+// SetAttribute for each, stopping at the first write with an error. The
+// writes before the write with an error remain set.
+//
+// Writes to paths which already have a value are applied together, so each
+// parent value is rebuilt once rather than once per write. This avoids
+// copying the other attributes of an object for each write when many
+// attributes are set, such as in Read.
+func (s *State) SetAttributes(ctx context.Context, writes []AttributeWrite) diag.Diagnostics {
+	return setAttributes(ctx, s, writes)
+}
+
+// attributeWriteValue converts the Go value to the Terraform value to write
+// at `path`, validating it with the attribute type in the schema.
+func (s State) attributeWriteValue(ctx context.Context, path path.Path, val interface{}) (tftypes.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	attrType, err := s.Schema.AttributeTypeAtPath(path)
@@ -166,14 +236,14 @@ func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, v
 			"State Write Error",
 			"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	newVal, newValDiags := reflect.FromValue(ctx, attrType, val, path)
 	diags.Append(newValDiags...)
 
 	if diags.HasError() {
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	tfVal, err := newVal.ToTerraformValue(ctx)
@@ -184,25 +254,33 @@ func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, v
 			"State Write Error",
 			"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return diags
+		return tftypes.Value{}, diags
 	}
 
 	if attrTypeWithValidate, ok := attrType.(attr.TypeWithValidate); ok {
 		diags.Append(attrTypeWithValidate.Validate(ctx, tfVal, path)...)
 
 		if diags.HasError() {
-			return diags
+			return tftypes.Value{}, diags
 		}
 	}
 
-	transformFunc, transformFuncDiags := s.setAttributeTransformFunc(ctx, path, tfVal, nil)
-	diags.Append(transformFuncDiags...)
+	return tfVal, diags
+}
+
+// setAttributeValue sets the Terraform value at `path`, adding any parent
+// values as necessary.
+func (s *State) setAttributeValue(ctx context.Context, path path.Path, tfVal tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	replacePath, replaceVal, replaceDiags := s.setAttributeReplacement(ctx, path, tfVal, nil)
+	diags.Append(replaceDiags...)
 
 	if diags.HasError() {
 		return diags
 	}
 
-	s.Raw, err = tftypes.Transform(s.Raw, transformFunc)
+	newRaw, err := replaceValueAtPath(s.Raw, totftypes.AttributePath(replacePath), replaceVal)
 	if err != nil {
 		err = fmt.Errorf("Cannot transform state: %w", err)
 		diags.AddAttributeError(
@@ -213,15 +291,82 @@ func (s *State) SetAttribute(ctx context.Context, path *tftypes.AttributePath, v
 		return diags
 	}
 
+	s.Raw = newRaw
+
+	return diags
+}
+
+// replaceAttributeValues replaces the values at existing paths together.
+// The first path is used for the diagnostic of an error.
+func (s *State) replaceAttributeValues(replacements []valueReplacement, paths []path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	newRaw, err := replaceValuesAtPaths(s.Raw, replacements)
+	if err != nil {
+		err = fmt.Errorf("Cannot transform state: %w", err)
+		diags.AddAttributeError(
+			paths[0],
+			"State Write Error",
+			"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	s.Raw = newRaw
+
+	return diags
+}
+
+// RemoveAttribute sets the attribute at `path` to a null value of the type
+// defined by the schema.
+//
+// The attribute path must be valid with the current schema. If the attribute
+// path does not have a value, such as an attribute of a null parent object or
+// a list index beyond the current length, the state is not modified. List
+// elements are set to null rather than being removed from the list.
+func (s *State) RemoveAttribute(ctx context.Context, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	attrType, err := s.Schema.AttributeTypeAtPath(path)
+	if err != nil {
+		err = fmt.Errorf("error getting attribute type in schema: %w", err)
+		diags.AddAttributeError(
+			path,
+			"State Write Error",
+			"An unexpected error was encountered trying to remove an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	exists, pathExistsDiags := s.pathExists(ctx, path)
+	diags.Append(pathExistsDiags...)
+
+	if diags.HasError() || !exists {
+		return diags
+	}
+
+	nullValue := tftypes.NewValue(attrType.TerraformType(ctx), nil)
+
+	s.Raw, err = replaceValueAtPath(s.Raw, totftypes.AttributePath(path), nullValue)
+	if err != nil {
+		err = fmt.Errorf("Cannot transform state: %w", err)
+		diags.AddAttributeError(
+			path,
+			"State Write Error",
+			"An unexpected error was encountered trying to remove an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
 	return diags
 }
 
 // pathExists walks the current state and returns true if the path can be reached.
 // The value at the path may be null or unknown.
-func (s State) pathExists(_ context.Context, path *tftypes.AttributePath) (bool, diag.Diagnostics) {
+func (s State) pathExists(_ context.Context, path path.Path) (bool, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
-	_, remaining, err := tftypes.WalkAttributePath(s.Raw, path)
+	_, remaining, err := tftypes.WalkAttributePath(s.Raw, totftypes.AttributePath(path))
 
 	if err != nil {
 		if errors.Is(err, tftypes.ErrInvalidStep) {
@@ -240,29 +385,25 @@ func (s State) pathExists(_ context.Context, path *tftypes.AttributePath) (bool,
 	return len(remaining.Steps()) == 0, diags
 }
 
-// setAttributeTransformFunc recursively creates a value based on the current
+// setAttributeReplacement recursively creates a value based on the current
 // Plan values along the path. If the value at the path does not yet exist,
 // this will perform recursion to add the child value to a parent value,
-// creating the parent value if necessary.
-func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.AttributePath, tfVal tftypes.Value, diags diag.Diagnostics) (func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error), diag.Diagnostics) {
+// creating the parent value if necessary. It returns the deepest existing
+// path and the value to replace at that path.
+func (s State) setAttributeReplacement(ctx context.Context, path path.Path, tfVal tftypes.Value, diags diag.Diagnostics) (path.Path, tftypes.Value, diag.Diagnostics) {
 	exists, pathExistsDiags := s.pathExists(ctx, path)
 	diags.Append(pathExistsDiags...)
 
 	if diags.HasError() {
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	if exists {
 		// Overwrite existing value
-		return func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
-			if p.Equal(path) {
-				return tfVal, nil
-			}
-			return v, nil
-		}, diags
+		return path, tfVal, diags
 	}
 
-	parentPath := path.WithoutLastStep()
+	parentPath := path.ParentPath()
 	parentAttrType, err := s.Schema.AttributeTypeAtPath(parentPath)
 
 	if err != nil {
@@ -272,7 +413,7 @@ func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attr
 			"State Write Error",
 			"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	parentValue, err := s.terraformValueAtPath(parentPath)
@@ -283,7 +424,7 @@ func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attr
 			"Plan Read Error",
 			"An unexpected error was encountered trying to read an attribute from the plan. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
 		)
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	if parentValue.IsNull() || !parentValue.IsKnown() {
@@ -303,28 +444,28 @@ func (s State) setAttributeTransformFunc(ctx context.Context, path *tftypes.Attr
 		diags.Append(parentValueDiags...)
 
 		if diags.HasError() {
-			return nil, diags
+			return path, tftypes.Value{}, diags
 		}
 	}
 
 	var childValueDiags diag.Diagnostics
-	childStep := path.LastStep()
+	childStep, _ := path.Steps().LastStep()
 	parentValue, childValueDiags = upsertChildValue(ctx, parentPath, parentValue, childStep, tfVal)
 	diags.Append(childValueDiags...)
 
 	if diags.HasError() {
-		return nil, diags
+		return path, tftypes.Value{}, diags
 	}
 
 	if attrTypeWithValidate, ok := parentAttrType.(attr.TypeWithValidate); ok {
 		diags.Append(attrTypeWithValidate.Validate(ctx, parentValue, parentPath)...)
 
 		if diags.HasError() {
-			return nil, diags
+			return path, tftypes.Value{}, diags
 		}
 	}
 
-	return s.setAttributeTransformFunc(ctx, parentPath, parentValue, diags)
+	return s.setAttributeReplacement(ctx, parentPath, parentValue, diags)
 }
 
 // RemoveResource removes the entire resource from state.
@@ -332,8 +473,28 @@ func (s *State) RemoveResource(ctx context.Context) {
 	s.Raw = tftypes.NewValue(s.Schema.TerraformType(ctx), nil)
 }
 
-func (s State) terraformValueAtPath(path *tftypes.AttributePath) (tftypes.Value, error) {
-	rawValue, remaining, err := tftypes.WalkAttributePath(s.Raw, path)
+// Transaction calls fn with a copy of the state and only updates the state
+// with the changes fn made to the copy if fn returns no error diagnostics.
+// This prevents partially written state, such as when a multi-step Create
+// sets some attributes before a later step fails. The diagnostics returned
+// by fn are returned.
+func (s *State) Transaction(fn func(*State) diag.Diagnostics) diag.Diagnostics {
+	// tftypes.Value is immutable, so a shallow copy cannot modify s.
+	txState := *s
+
+	diags := fn(&txState)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	s.Raw = txState.Raw
+
+	return diags
+}
+
+func (s State) terraformValueAtPath(path path.Path) (tftypes.Value, error) {
+	rawValue, remaining, err := tftypes.WalkAttributePath(s.Raw, totftypes.AttributePath(path))
 	if err != nil {
 		return tftypes.Value{}, fmt.Errorf("%v still remains in the path: %w", remaining, err)
 	}
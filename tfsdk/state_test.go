@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	intreflect "github.com/hashicorp/terraform-plugin-framework/internal/reflect"
 	testtypes "github.com/hashicorp/terraform-plugin-framework/internal/testing/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
@@ -570,7 +572,7 @@ func TestStateGet_testTypes(t *testing.T) {
 					Interface: "",
 				},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -825,7 +827,7 @@ func TestStateGet_testTypes(t *testing.T) {
 					Interface: "SCSI",
 				},
 			},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -852,6 +854,86 @@ func TestStateGet_testTypes(t *testing.T) {
 	}
 }
 
+func TestStateGetWithOptions(t *testing.T) {
+	t.Parallel()
+
+	type testStruct struct {
+		Null    string `tfsdk:"null"`
+		Unknown string `tfsdk:"unknown"`
+	}
+
+	state := State{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"null":    tftypes.String,
+				"unknown": tftypes.String,
+			},
+		}, map[string]tftypes.Value{
+			"null":    tftypes.NewValue(tftypes.String, nil),
+			"unknown": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+		Schema: Schema{
+			Attributes: map[string]Attribute{
+				"null": {
+					Type:     types.StringType,
+					Optional: true,
+				},
+				"unknown": {
+					Type:     types.StringType,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		opts          GetOptions
+		expectedError bool
+	}{
+		"no-options": {
+			expectedError: true,
+		},
+		"UnhandledNullAsEmpty": {
+			opts: GetOptions{
+				UnhandledNullAsEmpty: true,
+			},
+			expectedError: true,
+		},
+		"UnhandledUnknownAsEmpty": {
+			opts: GetOptions{
+				UnhandledUnknownAsEmpty: true,
+			},
+			expectedError: true,
+		},
+		"UnhandledNullAsEmpty-UnhandledUnknownAsEmpty": {
+			opts: GetOptions{
+				UnhandledNullAsEmpty:    true,
+				UnhandledUnknownAsEmpty: true,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got testStruct
+
+			diags := state.GetWithOptions(context.Background(), &got, tc.opts)
+
+			if diags.HasError() != tc.expectedError {
+				t.Fatalf("expected error %t, got diagnostics: %v", tc.expectedError, diags)
+			}
+
+			if diff := cmp.Diff(got, testStruct{}); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestStateGetAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -948,7 +1030,7 @@ func TestStateGetAttribute(t *testing.T) {
 			expected: new(testtypes.String),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
 					intreflect.DiagNewAttributeValueIntoWrongType{
 						ValType:    reflect.TypeOf(types.String{Value: "namevalue"}),
 						TargetType: reflect.TypeOf(testtypes.String{}),
@@ -979,7 +1061,99 @@ func TestStateGetAttribute(t *testing.T) {
 			expected: new(bool),
 			expectedDiags: diag.Diagnostics{
 				diag.WithPath(
-					tftypes.NewAttributePath().WithAttributeName("name"),
+					path.Root("name"),
+					intreflect.DiagIntoIncompatibleType{
+						Val:        tftypes.NewValue(tftypes.String, "namevalue"),
+						TargetType: reflect.TypeOf(false),
+						Err:        fmt.Errorf("can't unmarshal %s into *%T, expected boolean", tftypes.String, false),
+					},
+				),
+			},
+		},
+		"object-struct": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"value": tftypes.String,
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"value": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"value": tftypes.NewValue(tftypes.String, "namevalue"),
+					}),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Attributes: SingleNestedAttributes(map[string]Attribute{
+								"value": {
+									Type:     types.StringType,
+									Required: true,
+								},
+							}),
+							Required: true,
+						},
+					},
+				},
+			},
+			target: new(struct {
+				Value string `tfsdk:"value"`
+			}),
+			expected: &struct {
+				Value string `tfsdk:"value"`
+			}{
+				Value: "namevalue",
+			},
+		},
+		"object-struct-incompatible-type": {
+			state: State{
+				Raw: tftypes.NewValue(tftypes.Object{
+					AttributeTypes: map[string]tftypes.Type{
+						"name": tftypes.Object{
+							AttributeTypes: map[string]tftypes.Type{
+								"value": tftypes.String,
+							},
+						},
+					},
+				}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.Object{
+						AttributeTypes: map[string]tftypes.Type{
+							"value": tftypes.String,
+						},
+					}, map[string]tftypes.Value{
+						"value": tftypes.NewValue(tftypes.String, "namevalue"),
+					}),
+				}),
+				Schema: Schema{
+					Attributes: map[string]Attribute{
+						"name": {
+							Attributes: SingleNestedAttributes(map[string]Attribute{
+								"value": {
+									Type:     types.StringType,
+									Required: true,
+								},
+							}),
+							Required: true,
+						},
+					},
+				},
+			},
+			target: new(struct {
+				Value bool `tfsdk:"value"`
+			}),
+			expected: new(struct {
+				Value bool `tfsdk:"value"`
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.WithPath(
+					path.Root("name").AtName("value"),
 					intreflect.DiagIntoIncompatibleType{
 						Val:        tftypes.NewValue(tftypes.String, "namevalue"),
 						TargetType: reflect.TypeOf(false),
@@ -1008,7 +1182,7 @@ func TestStateGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      new(testtypes.String),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -1030,7 +1204,7 @@ func TestStateGetAttribute(t *testing.T) {
 			},
 			target:        new(testtypes.String),
 			expected:      &testtypes.String{String: types.String{Value: "namevalue"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -1039,7 +1213,7 @@ func TestStateGetAttribute(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := tc.state.GetAttribute(context.Background(), tftypes.NewAttributePath().WithAttributeName("name"), tc.target)
+			diags := tc.state.GetAttribute(context.Background(), path.Root("name"), tc.target)
 
 			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
 				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
@@ -1057,7 +1231,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 
 	type testCase struct {
 		state         State
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      attr.Value
 		expectedDiags diag.Diagnostics
 	}
@@ -1084,7 +1258,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: nil,
 		},
 		"WithAttributeName-nonexistent": {
@@ -1105,11 +1279,11 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: nil,
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("other"),
+					path.Root("other"),
 					"State Read Error",
 					"An unexpected error was encountered trying to read an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"error getting attribute type in schema: AttributeName(\"other\") still remains in the path: could not find attribute or block \"other\" in schema",
@@ -1146,7 +1320,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-List-WithElementKeyInt": {
@@ -1182,7 +1356,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -1226,7 +1400,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedAttributes-null-WithElementKeyInt-WithAttributeName-Object": {
@@ -1283,7 +1457,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path: path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.Object{
 				Null:      true,
 				AttrTypes: map[string]attr.Type{"value": types.StringType},
@@ -1338,7 +1512,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-ListNestedBlocks-null-WithElementKeyInt-WithAttributeName": {
@@ -1407,7 +1581,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-ListNestedBlocks-WithElementKeyInt-WithAttributeName": {
@@ -1484,7 +1658,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0).WithAttributeName("sub_test"),
+			path:     path.Root("test").AtListIndex(0).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-null-WithElementKeyString": {
@@ -1517,7 +1691,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Map-WithElementKeyString": {
@@ -1553,7 +1727,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("sub_test"),
+			path:     path.Root("test").AtMapKey("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Map-WithElementKeyString-nonexistent": {
@@ -1588,7 +1762,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-null-WithElementKeyInt-WithAttributeName": {
@@ -1632,7 +1806,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-MapNestedAttributes-WithElementKeyString-WithAttributeName": {
@@ -1684,7 +1858,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("element").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtMapKey("element").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Object-WithAttributeName": {
@@ -1725,7 +1899,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-Set-null-WithElementKeyValue": {
@@ -1758,7 +1932,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-Set-WithElementKeyValue": {
@@ -1794,7 +1968,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "value")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "value")),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedAttributes-null-WithElementKeyValue-WithAttributeName": {
@@ -1838,13 +2012,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedAttributes-WithElementKeyValue-WithAttributeName": {
@@ -1896,13 +2070,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SetNestedBlocks-null-WithElementKeyValue-WithAttributeName": {
@@ -1971,13 +2145,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SetNestedBlocks-WithElementKeyValue-WithAttributeName": {
@@ -2054,13 +2228,13 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"sub_test": tftypes.String,
 				},
 			}, map[string]tftypes.Value{
 				"sub_test": tftypes.NewValue(tftypes.String, "value"),
-			})).WithAttributeName("sub_test"),
+			})).AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-SingleNestedAttributes-null-WithAttributeName": {
@@ -2100,7 +2274,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-SingleNestedAttributes-WithAttributeName": {
@@ -2142,7 +2316,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("sub_test"),
+			path:     path.Root("test").AtName("sub_test"),
 			expected: types.String{Value: "value"},
 		},
 		"WithAttributeName-String-null": {
@@ -2169,7 +2343,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Null: true},
 		},
 		"WithAttributeName-String-unknown": {
@@ -2196,7 +2370,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Unknown: true},
 		},
 		"WithAttributeName-String-value": {
@@ -2223,7 +2397,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: types.String{Value: "value"},
 		},
 		"AttrTypeWithValidateError": {
@@ -2250,9 +2424,9 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      nil,
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -2278,9 +2452,9 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("test"),
+			path:          path.Root("test"),
 			expected:      testtypes.String{String: types.String{Value: "value"}, CreatedBy: testtypes.StringTypeWithValidateWarning{}},
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("test"))},
 		},
 		"AttrTypeInt64WithValidateError-nested-missing-in-config": {
 			state: State{
@@ -2307,7 +2481,7 @@ func TestStateGetAttributeValue(t *testing.T) {
 					},
 				},
 			},
-			path:          tftypes.NewAttributePath().WithAttributeName("parent").WithAttributeName("test"),
+			path:          path.Root("parent").AtName("test"),
 			expected:      types.Int64{Null: true},
 			expectedDiags: nil,
 		},
@@ -2335,7 +2509,7 @@ func TestStatePathExists(t *testing.T) {
 
 	type testCase struct {
 		state         State
-		path          *tftypes.AttributePath
+		path          path.Path
 		expected      bool
 		expectedDiags diag.Diagnostics
 	}
@@ -2343,12 +2517,12 @@ func TestStatePathExists(t *testing.T) {
 	testCases := map[string]testCase{
 		"empty-path": {
 			state:    State{},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: false,
 		},
 		"empty-root": {
 			state:    State{},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"root": {
@@ -2369,7 +2543,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath(),
+			path:     path.Empty(),
 			expected: true,
 		},
 		"WithAttributeName": {
@@ -2390,7 +2564,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test"),
+			path:     path.Root("test"),
 			expected: true,
 		},
 		"WithAttributeName-mismatch": {
@@ -2411,7 +2585,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("other"),
+			path:     path.Root("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName": {
@@ -2446,7 +2620,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("nested"),
+			path:     path.Root("test").AtName("nested"),
 			expected: true,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-child": {
@@ -2481,7 +2655,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithAttributeName-mismatch-parent": {
@@ -2502,7 +2676,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithAttributeName("other"),
+			path:     path.Root("test").AtName("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt": {
@@ -2531,7 +2705,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-child": {
@@ -2560,7 +2734,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(1),
+			path:     path.Root("test").AtListIndex(1),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyInt-mismatch-parent": {
@@ -2581,7 +2755,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path:     path.Root("test").AtListIndex(0),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString": {
@@ -2610,7 +2784,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path:     path.Root("test").AtMapKey("key"),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-child": {
@@ -2639,7 +2813,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyString-mismatch-parent": {
@@ -2660,7 +2834,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("other"),
+			path:     path.Root("test").AtMapKey("other"),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue": {
@@ -2689,7 +2863,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			expected: true,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-child": {
@@ -2718,7 +2892,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 		"WithAttributeName.WithElementKeyValue-mismatch-parent": {
@@ -2739,7 +2913,7 @@ func TestStatePathExists(t *testing.T) {
 					},
 				},
 			},
-			path:     tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "othervalue")),
+			path:     path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "othervalue")),
 			expected: false,
 		},
 	}
@@ -3360,7 +3534,7 @@ func TestStateSet(t *testing.T) {
 				Name: "newvalue",
 			},
 			expected:      tftypes.Value{},
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -3386,7 +3560,7 @@ func TestStateSet(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "newvalue"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestWarningDiagnostic(path.Root("name"))},
 		},
 	}
 
@@ -3413,7 +3587,7 @@ func TestStateSetAttribute(t *testing.T) {
 
 	type testCase struct {
 		state         State
-		path          *tftypes.AttributePath
+		path          path.Path
 		val           interface{}
 		expected      tftypes.Value
 		expectedDiags diag.Diagnostics
@@ -3478,7 +3652,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3587,7 +3761,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(2),
+			path: path.Root("disks").AtListIndex(2),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3630,7 +3804,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 3 as list currently has 1 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -3685,7 +3859,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3775,7 +3949,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -3808,7 +3982,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -3847,7 +4021,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key2"),
+			path: path.Root("test").AtMapKey("key2"),
 			val:  "key2value",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3896,7 +4070,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -3972,7 +4146,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4079,7 +4253,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4153,7 +4327,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4197,7 +4371,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4281,7 +4455,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -4365,7 +4539,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -4418,7 +4592,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4461,7 +4635,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  2,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4515,7 +4689,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 				Other     string `tfsdk:"other"`
@@ -4584,7 +4758,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk").WithAttributeName("interface"),
+			path: path.Root("scratch_disk").AtName("interface"),
 			val:  "NVME",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4638,7 +4812,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4722,7 +4896,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -4815,7 +4989,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags").WithElementKeyValue(tftypes.NewValue(tftypes.String, "three")),
+			path: path.Root("tags").AtSetValue(tftypes.NewValue(tftypes.String, "three")),
 			val:  "three",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4859,7 +5033,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4887,7 +5061,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath(),
+			path: path.Empty(),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4896,7 +5070,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath(),
+					path.Empty(),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to convert the Terraform value. This is always an error in the provider. Please report the following to the provider developer:\n\nexpected tftypes.Object[\"test\":tftypes.Bool], got tftypes.Bool",
 				),
@@ -4923,7 +5097,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  false,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -4960,7 +5134,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5005,7 +5179,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyInt(0),
+			path: path.Root("test").AtListIndex(0),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5023,7 +5197,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-List-Element": {
@@ -5064,7 +5238,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5144,7 +5318,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(1),
+			path: path.Root("disks").AtListIndex(1),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5167,7 +5341,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}, nil),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("disks"),
+					path.Root("disks"),
 					"Value Conversion Error",
 					"An unexpected error was encountered trying to create a value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
 						"Cannot add list element 2 as list currently has 0 length. To prevent ambiguity, only the next element can be added to a list. Add empty elements into the list prior to this call, if appropriate.",
@@ -5202,7 +5376,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0),
+			path: path.Root("disks").AtListIndex(0),
 			val: struct {
 				ID                 string `tfsdk:"id"`
 				DeleteWithInstance bool   `tfsdk:"delete_with_instance"`
@@ -5244,7 +5418,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyInt(0).WithAttributeName("id")),
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtListIndex(0).AtName("id")),
 			},
 		},
 		"write-Map": {
@@ -5272,7 +5446,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val: map[string]string{
 				"newkey": "newvalue",
 			},
@@ -5319,7 +5493,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5337,7 +5511,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Map-Element": {
@@ -5365,7 +5539,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5408,7 +5582,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key"),
+			path: path.Root("test").AtMapKey("key"),
 			val:  "keyvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5426,7 +5600,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyString("key")),
+				testtypes.TestWarningDiagnostic(path.Root("test").AtMapKey("key")),
 			},
 		},
 		"write-Number": {
@@ -5450,7 +5624,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  1,
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5491,7 +5665,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("scratch_disk"),
+			path: path.Root("scratch_disk"),
 			val: struct {
 				Interface string `tfsdk:"interface"`
 			}{
@@ -5540,7 +5714,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("tags"),
+			path: path.Root("tags"),
 			val:  []string{"one", "two"},
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5595,7 +5769,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -5673,7 +5847,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "testvalue")),
+			path: path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "testvalue")),
 			val:  "testvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5691,7 +5865,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("test")),
+				testtypes.TestWarningDiagnostic(path.Root("test")),
 			},
 		},
 		"write-Set-Element-AttrTypeWithValidateWarning": {
@@ -5732,7 +5906,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+			path: path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
 					"id":                   tftypes.String,
 					"delete_with_instance": tftypes.Bool,
@@ -5782,7 +5956,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"other": tftypes.NewValue(tftypes.String, nil),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("disks").WithElementKeyValue(tftypes.NewValue(tftypes.Object{
+				testtypes.TestWarningDiagnostic(path.Root("disks").AtSetValue(tftypes.NewValue(tftypes.Object{
 					AttributeTypes: map[string]tftypes.Type{
 						"id":                   tftypes.String,
 						"delete_with_instance": tftypes.Bool,
@@ -5790,7 +5964,7 @@ func TestStateSetAttribute(t *testing.T) {
 				}, map[string]tftypes.Value{
 					"id":                   tftypes.NewValue(tftypes.String, "mynewdisk"),
 					"delete_with_instance": tftypes.NewValue(tftypes.Bool, true),
-				})).WithAttributeName("id")),
+				})).AtName("id")),
 			},
 		},
 		"write-String": {
@@ -5814,7 +5988,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("test"),
+			path: path.Root("test"),
 			val:  "newvalue",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5844,7 +6018,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5853,7 +6027,7 @@ func TestStateSetAttribute(t *testing.T) {
 			}, map[string]tftypes.Value{
 				"name": tftypes.NewValue(tftypes.String, "originalname"),
 			}),
-			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(tftypes.NewAttributePath().WithAttributeName("name"))},
+			expectedDiags: diag.Diagnostics{testtypes.TestErrorDiagnostic(path.Root("name"))},
 		},
 		"AttrTypeWithValidateWarning": {
 			state: State{
@@ -5873,7 +6047,7 @@ func TestStateSetAttribute(t *testing.T) {
 					},
 				},
 			},
-			path: tftypes.NewAttributePath().WithAttributeName("name"),
+			path: path.Root("name"),
 			val:  "newname",
 			expected: tftypes.NewValue(tftypes.Object{
 				AttributeTypes: map[string]tftypes.Type{
@@ -5883,7 +6057,7 @@ func TestStateSetAttribute(t *testing.T) {
 				"name": tftypes.NewValue(tftypes.String, "newname"),
 			}),
 			expectedDiags: diag.Diagnostics{
-				testtypes.TestWarningDiagnostic(tftypes.NewAttributePath().WithAttributeName("name")),
+				testtypes.TestWarningDiagnostic(path.Root("name")),
 			},
 		},
 	}
@@ -5905,3 +6079,465 @@ func TestStateSetAttribute(t *testing.T) {
 		})
 	}
 }
+
+func TestStateRemoveAttribute(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"disks":  tftypes.List{ElementType: nestedType},
+			"name":   tftypes.String,
+			"nested": nestedType,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+	value := func(disks interface{}, name interface{}, nested interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"disks":  tftypes.NewValue(tftypes.List{ElementType: nestedType}, disks),
+			"name":   tftypes.NewValue(tftypes.String, name),
+			"nested": tftypes.NewValue(nestedType, nested),
+		})
+	}
+	disk := func(id interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, id),
+		})
+	}
+
+	testCases := map[string]struct {
+		raw           tftypes.Value
+		path          path.Path
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"root": {
+			raw:      value([]tftypes.Value{disk("disk0")}, "test", nil),
+			path:     path.Root("name"),
+			expected: value([]tftypes.Value{disk("disk0")}, nil, nil),
+		},
+		"list": {
+			raw:      value([]tftypes.Value{disk("disk0")}, "test", nil),
+			path:     path.Root("disks"),
+			expected: value(nil, "test", nil),
+		},
+		"list-element": {
+			raw:      value([]tftypes.Value{disk("disk0"), disk("disk1")}, "test", nil),
+			path:     path.Root("disks").AtListIndex(0),
+			expected: value([]tftypes.Value{tftypes.NewValue(nestedType, nil), disk("disk1")}, "test", nil),
+		},
+		"list-element-attribute": {
+			raw:      value([]tftypes.Value{disk("disk0"), disk("disk1")}, "test", nil),
+			path:     path.Root("disks").AtListIndex(1).AtName("id"),
+			expected: value([]tftypes.Value{disk("disk0"), disk(nil)}, "test", nil),
+		},
+		"list-element-missing": {
+			raw:      value([]tftypes.Value{disk("disk0")}, "test", nil),
+			path:     path.Root("disks").AtListIndex(1),
+			expected: value([]tftypes.Value{disk("disk0")}, "test", nil),
+		},
+		"null-parent": {
+			raw:      value(nil, "test", nil),
+			path:     path.Root("nested").AtName("id"),
+			expected: value(nil, "test", nil),
+		},
+		"invalid-path": {
+			raw:      value(nil, "test", nil),
+			path:     path.Root("missing"),
+			expected: value(nil, "test", nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"State Write Error",
+					"An unexpected error was encountered trying to remove an attribute from the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"error getting attribute type in schema: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := State{
+				Raw:    tc.raw,
+				Schema: schema,
+			}
+
+			diags := state.RemoveAttribute(context.Background(), tc.path)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateSetAttributes(t *testing.T) {
+	t.Parallel()
+
+	type testDisk struct {
+		ID string `tfsdk:"id"`
+	}
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"disks":  tftypes.List{ElementType: nestedType},
+			"name":   tftypes.String,
+			"nested": nestedType,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"disks": {
+				Attributes: ListNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}, ListNestedAttributesOptions{}),
+				Optional: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Optional: true,
+			},
+			"nested": {
+				Attributes: SingleNestedAttributes(map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+				}),
+				Optional: true,
+			},
+		},
+	}
+	value := func(disks interface{}, name interface{}, nested interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"disks":  tftypes.NewValue(tftypes.List{ElementType: nestedType}, disks),
+			"name":   tftypes.NewValue(tftypes.String, name),
+			"nested": tftypes.NewValue(nestedType, nested),
+		})
+	}
+	disk := func(id interface{}) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, id),
+		})
+	}
+
+	testCases := map[string]struct {
+		raw           tftypes.Value
+		writes        []AttributeWrite
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"none": {
+			raw:      value([]tftypes.Value{disk("disk0")}, "test", nil),
+			expected: value([]tftypes.Value{disk("disk0")}, "test", nil),
+		},
+		"existing": {
+			raw: value([]tftypes.Value{disk("disk0"), disk("disk1")}, "test", nil),
+			writes: []AttributeWrite{
+				{Path: path.Root("disks").AtListIndex(1).AtName("id"), Value: "new-disk1"},
+				{Path: path.Root("name"), Value: "new"},
+				{Path: path.Root("disks").AtListIndex(0).AtName("id"), Value: "new-disk0"},
+			},
+			expected: value([]tftypes.Value{disk("new-disk0"), disk("new-disk1")}, "new", nil),
+		},
+		"parent-then-child": {
+			raw: value([]tftypes.Value{disk("disk0")}, "test", nil),
+			writes: []AttributeWrite{
+				{Path: path.Root("disks"), Value: []testDisk{{ID: "disk0"}, {ID: "disk1"}}},
+				{Path: path.Root("disks").AtListIndex(1).AtName("id"), Value: "new-disk1"},
+			},
+			expected: value([]tftypes.Value{disk("disk0"), disk("new-disk1")}, "test", nil),
+		},
+		"child-then-parent": {
+			raw: value([]tftypes.Value{disk("disk0")}, "test", nil),
+			writes: []AttributeWrite{
+				{Path: path.Root("disks").AtListIndex(0).AtName("id"), Value: "new-disk0"},
+				{Path: path.Root("disks"), Value: []testDisk{{ID: "disk1"}}},
+			},
+			expected: value([]tftypes.Value{disk("disk1")}, "test", nil),
+		},
+		"new-values": {
+			raw: value([]tftypes.Value{disk("disk0")}, "test", nil),
+			writes: []AttributeWrite{
+				{Path: path.Root("name"), Value: "new"},
+				{Path: path.Root("disks").AtListIndex(1), Value: testDisk{ID: "disk1"}},
+				{Path: path.Root("nested").AtName("id"), Value: "nested"},
+				{Path: path.Root("disks").AtListIndex(0).AtName("id"), Value: "new-disk0"},
+			},
+			expected: value(
+				[]tftypes.Value{disk("new-disk0"), disk("disk1")},
+				"new",
+				map[string]tftypes.Value{
+					"id": tftypes.NewValue(tftypes.String, "nested"),
+				},
+			),
+		},
+		"invalid-path": {
+			raw: value(nil, "test", nil),
+			writes: []AttributeWrite{
+				{Path: path.Root("name"), Value: "new"},
+				{Path: path.Root("missing"), Value: "new"},
+				{Path: path.Root("nested").AtName("id"), Value: "nested"},
+			},
+			expected: value(nil, "new", nil),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("missing"),
+					"State Write Error",
+					"An unexpected error was encountered trying to write an attribute to the state. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"error getting attribute type in schema: AttributeName(\"missing\") still remains in the path: could not find attribute or block \"missing\" in schema",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := State{
+				Raw:    tc.raw,
+				Schema: schema,
+			}
+
+			diags := state.SetAttributes(context.Background(), tc.writes)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStateTransaction(t *testing.T) {
+	t.Parallel()
+
+	schemaType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":   tftypes.String,
+			"name": tftypes.String,
+		},
+	}
+	schema := Schema{
+		Attributes: map[string]Attribute{
+			"id": {
+				Type:     types.StringType,
+				Computed: true,
+			},
+			"name": {
+				Type:     types.StringType,
+				Required: true,
+			},
+		},
+	}
+	raw := tftypes.NewValue(schemaType, map[string]tftypes.Value{
+		"id":   tftypes.NewValue(tftypes.String, nil),
+		"name": tftypes.NewValue(tftypes.String, "test"),
+	})
+
+	testCases := map[string]struct {
+		fn            func(*State) diag.Diagnostics
+		expected      tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"commit": {
+			fn: func(s *State) diag.Diagnostics {
+				diags := s.SetAttribute(context.Background(), path.Root("id"), "test-id")
+				diags.AddWarning("test warning summary", "test warning detail")
+
+				return diags
+			},
+			expected: tftypes.NewValue(schemaType, map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "test-id"),
+				"name": tftypes.NewValue(tftypes.String, "test"),
+			}),
+			expectedDiags: diag.Diagnostics{
+				diag.NewWarningDiagnostic("test warning summary", "test warning detail"),
+			},
+		},
+		"rollback": {
+			fn: func(s *State) diag.Diagnostics {
+				diags := s.SetAttribute(context.Background(), path.Root("id"), "test-id")
+				diags.AddError("test error summary", "test error detail")
+
+				return diags
+			},
+			expected: raw,
+			expectedDiags: diag.Diagnostics{
+				diag.NewErrorDiagnostic("test error summary", "test error detail"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			state := State{
+				Raw:    raw,
+				Schema: schema,
+			}
+
+			diags := state.Transaction(tc.fn)
+
+			if diff := cmp.Diff(diags, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics (+wanted, -got): %s", diff)
+			}
+
+			if diff := cmp.Diff(state.Raw, tc.expected); diff != "" {
+				t.Errorf("unexpected value (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+// benchmarkStateAttributes returns a schema of attributeCount string
+// attributes, a null value for each and the path of each.
+func benchmarkStateAttributes(attributeCount int) (Schema, tftypes.Value, path.Paths) {
+	attributes := make(map[string]Attribute, attributeCount)
+	attributeTypes := make(map[string]tftypes.Type, attributeCount)
+	attributeValues := make(map[string]tftypes.Value, attributeCount)
+	paths := make(path.Paths, 0, attributeCount)
+
+	for idx := 0; idx < attributeCount; idx++ {
+		name := "attr" + strconv.Itoa(idx)
+		attributes[name] = Attribute{
+			Type:     types.StringType,
+			Optional: true,
+		}
+		attributeTypes[name] = tftypes.String
+		attributeValues[name] = tftypes.NewValue(tftypes.String, nil)
+		paths = append(paths, path.Root(name))
+	}
+
+	schema := Schema{
+		Attributes: attributes,
+	}
+	raw := tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, attributeValues)
+
+	return schema, raw, paths
+}
+
+func benchmarkStateSetAttribute(b *testing.B, attributeCount int) {
+	ctx := context.Background()
+	schema, raw, paths := benchmarkStateAttributes(attributeCount)
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		state := State{
+			Raw:    raw,
+			Schema: schema,
+		}
+
+		for _, p := range paths {
+			if diags := state.SetAttribute(ctx, p, "value"); diags.HasError() {
+				b.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		}
+	}
+}
+
+func BenchmarkStateSetAttribute10(b *testing.B) {
+	benchmarkStateSetAttribute(b, 10)
+}
+
+func BenchmarkStateSetAttribute100(b *testing.B) {
+	benchmarkStateSetAttribute(b, 100)
+}
+
+func BenchmarkStateSetAttribute1000(b *testing.B) {
+	benchmarkStateSetAttribute(b, 1000)
+}
+
+func benchmarkStateSetAttributes(b *testing.B, attributeCount int) {
+	ctx := context.Background()
+	schema, raw, paths := benchmarkStateAttributes(attributeCount)
+	writes := make([]AttributeWrite, 0, len(paths))
+
+	for _, p := range paths {
+		writes = append(writes, AttributeWrite{
+			Path:  p,
+			Value: "value",
+		})
+	}
+
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		state := State{
+			Raw:    raw,
+			Schema: schema,
+		}
+
+		if diags := state.SetAttributes(ctx, writes); diags.HasError() {
+			b.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+}
+
+func BenchmarkStateSetAttributes10(b *testing.B) {
+	benchmarkStateSetAttributes(b, 10)
+}
+
+func BenchmarkStateSetAttributes100(b *testing.B) {
+	benchmarkStateSetAttributes(b, 100)
+}
+
+func BenchmarkStateSetAttributes1000(b *testing.B) {
+	benchmarkStateSetAttributes(b, 1000)
+}
@@ -0,0 +1,132 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+// StringNormalizer handles the common situation of an optional and computed
+// string attribute where the practitioner supplies a value and the remote
+// API returns an equivalent, normalized variant, such as a lowercased name or
+// reformatted JSON document. Without normalization, Terraform would report
+// the difference as drift or an inconsistent result after apply.
+//
+// The state keeps the value as written by the practitioner, as long as it
+// normalizes to the same value as the remote value. Use Attribute to declare
+// the attribute, then Refresh when setting its value from the remote API in
+// Create, Read, and Update.
+type StringNormalizer struct {
+	// Normalize returns the normalized form of a value. It must return
+	// the same result for any values which the remote API considers
+	// equivalent.
+	Normalize func(string) string
+
+	// Description describes the normalization in plain text formatting,
+	// such as "Letter case is ignored.".
+	Description string
+
+	// MarkdownDescription describes the normalization in Markdown
+	// formatting. If empty, Description is used.
+	MarkdownDescription string
+}
+
+// Attribute returns an optional and computed string Attribute with the
+// description and the StringNormalizer plan modifier. Additional fields,
+// such as Validators, can be set on the returned Attribute.
+func (n StringNormalizer) Attribute(description string) Attribute {
+	return Attribute{
+		Type:        types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Description: description,
+		PlanModifiers: AttributePlanModifiers{
+			n.PlanModifier(),
+		},
+	}
+}
+
+// PlanModifier returns an AttributePlanModifier which plans the prior state
+// value when the configured value is not set, or when it normalizes to the
+// same value as the prior state value.
+func (n StringNormalizer) PlanModifier() AttributePlanModifier {
+	return stringNormalizerModifier{
+		normalizer: n,
+	}
+}
+
+// Refresh returns the value which should be saved into state, given the
+// current value, such as from the plan or prior state, and the remote value
+// returned by the API. The current value is returned if it normalizes to the
+// same value as the remote value, otherwise the remote value is returned.
+func (n StringNormalizer) Refresh(current types.String, remote types.String) types.String {
+	if current.Null || current.Unknown || remote.Null || remote.Unknown {
+		return remote
+	}
+
+	if n.Normalize(current.Value) == n.Normalize(remote.Value) {
+		return current
+	}
+
+	return remote
+}
+
+type stringNormalizerModifier struct {
+	normalizer StringNormalizer
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m stringNormalizerModifier) Description(_ context.Context) string {
+	return m.normalizer.Description
+}
+
+// MarkdownDescription returns a markdown description of the plan modifier.
+func (m stringNormalizerModifier) MarkdownDescription(_ context.Context) string {
+	if m.normalizer.MarkdownDescription != "" {
+		return m.normalizer.MarkdownDescription
+	}
+
+	return m.normalizer.Description
+}
+
+// Modify sets the attribute's plan to the prior state value if the config
+// value is null or normalizes to the same value as the prior state value.
+func (m stringNormalizerModifier) Modify(ctx context.Context, req ModifyAttributePlanRequest, resp *ModifyAttributePlanResponse) {
+	if req.AttributeConfig == nil || req.AttributeState == nil || resp.AttributePlan == nil {
+		// shouldn't happen, but let's not panic if it does
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		// if we're deleting the resource, there is nothing to plan
+		return
+	}
+
+	var config, state types.String
+
+	diags := ValueAs(ctx, req.AttributeConfig, &config)
+	diags.Append(ValueAs(ctx, req.AttributeState, &state)...)
+
+	for idx, d := range diags {
+		diags[idx] = diag.WithPath(req.AttributePath, d)
+	}
+
+	resp.Diagnostics.Append(diags...)
+
+	if diags.HasError() {
+		return
+	}
+
+	// interpolated configuration values must remain unknown and there is
+	// nothing to preserve without a prior state value
+	if config.Unknown || state.Null || state.Unknown {
+		return
+	}
+
+	if config.Null || m.normalizer.Normalize(config.Value) == m.normalizer.Normalize(state.Value) {
+		tfsdklog.Debug(ctx, "planning prior state value for normalized string", "path", req.AttributePath)
+		resp.AttributePlan = req.AttributeState
+	}
+}
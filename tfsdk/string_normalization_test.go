@@ -0,0 +1,163 @@
+package tfsdk
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testLowercaseNormalizer = StringNormalizer{
+	Normalize:   strings.ToLower,
+	Description: "Letter case is ignored.",
+}
+
+func TestStringNormalizerAttribute(t *testing.T) {
+	t.Parallel()
+
+	got := testLowercaseNormalizer.Attribute("test description")
+
+	if !got.Optional || !got.Computed {
+		t.Errorf("expected optional and computed attribute")
+	}
+
+	if len(got.PlanModifiers) != 1 {
+		t.Fatalf("expected 1 plan modifier, got %d", len(got.PlanModifiers))
+	}
+
+	if _, ok := got.PlanModifiers[0].(stringNormalizerModifier); !ok {
+		t.Errorf("expected stringNormalizerModifier, got %T", got.PlanModifiers[0])
+	}
+}
+
+func TestStringNormalizerModifier(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		state    attr.Value
+		plan     attr.Value
+		config   attr.Value
+		expected attr.Value
+	}
+
+	tests := map[string]testCase{
+		"null-state": {
+			state:    types.String{Null: true},
+			plan:     types.String{Value: "TEST"},
+			config:   types.String{Value: "TEST"},
+			expected: types.String{Value: "TEST"},
+		},
+		"null-config": {
+			state:    types.String{Value: "test"},
+			plan:     types.String{Unknown: true},
+			config:   types.String{Null: true},
+			expected: types.String{Value: "test"},
+		},
+		"unknown-config": {
+			state:    types.String{Value: "test"},
+			plan:     types.String{Unknown: true},
+			config:   types.String{Unknown: true},
+			expected: types.String{Unknown: true},
+		},
+		"normalized-equal": {
+			state:    types.String{Value: "Test"},
+			plan:     types.String{Value: "TEST"},
+			config:   types.String{Value: "TEST"},
+			expected: types.String{Value: "Test"},
+		},
+		"normalized-different": {
+			state:    types.String{Value: "Test"},
+			plan:     types.String{Value: "other"},
+			config:   types.String{Value: "other"},
+			expected: types.String{Value: "other"},
+		},
+	}
+
+	for name, tc := range tests {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := Schema{
+				Attributes: map[string]Attribute{
+					"a": testLowercaseNormalizer.Attribute(""),
+				},
+			}
+
+			req := ModifyAttributePlanRequest{
+				AttributePath: path.Root("a"),
+				Plan: Plan{
+					Schema: schema,
+					Raw: tftypes.NewValue(schema.TerraformType(context.Background()), map[string]tftypes.Value{
+						"a": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					}),
+				},
+				AttributeConfig: tc.config,
+				AttributeState:  tc.state,
+				AttributePlan:   tc.plan,
+			}
+			resp := &ModifyAttributePlanResponse{
+				AttributePlan: req.AttributePlan,
+			}
+
+			testLowercaseNormalizer.PlanModifier().Modify(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("Unexpected diagnostics: %s", resp.Diagnostics)
+			}
+			if diff := cmp.Diff(tc.expected, resp.AttributePlan); diff != "" {
+				t.Errorf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringNormalizerRefresh(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		current  types.String
+		remote   types.String
+		expected types.String
+	}{
+		"null-current": {
+			current:  types.String{Null: true},
+			remote:   types.String{Value: "test"},
+			expected: types.String{Value: "test"},
+		},
+		"null-remote": {
+			current:  types.String{Value: "test"},
+			remote:   types.String{Null: true},
+			expected: types.String{Null: true},
+		},
+		"normalized-equal": {
+			current:  types.String{Value: "TEST"},
+			remote:   types.String{Value: "test"},
+			expected: types.String{Value: "TEST"},
+		},
+		"normalized-different": {
+			current:  types.String{Value: "TEST"},
+			remote:   types.String{Value: "other"},
+			expected: types.String{Value: "other"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testLowercaseNormalizer.Refresh(tc.current, tc.remote)
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package tfsdk
+
+import (
+	"context"
+)
+
+type terraformVersionContextKey struct{}
+
+// TerraformVersionFromContext returns the version of Terraform executing the
+// RPC being served, such as "1.1.7". Terraform only sends its version when
+// configuring the provider, so it is an empty string for RPCs served before
+// ConfigureProvider, such as GetProviderSchema and configuration validation,
+// and outside of an RPC.
+//
+// The version is intended for logging, User-Agent headers, and clearer
+// diagnostics when functionality requires a newer Terraform version.
+// Providers should not otherwise try to gate behavior on Terraform versions.
+//
+// The client capabilities of Terraform, such as support for deferred
+// actions, are not available as the protocol version implemented by the
+// framework does not include them.
+func TerraformVersionFromContext(ctx context.Context) string {
+	version, _ := ctx.Value(terraformVersionContextKey{}).(string)
+
+	return version
+}
+
+// contextWithTerraformVersion returns a new context including the Terraform
+// version, if known.
+func contextWithTerraformVersion(ctx context.Context, version string) context.Context {
+	if version == "" {
+		return ctx
+	}
+
+	return context.WithValue(ctx, terraformVersionContextKey{}, version)
+}
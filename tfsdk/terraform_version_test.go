@@ -0,0 +1,54 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTerraformVersionFromContext(t *testing.T) {
+	t.Parallel()
+
+	if got := TerraformVersionFromContext(context.Background()); got != "" {
+		t.Errorf("expected no Terraform version, got %q", got)
+	}
+
+	s := &server{
+		p: testServeProviderWithConfigureData{&testServeProvider{}},
+	}
+
+	ctx, done := s.registerContext(context.Background())
+	defer done()
+
+	if got := TerraformVersionFromContext(ctx); got != "" {
+		t.Errorf("expected no Terraform version before ConfigureProvider, got %q", got)
+	}
+
+	config, err := tfprotov6.NewDynamicValue(tftypes.Object{}, tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{}))
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	resp, err := s.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{
+		Config:           &config,
+		TerraformVersion: "1.1.7",
+	})
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	ctx, done = s.registerContext(context.Background())
+	defer done()
+
+	if got := TerraformVersionFromContext(ctx); got != "1.1.7" {
+		t.Errorf("expected Terraform version 1.1.7, got %q", got)
+	}
+}
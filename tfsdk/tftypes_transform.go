@@ -1,8 +1,275 @@
 package tfsdk
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
-// transformFunc is the signature expected for tftypes.Transform functions.
-type transformFunc func(*tftypes.AttributePath, tftypes.Value) (tftypes.Value, error)
+// valueReplacement is a value to replace at a path with
+// replaceValuesAtPaths.
+type valueReplacement struct {
+	path  *tftypes.AttributePath
+	value tftypes.Value
+}
+
+// stepsReplacement is a valueReplacement with the steps remaining beneath
+// the value being rebuilt.
+type stepsReplacement struct {
+	steps []tftypes.AttributePathStep
+	value tftypes.Value
+}
+
+// stepReplacements are the replacements beneath one child of a value being
+// rebuilt, with the steps remaining beneath that child.
+type stepReplacements struct {
+	step         tftypes.AttributePathStep
+	replacements []stepsReplacement
+}
+
+// replaceValueAtPath returns a copy of root with the value at path replaced
+// by val. The path must already exist in root.
+//
+// Unlike tftypes.Transform, which rebuilds every value in the tree, only the
+// values along the path are rebuilt. All other values are shared with root,
+// which is safe since tftypes.Value is immutable.
+func replaceValueAtPath(root tftypes.Value, path *tftypes.AttributePath, val tftypes.Value) (tftypes.Value, error) {
+	return replaceValuesAtPaths(root, []valueReplacement{{path: path, value: val}})
+}
+
+// replaceValuesAtPaths returns a copy of root with the values at each path
+// replaced. The paths must already exist in root and no path may be beneath
+// another, so the order of replacements does not matter.
+//
+// Each value along the paths is rebuilt once with all of the replacements
+// beneath it, so replacing many attributes of an object copies its other
+// attributes once, rather than once per replacement as repeated calls of
+// replaceValueAtPath would.
+func replaceValuesAtPaths(root tftypes.Value, replacements []valueReplacement) (tftypes.Value, error) {
+	steps := make([]stepsReplacement, 0, len(replacements))
+
+	for _, replacement := range replacements {
+		steps = append(steps, stepsReplacement{
+			steps: replacement.path.Steps(),
+			value: replacement.value,
+		})
+	}
+
+	return replaceValuesAtSteps(root, steps, tftypes.NewAttributePath())
+}
+
+// replaceValuesAtSteps is the recursive implementation of
+// replaceValuesAtPaths, where current is the path of value.
+func replaceValuesAtSteps(value tftypes.Value, replacements []stepsReplacement, current *tftypes.AttributePath) (tftypes.Value, error) {
+	if len(replacements) == 0 {
+		return value, nil
+	}
+
+	for _, replacement := range replacements {
+		if len(replacement.steps) == 0 {
+			return replacement.value, nil
+		}
+	}
+
+	if !value.IsKnown() || value.IsNull() {
+		return tftypes.Value{}, current.NewErrorf("cannot replace value beneath null or unknown value")
+	}
+
+	groups := groupStepsReplacements(replacements)
+	typ := value.Type()
+
+	for _, group := range groups {
+		var ok bool
+
+		switch group.step.(type) {
+		case tftypes.AttributeName:
+			ok = typ.Is(tftypes.Object{})
+		case tftypes.ElementKeyString:
+			ok = typ.Is(tftypes.Map{})
+		case tftypes.ElementKeyInt:
+			ok = typ.Is(tftypes.List{}) || typ.Is(tftypes.Tuple{})
+		case tftypes.ElementKeyValue:
+			ok = typ.Is(tftypes.Set{})
+		default:
+			return tftypes.Value{}, current.NewError(fmt.Errorf("unsupported step type %T", group.step))
+		}
+
+		if !ok {
+			return tftypes.Value{}, current.NewErrorf("cannot apply %T to %s", group.step, typ)
+		}
+	}
+
+	switch {
+	case typ.Is(tftypes.Object{}):
+		var attrs map[string]tftypes.Value
+
+		if err := value.As(&attrs); err != nil {
+			return tftypes.Value{}, current.NewError(err)
+		}
+
+		newAttrs := make(map[string]tftypes.Value, len(attrs))
+
+		for name, attr := range attrs {
+			newAttrs[name] = attr
+		}
+
+		for _, group := range groups {
+			name := string(group.step.(tftypes.AttributeName))
+			child, ok := attrs[name]
+
+			if !ok {
+				return tftypes.Value{}, current.NewErrorf("attribute %q not found", name)
+			}
+
+			newChild, err := replaceValuesAtSteps(child, group.replacements, current.WithAttributeName(name))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			newAttrs[name] = newChild
+		}
+
+		return tftypes.NewValue(typ, newAttrs), nil
+	case typ.Is(tftypes.Map{}):
+		var elems map[string]tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			return tftypes.Value{}, current.NewError(err)
+		}
+
+		newElems := make(map[string]tftypes.Value, len(elems))
+
+		for key, elem := range elems {
+			newElems[key] = elem
+		}
+
+		for _, group := range groups {
+			key := string(group.step.(tftypes.ElementKeyString))
+			child, ok := elems[key]
+
+			if !ok {
+				return tftypes.Value{}, current.NewErrorf("map key %q not found", key)
+			}
+
+			newChild, err := replaceValuesAtSteps(child, group.replacements, current.WithElementKeyString(key))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			newElems[key] = newChild
+		}
+
+		return tftypes.NewValue(typ, newElems), nil
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			return tftypes.Value{}, current.NewError(err)
+		}
+
+		newElems := make([]tftypes.Value, len(elems))
+		copy(newElems, elems)
+
+		for _, group := range groups {
+			idx := int(group.step.(tftypes.ElementKeyInt))
+
+			if idx < 0 || idx >= len(elems) {
+				return tftypes.Value{}, current.NewErrorf("index %d out of range for length %d", idx, len(elems))
+			}
+
+			newChild, err := replaceValuesAtSteps(elems[idx], group.replacements, current.WithElementKeyInt(idx))
+
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			newElems[idx] = newChild
+		}
+
+		return tftypes.NewValue(typ, newElems), nil
+	default:
+		var elems []tftypes.Value
+
+		if err := value.As(&elems); err != nil {
+			return tftypes.Value{}, current.NewError(err)
+		}
+
+		newElems := make([]tftypes.Value, len(elems))
+		copy(newElems, elems)
+
+		for _, group := range groups {
+			elemValue := tftypes.Value(group.step.(tftypes.ElementKeyValue))
+			found := false
+
+			for idx, elem := range elems {
+				if !elem.Equal(elemValue) {
+					continue
+				}
+
+				newChild, err := replaceValuesAtSteps(elem, group.replacements, current.WithElementKeyValue(elem))
+
+				if err != nil {
+					return tftypes.Value{}, err
+				}
+
+				newElems[idx] = newChild
+				found = true
+
+				break
+			}
+
+			if !found {
+				return tftypes.Value{}, current.NewErrorf("set value %s not found", elemValue)
+			}
+		}
+
+		return tftypes.NewValue(typ, newElems), nil
+	}
+}
+
+// groupStepsReplacements groups the replacements by their first step, in
+// the order each step first appears, removing the first step.
+func groupStepsReplacements(replacements []stepsReplacement) []stepReplacements {
+	var groups []stepReplacements
+
+	// Set element steps are values, which cannot be map keys, so they are
+	// grouped by comparing each group instead.
+	indexes := make(map[tftypes.AttributePathStep]int, len(replacements))
+
+	for _, replacement := range replacements {
+		step := replacement.steps[0]
+		remaining := stepsReplacement{
+			steps: replacement.steps[1:],
+			value: replacement.value,
+		}
+
+		idx := -1
+
+		if _, ok := step.(tftypes.ElementKeyValue); ok {
+			for groupIdx, group := range groups {
+				if group.step.Equal(step) {
+					idx = groupIdx
+
+					break
+				}
+			}
+		} else if groupIdx, ok := indexes[step]; ok {
+			idx = groupIdx
+		}
+
+		if idx < 0 {
+			idx = len(groups)
+			groups = append(groups, stepReplacements{step: step})
+
+			if _, ok := step.(tftypes.ElementKeyValue); !ok {
+				indexes[step] = idx
+			}
+		}
+
+		groups[idx].replacements = append(groups[idx].replacements, remaining)
+	}
+
+	return groups
+}
@@ -0,0 +1,288 @@
+package tfsdk
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReplaceValueAtPath(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name": tftypes.String,
+		},
+	}
+	rootType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list":   tftypes.List{ElementType: nestedType},
+			"map":    tftypes.Map{ElementType: tftypes.String},
+			"null":   nestedType,
+			"set":    tftypes.Set{ElementType: tftypes.String},
+			"string": tftypes.String,
+			"tuple":  tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}},
+		},
+	}
+	root := func(overrides map[string]tftypes.Value) tftypes.Value {
+		attrs := map[string]tftypes.Value{
+			"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+				tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "first"),
+				}),
+				tftypes.NewValue(nestedType, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "second"),
+				}),
+			}),
+			"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"key": tftypes.NewValue(tftypes.String, "value"),
+			}),
+			"null": tftypes.NewValue(nestedType, nil),
+			"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "element"),
+			}),
+			"string": tftypes.NewValue(tftypes.String, "value"),
+			"tuple": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "value"),
+				tftypes.NewValue(tftypes.Bool, true),
+			}),
+		}
+
+		for name, value := range overrides {
+			attrs[name] = value
+		}
+
+		return tftypes.NewValue(rootType, attrs)
+	}
+
+	testCases := map[string]struct {
+		path          *tftypes.AttributePath
+		val           tftypes.Value
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"root": {
+			path:     tftypes.NewAttributePath(),
+			val:      tftypes.NewValue(rootType, nil),
+			expected: tftypes.NewValue(rootType, nil),
+		},
+		"AttributeName": {
+			path: tftypes.NewAttributePath().WithAttributeName("string"),
+			val:  tftypes.NewValue(tftypes.String, "new"),
+			expected: root(map[string]tftypes.Value{
+				"string": tftypes.NewValue(tftypes.String, "new"),
+			}),
+		},
+		"AttributeName-ElementKeyInt-AttributeName": {
+			path: tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("name"),
+			val:  tftypes.NewValue(tftypes.String, "new"),
+			expected: root(map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					tftypes.NewValue(nestedType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "first"),
+					}),
+					tftypes.NewValue(nestedType, map[string]tftypes.Value{
+						"name": tftypes.NewValue(tftypes.String, "new"),
+					}),
+				}),
+			}),
+		},
+		"AttributeName-ElementKeyInt-tuple": {
+			path: tftypes.NewAttributePath().WithAttributeName("tuple").WithElementKeyInt(1),
+			val:  tftypes.NewValue(tftypes.Bool, false),
+			expected: root(map[string]tftypes.Value{
+				"tuple": tftypes.NewValue(tftypes.Tuple{ElementTypes: []tftypes.Type{tftypes.String, tftypes.Bool}}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "value"),
+					tftypes.NewValue(tftypes.Bool, false),
+				}),
+			}),
+		},
+		"AttributeName-ElementKeyString": {
+			path: tftypes.NewAttributePath().WithAttributeName("map").WithElementKeyString("key"),
+			val:  tftypes.NewValue(tftypes.String, "new"),
+			expected: root(map[string]tftypes.Value{
+				"map": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"key": tftypes.NewValue(tftypes.String, "new"),
+				}),
+			}),
+		},
+		"AttributeName-ElementKeyValue": {
+			path: tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyValue(tftypes.NewValue(tftypes.String, "element")),
+			val:  tftypes.NewValue(tftypes.String, "new"),
+			expected: root(map[string]tftypes.Value{
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "new"),
+				}),
+			}),
+		},
+		"missing-attribute": {
+			path:          tftypes.NewAttributePath().WithAttributeName("missing"),
+			val:           tftypes.NewValue(tftypes.String, "new"),
+			expectedError: `attribute "missing" not found`,
+		},
+		"list-index-out-of-range": {
+			path:          tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(2).WithAttributeName("name"),
+			val:           tftypes.NewValue(tftypes.String, "new"),
+			expectedError: `AttributeName("list"): index 2 out of range for length 2`,
+		},
+		"null-parent": {
+			path:          tftypes.NewAttributePath().WithAttributeName("null").WithAttributeName("name"),
+			val:           tftypes.NewValue(tftypes.String, "new"),
+			expectedError: `AttributeName("null"): cannot replace value beneath null or unknown value`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := replaceValueAtPath(root(nil), tc.path, tc.val)
+
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), tc.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if tc.expectedError != "" {
+				t.Fatalf("expected error: %s", tc.expectedError)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestReplaceValuesAtPaths(t *testing.T) {
+	t.Parallel()
+
+	nestedType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"name":  tftypes.String,
+			"value": tftypes.String,
+		},
+	}
+	rootType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"list":   tftypes.List{ElementType: nestedType},
+			"set":    tftypes.Set{ElementType: tftypes.String},
+			"string": tftypes.String,
+		},
+	}
+	nested := func(name, value string) tftypes.Value {
+		return tftypes.NewValue(nestedType, map[string]tftypes.Value{
+			"name":  tftypes.NewValue(tftypes.String, name),
+			"value": tftypes.NewValue(tftypes.String, value),
+		})
+	}
+	root := tftypes.NewValue(rootType, map[string]tftypes.Value{
+		"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+			nested("first", "one"),
+			nested("second", "two"),
+		}),
+		"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "a"),
+			tftypes.NewValue(tftypes.String, "b"),
+		}),
+		"string": tftypes.NewValue(tftypes.String, "value"),
+	})
+
+	testCases := map[string]struct {
+		replacements  []valueReplacement
+		expected      tftypes.Value
+		expectedError string
+	}{
+		"none": {
+			expected: root,
+		},
+		"siblings": {
+			replacements: []valueReplacement{
+				{
+					path:  tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("value"),
+					value: tftypes.NewValue(tftypes.String, "new-two"),
+				},
+				{
+					path:  tftypes.NewAttributePath().WithAttributeName("string"),
+					value: tftypes.NewValue(tftypes.String, "new"),
+				},
+				{
+					path:  tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(1).WithAttributeName("name"),
+					value: tftypes.NewValue(tftypes.String, "new-second"),
+				},
+				{
+					path:  tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(0).WithAttributeName("value"),
+					value: tftypes.NewValue(tftypes.String, "new-one"),
+				},
+				{
+					path:  tftypes.NewAttributePath().WithAttributeName("set").WithElementKeyValue(tftypes.NewValue(tftypes.String, "b")),
+					value: tftypes.NewValue(tftypes.String, "c"),
+				},
+			},
+			expected: tftypes.NewValue(rootType, map[string]tftypes.Value{
+				"list": tftypes.NewValue(tftypes.List{ElementType: nestedType}, []tftypes.Value{
+					nested("first", "new-one"),
+					nested("new-second", "new-two"),
+				}),
+				"set": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+					tftypes.NewValue(tftypes.String, "a"),
+					tftypes.NewValue(tftypes.String, "c"),
+				}),
+				"string": tftypes.NewValue(tftypes.String, "new"),
+			}),
+		},
+		"missing-attribute": {
+			replacements: []valueReplacement{
+				{
+					path:  tftypes.NewAttributePath().WithAttributeName("string"),
+					value: tftypes.NewValue(tftypes.String, "new"),
+				},
+				{
+					path:  tftypes.NewAttributePath().WithAttributeName("list").WithElementKeyInt(0).WithAttributeName("missing"),
+					value: tftypes.NewValue(tftypes.String, "new"),
+				},
+			},
+			expectedError: `AttributeName("list").ElementKeyInt(0): attribute "missing" not found`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := replaceValuesAtPaths(root, tc.replacements)
+
+			if err != nil {
+				if tc.expectedError == "" {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				if diff := cmp.Diff(err.Error(), tc.expectedError); diff != "" {
+					t.Fatalf("unexpected error difference: %s", diff)
+				}
+
+				return
+			}
+
+			if tc.expectedError != "" {
+				t.Fatalf("expected error: %s", tc.expectedError)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package tfsdk
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// Tracer creates spans around the handling of each RPC and each call of the
+// Create, Read, Update, and Delete methods of resources and the Read method
+// of data sources, such as with OpenTelemetry. The context returned by
+// StartSpan is passed to the RPC handling or method, so spans started by
+// provider code, such as instrumented HTTP clients, are children of them.
+//
+// For example, an OpenTelemetry tracer can be adapted with:
+//
+//	type otelTracer struct {
+//		tracer trace.Tracer
+//	}
+//
+//	func (t otelTracer) StartSpan(ctx context.Context, span tfsdk.TraceSpan) (context.Context, func(diag.Diagnostics)) {
+//		ctx, otelSpan := t.tracer.Start(ctx, span.Name(), trace.WithAttributes(
+//			attribute.String("tf_resource_type", span.TypeName),
+//		))
+//
+//		return ctx, func(diags diag.Diagnostics) {
+//			if diags.HasError() {
+//				otelSpan.SetStatus(codes.Error, "error diagnostics")
+//			}
+//
+//			otelSpan.End()
+//		}
+//	}
+type Tracer interface {
+	// StartSpan starts the span as a child of any span in the context. It
+	// returns a context containing the new span and a function which ends
+	// the span with the diagnostics of the RPC or method.
+	StartSpan(context.Context, TraceSpan) (context.Context, func(diag.Diagnostics))
+}
+
+// TraceSpan describes a span started by a Tracer. Exactly one of Operation,
+// for spans of RPCs, and Method, for spans of method calls, is set.
+type TraceSpan struct {
+	// Operation is the RPC being served.
+	Operation Operation
+
+	// Method is the resource or data source method being called. Method
+	// spans are children of the span of the RPC which calls the method. The
+	// method may be called more than once by interceptors, such as to retry
+	// failed calls, in which case each call has its own span.
+	Method InterceptedMethod
+
+	// TypeName is the resource or data source type of the RPC or method.
+	// It is empty for provider RPCs, such as ConfigureProvider.
+	TypeName string
+}
+
+// Name returns the name of the RPC or method, such as PlanResourceChange or
+// CreateResource.
+func (s TraceSpan) Name() string {
+	if s.Method != "" {
+		return string(s.Method)
+	}
+
+	return string(s.Operation)
+}
+
+// startSpan starts the span with the Tracer of the server, if any, logs the
+// start and end of the span to the framework log subsystem, and records its
+// Measurement with the Metrics of the server, if any.
+func (s *server) startSpan(ctx context.Context, span TraceSpan) (context.Context, func(diag.Diagnostics)) {
+	endLog := logPhaseStart(ctx, span)
+	endMeasurement := s.startMeasurement(ctx, span)
+	endSpan := func(diag.Diagnostics) {}
+
+	if s.tracer != nil {
+		ctx, endSpan = s.tracer.StartSpan(ctx, span)
+	}
+
+	return ctx, func(diags diag.Diagnostics) {
+		endMeasurement(ctx, diags)
+		endSpan(diags)
+		endLog(diags)
+	}
+}
@@ -0,0 +1,154 @@
+package tfsdk
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testTracerSpanKey struct{}
+
+// testTracer records the start of each span with the name of its parent
+// span, and the end of each span with whether it had errors.
+type testTracer struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (t *testTracer) record(event string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.events = append(t.events, event)
+}
+
+func (t *testTracer) StartSpan(ctx context.Context, span TraceSpan) (context.Context, func(diag.Diagnostics)) {
+	name := span.Name() + " " + span.TypeName
+	parent, _ := ctx.Value(testTracerSpanKey{}).(string)
+
+	t.record("start " + name + " parent:" + parent)
+
+	return context.WithValue(ctx, testTracerSpanKey{}, name), func(diags diag.Diagnostics) {
+		t.record("end " + name + " error:" + strconv.FormatBool(diags.HasError()))
+	}
+}
+
+func TestServerTracer(t *testing.T) {
+	t.Parallel()
+
+	stateType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id": tftypes.String,
+		},
+	}
+
+	testCases := map[string]struct {
+		fail           bool
+		expectedEvents []string
+	}{
+		"success": {
+			expectedEvents: []string{
+				"start ApplyResourceChange test_post_apply parent:",
+				"start CreateResource test_post_apply parent:ApplyResourceChange test_post_apply",
+				"end CreateResource test_post_apply error:false",
+				"end ApplyResourceChange test_post_apply error:false",
+			},
+		},
+		"error": {
+			fail: true,
+			expectedEvents: []string{
+				"start ApplyResourceChange test_post_apply parent:",
+				"start CreateResource test_post_apply parent:ApplyResourceChange test_post_apply",
+				"end CreateResource test_post_apply error:true",
+				"end ApplyResourceChange test_post_apply error:true",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tracer := &testTracer{}
+			provider := testServeProviderWithResources{
+				testServeProvider: &testServeProvider{},
+				resources: []func() ResourceWithMetadata{
+					func() ResourceWithMetadata {
+						return testPostApplyResource{
+							testResourceWithMetadata: testResourceWithMetadata{typeName: "test_post_apply"},
+							fail:                     tc.fail,
+						}
+					},
+				},
+			}
+			testServer := NewProtocol6ServerWithOptions(provider, ServeOpts{Tracer: tracer})
+
+			planned, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "created"),
+			}))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			prior, err := tfprotov6.NewDynamicValue(stateType, tftypes.NewValue(stateType, nil))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = testServer.ApplyResourceChange(context.Background(), &tfprotov6.ApplyResourceChangeRequest{
+				Config:       &planned,
+				PlannedState: &planned,
+				PriorState:   &prior,
+				TypeName:     "test_post_apply",
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(tracer.events, tc.expectedEvents); diff != "" {
+				t.Errorf("unexpected events difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTraceSpanName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		span     TraceSpan
+		expected string
+	}{
+		"operation": {
+			span:     TraceSpan{Operation: OperationPlanResourceChange, TypeName: "test_one"},
+			expected: "PlanResourceChange",
+		},
+		"method": {
+			span:     TraceSpan{Method: InterceptedMethodCreateResource, TypeName: "test_one"},
+			expected: "CreateResource",
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.span.Name(); got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package tfsdk
+
+import (
+	"context"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// UpgradeStateStub returns Go source code for the skeleton of a function
+// which upgrades prior resource state data, stored using priorSchema, into
+// data conforming to schema. It is intended to be used as a starting point for
+// writing state upgrade logic by hand, rather than used as-is.
+//
+// Attributes and blocks with the same name and type in both schemas are
+// copied without modification. All others receive a TODO comment describing
+// the difference, such as an added, removed, or retyped attribute. Attributes
+// which were removed and added with the same type are called out as a possible
+// rename.
+//
+// The generated function refers to the terraform-plugin-go tftypes and
+// terraform-plugin-framework tfsdk packages, which must be imported by the
+// file where it is placed. It can be called from the StateUpgrader of a
+// ResourceStateUpgrader with priorSchema as its PriorSchema, passing
+// UpgradeResourceStateRequest.State.Raw and
+// UpgradeResourceStateResponse.State.Schema, then setting
+// UpgradeResourceStateResponse.State.Raw to the result.
+func UpgradeStateStub(ctx context.Context, priorSchema Schema, schema Schema) (string, error) {
+	priorTypes := schemaAttributeTerraformTypes(ctx, priorSchema)
+	currentTypes := schemaAttributeTerraformTypes(ctx, schema)
+
+	var removed []string
+
+	for name := range priorTypes {
+		if _, ok := currentTypes[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	sort.Strings(removed)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "// upgradeStateFromV%d upgrades prior resource state data from schema\n", priorSchema.Version)
+	fmt.Fprintf(&b, "// version %d to schema version %d.\n", priorSchema.Version, schema.Version)
+	fmt.Fprintf(&b, "func upgradeStateFromV%d(ctx context.Context, priorState tftypes.Value, schema tfsdk.Schema) (tftypes.Value, error) {\n", priorSchema.Version)
+	b.WriteString("var priorAttributes map[string]tftypes.Value\n\n")
+	b.WriteString("if err := priorState.As(&priorAttributes); err != nil {\nreturn tftypes.Value{}, err\n}\n\n")
+	b.WriteString("schemaType := schema.TerraformType(ctx).(tftypes.Object)\n\n")
+	b.WriteString("upgradedAttributes := map[string]tftypes.Value{\n")
+
+	for _, name := range sortedTerraformTypesKeys(currentTypes) {
+		currentType := currentTypes[name]
+		priorType, ok := priorTypes[name]
+
+		switch {
+		case !ok:
+			fmt.Fprintf(&b, "// TODO: Set added %q value.", name)
+
+			if renamed := possibleRenames(currentType, removed, priorTypes); len(renamed) > 0 {
+				fmt.Fprintf(&b, " Possibly renamed from prior %s.", strings.Join(renamed, " or "))
+			}
+
+			fmt.Fprintf(&b, "\n%q: tftypes.NewValue(schemaType.AttributeTypes[%q], nil),\n", name, name)
+		case !priorType.Equal(currentType):
+			fmt.Fprintf(&b, "// TODO: Convert prior %q value from type %s to %s.\n", name, priorType, currentType)
+			fmt.Fprintf(&b, "%q: tftypes.NewValue(schemaType.AttributeTypes[%q], nil),\n", name, name)
+		default:
+			fmt.Fprintf(&b, "%q: priorAttributes[%q],\n", name, name)
+		}
+	}
+
+	b.WriteString("}\n\n")
+
+	for _, name := range removed {
+		fmt.Fprintf(&b, "// TODO: Handle removed %q value: priorAttributes[%q]\n", name, name)
+	}
+
+	if len(removed) > 0 {
+		b.WriteString("\n")
+	}
+
+	b.WriteString("return tftypes.NewValue(schemaType, upgradedAttributes), nil\n}\n")
+
+	source, err := format.Source([]byte(b.String()))
+
+	if err != nil {
+		return "", fmt.Errorf("unable to format generated source: %w", err)
+	}
+
+	return string(source), nil
+}
+
+// possibleRenames returns the quoted names of removed prior attributes with
+// the same type as the given added attribute.
+func possibleRenames(typ tftypes.Type, removed []string, priorTypes map[string]tftypes.Type) []string {
+	var result []string
+
+	for _, removedName := range removed {
+		if priorTypes[removedName].Equal(typ) {
+			result = append(result, fmt.Sprintf("%q", removedName))
+		}
+	}
+
+	return result
+}
+
+// schemaAttributeTerraformTypes returns the tftypes.Type of every top level
+// attribute and block in the schema.
+func schemaAttributeTerraformTypes(ctx context.Context, s Schema) map[string]tftypes.Type {
+	result := make(map[string]tftypes.Type, len(s.Attributes)+len(s.Blocks))
+
+	for name, attribute := range s.Attributes {
+		result[name] = attribute.terraformType(ctx)
+	}
+
+	for name, block := range s.Blocks {
+		result[name] = block.terraformType(ctx)
+	}
+
+	return result
+}
+
+// sortedTerraformTypesKeys returns the keys of the map in lexical order.
+func sortedTerraformTypesKeys(m map[string]tftypes.Type) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,163 @@
+package tfsdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestUpgradeStateStub(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		priorSchema Schema
+		schema      Schema
+		expected    string
+	}{
+		"unchanged": {
+			priorSchema: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				},
+			},
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+				},
+				Version: 1,
+			},
+			expected: `// upgradeStateFromV0 upgrades prior resource state data from schema
+// version 0 to schema version 1.
+func upgradeStateFromV0(ctx context.Context, priorState tftypes.Value, schema tfsdk.Schema) (tftypes.Value, error) {
+	var priorAttributes map[string]tftypes.Value
+
+	if err := priorState.As(&priorAttributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	schemaType := schema.TerraformType(ctx).(tftypes.Object)
+
+	upgradedAttributes := map[string]tftypes.Value{
+		"id": priorAttributes["id"],
+	}
+
+	return tftypes.NewValue(schemaType, upgradedAttributes), nil
+}
+`,
+		},
+		"changes": {
+			priorSchema: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"old_name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+					"removed": {
+						Type:     types.BoolType,
+						Optional: true,
+					},
+					"size": {
+						Type:     types.StringType,
+						Required: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"disk": {
+						Attributes: map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+				},
+				Version: 1,
+			},
+			schema: Schema{
+				Attributes: map[string]Attribute{
+					"id": {
+						Type:     types.StringType,
+						Computed: true,
+					},
+					"new_name": {
+						Type:     types.StringType,
+						Optional: true,
+					},
+					"size": {
+						Type:     types.Int64Type,
+						Required: true,
+					},
+				},
+				Blocks: map[string]Block{
+					"disk": {
+						Attributes: map[string]Attribute{
+							"name": {
+								Type:     types.StringType,
+								Required: true,
+							},
+						},
+						NestingMode: BlockNestingModeList,
+					},
+				},
+				Version: 2,
+			},
+			expected: `// upgradeStateFromV1 upgrades prior resource state data from schema
+// version 1 to schema version 2.
+func upgradeStateFromV1(ctx context.Context, priorState tftypes.Value, schema tfsdk.Schema) (tftypes.Value, error) {
+	var priorAttributes map[string]tftypes.Value
+
+	if err := priorState.As(&priorAttributes); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	schemaType := schema.TerraformType(ctx).(tftypes.Object)
+
+	upgradedAttributes := map[string]tftypes.Value{
+		"disk": priorAttributes["disk"],
+		"id":   priorAttributes["id"],
+		// TODO: Set added "new_name" value. Possibly renamed from prior "old_name".
+		"new_name": tftypes.NewValue(schemaType.AttributeTypes["new_name"], nil),
+		// TODO: Convert prior "size" value from type tftypes.String to tftypes.Number.
+		"size": tftypes.NewValue(schemaType.AttributeTypes["size"], nil),
+	}
+
+	// TODO: Handle removed "old_name" value: priorAttributes["old_name"]
+	// TODO: Handle removed "removed" value: priorAttributes["removed"]
+
+	return tftypes.NewValue(schemaType, upgradedAttributes), nil
+}
+`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := UpgradeStateStub(context.Background(), tc.priorSchema, tc.schema)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, tc.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
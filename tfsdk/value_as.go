@@ -7,20 +7,29 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 )
 
 // ValueAs populates the Go value passed as `target` with
 // the contents of `val`, using the reflection rules
 // defined for `Get` and `GetAttribute`.
 func ValueAs(ctx context.Context, val attr.Value, target interface{}) diag.Diagnostics {
+	return valueAs(ctx, val, target, path.Empty())
+}
+
+// valueAs is ValueAs for a value found at `valuePath`, such as an attribute
+// read with GetAttribute. Diagnostics are returned at paths relative to
+// `valuePath`, so errors decoding a nested object into a struct point at the
+// offending nested attribute.
+func valueAs(ctx context.Context, val attr.Value, target interface{}, valuePath path.Path) diag.Diagnostics {
 	if reflect.IsGenericAttrValue(ctx, target) {
 		*(target.(*attr.Value)) = val
 		return nil
 	}
 	raw, err := val.ToTerraformValue(ctx)
 	if err != nil {
-		return diag.Diagnostics{diag.NewErrorDiagnostic("Error converting value",
-			fmt.Sprintf("An unexpected error was encountered converting a %T to its equivalent Terraform representation. This is always a bug in the provider.\n\nError: %s", val, err))}
+		return diag.Diagnostics{diag.WithPath(valuePath, diag.NewErrorDiagnostic("Error converting value",
+			fmt.Sprintf("An unexpected error was encountered converting a %T to its equivalent Terraform representation. This is always a bug in the provider.\n\nError: %s", val, err)))}
 	}
-	return reflect.Into(ctx, val.Type(ctx), raw, target, reflect.Options{})
+	return reflect.Into(ctx, val.Type(ctx), raw, target, reflect.Options{}, valuePath)
 }
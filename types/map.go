@@ -3,24 +3,44 @@ package types
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
+var _ attr.TypeWithValidate = MapType{}
+
 // MapType is an AttributeType representing a map of values. All values must
 // be of the same type, which the provider must specify as the ElemType
 // property. Keys will always be strings.
 type MapType struct {
 	ElemType attr.Type
+
+	// KeyValidator is an optional validation for every key of the map, such
+	// as a pattern or maximum length. Terraform only enforces that map keys
+	// are strings.
+	//
+	// KeyValidator only applies when the MapType is the Type of an
+	// attribute. Types containing other types, such as ListType, ObjectType,
+	// and MapType itself, do not validate their element or attribute types,
+	// so the KeyValidator of a nested MapType, such as the ElemType of a
+	// ListType, is never called. Use an attribute validator for the keys of
+	// nested maps instead.
+	//
+	// KeyValidator is not considered by Equal, as map values of this type do
+	// not retain their KeyValidator.
+	KeyValidator MapKeyValidator
 }
 
 // WithElementType returns a new copy of the type with its element type set.
 func (m MapType) WithElementType(typ attr.Type) attr.TypeWithElementType {
 	return MapType{
-		ElemType: typ,
+		ElemType:     typ,
+		KeyValidator: m.KeyValidator,
 	}
 }
 
@@ -108,6 +128,56 @@ func (m MapType) String() string {
 	return "types.MapType[" + m.ElemType.String() + "]"
 }
 
+// Validate implements type validation. If KeyValidator is set, it is called
+// for every key of a known map value. The ElemType is not validated.
+func (m MapType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if in.Type() == nil {
+		return diags
+	}
+
+	if !in.Type().Is(tftypes.Map{}) {
+		err := fmt.Errorf("expected Map value, received %T with value: %v", in, in)
+		diags.AddAttributeError(
+			path,
+			"Map Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	if m.KeyValidator == nil || !in.IsKnown() || in.IsNull() {
+		return diags
+	}
+
+	var elems map[string]tftypes.Value
+
+	if err := in.As(&elems); err != nil {
+		diags.AddAttributeError(
+			path,
+			"Map Type Validation Error",
+			"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+		return diags
+	}
+
+	keys := make([]string, 0, len(elems))
+
+	for key := range elems {
+		keys = append(keys, key)
+	}
+
+	// Sort keys so diagnostics are deterministic.
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		diags.Append(m.KeyValidator.ValidateMapKey(ctx, key, path.AtMapKey(key))...)
+	}
+
+	return diags
+}
+
 // Map represents a map of AttributeValues, all of the same type, indicated by
 // ElemType. Keys for the map will always be strings.
 type Map struct {
@@ -150,7 +220,7 @@ func (m Map) ElementsAs(ctx context.Context, target interface{}, allowUnhandled
 	return reflect.Into(ctx, MapType{ElemType: m.ElemType}, val, target, reflect.Options{
 		UnhandledNullAsEmpty:    allowUnhandled,
 		UnhandledUnknownAsEmpty: allowUnhandled,
-	})
+	}, path.Empty())
 }
 
 // Type returns a MapType with the same element type as `m`.
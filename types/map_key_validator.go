@@ -0,0 +1,103 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	_ MapKeyValidator = mapKeyLengthAtMostValidator{}
+	_ MapKeyValidator = mapKeyRegexMatchesValidator{}
+)
+
+// MapKeyValidator describes reusable validation for the keys of a map, set
+// via the MapType KeyValidator field. It only applies to a MapType which is
+// the Type of an attribute, not one nested in another type.
+//
+// Implementations must be comparable, such as a struct without function,
+// map, or slice fields or a pointer, as MapType values may be compared.
+type MapKeyValidator interface {
+	// Description describes the validation in plain text formatting.
+	Description(context.Context) string
+
+	// ValidateMapKey performs the validation of a single map key. The path
+	// is the path of the map element with that key.
+	ValidateMapKey(ctx context.Context, key string, path path.Path) diag.Diagnostics
+}
+
+// MapKeyLengthAtMost returns a MapKeyValidator which ensures that every map
+// key contains at most the given number of characters.
+func MapKeyLengthAtMost(max int) MapKeyValidator {
+	return mapKeyLengthAtMostValidator{
+		max: max,
+	}
+}
+
+type mapKeyLengthAtMostValidator struct {
+	max int
+}
+
+// Description describes the validation in plain text formatting.
+func (v mapKeyLengthAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map keys must be at most %d characters", v.max)
+}
+
+// ValidateMapKey performs the validation of a single map key.
+func (v mapKeyLengthAtMostValidator) ValidateMapKey(ctx context.Context, key string, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if utf8.RuneCountInString(key) > v.max {
+		diags.AddAttributeError(
+			path,
+			"Invalid Map Key",
+			fmt.Sprintf("Map key %q is invalid: %s.", key, v.Description(ctx)),
+		)
+	}
+
+	return diags
+}
+
+// MapKeyRegexMatches returns a MapKeyValidator which ensures that every map
+// key matches the regular expression. The optional message describes the
+// expected key format in error diagnostics, otherwise the regular expression
+// itself is shown.
+func MapKeyRegexMatches(regexp *regexp.Regexp, message string) MapKeyValidator {
+	return mapKeyRegexMatchesValidator{
+		message: message,
+		regexp:  regexp,
+	}
+}
+
+type mapKeyRegexMatchesValidator struct {
+	message string
+	regexp  *regexp.Regexp
+}
+
+// Description describes the validation in plain text formatting.
+func (v mapKeyRegexMatchesValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("map keys must match regular expression %q", v.regexp)
+}
+
+// ValidateMapKey performs the validation of a single map key.
+func (v mapKeyRegexMatchesValidator) ValidateMapKey(ctx context.Context, key string, path path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !v.regexp.MatchString(key) {
+		diags.AddAttributeError(
+			path,
+			"Invalid Map Key",
+			fmt.Sprintf("Map key %q is invalid: %s.", key, v.Description(ctx)),
+		)
+	}
+
+	return diags
+}
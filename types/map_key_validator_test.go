@@ -0,0 +1,79 @@
+package types
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestMapKeyValidators(t *testing.T) {
+	t.Parallel()
+
+	path := path.Root("test").AtMapKey("key")
+
+	testCases := map[string]struct {
+		validator     MapKeyValidator
+		key           string
+		expectedDiags diag.Diagnostics
+	}{
+		"length-at-most-valid": {
+			validator: MapKeyLengthAtMost(3),
+			key:       "äöü",
+		},
+		"length-at-most-invalid": {
+			validator: MapKeyLengthAtMost(3),
+			key:       "abcd",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path,
+					"Invalid Map Key",
+					`Map key "abcd" is invalid: map keys must be at most 3 characters.`,
+				),
+			},
+		},
+		"regex-matches-valid": {
+			validator: MapKeyRegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			key:       "abc",
+		},
+		"regex-matches-invalid": {
+			validator: MapKeyRegexMatches(regexp.MustCompile(`^[a-z]+$`), ""),
+			key:       "ABC",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path,
+					"Invalid Map Key",
+					`Map key "ABC" is invalid: map keys must match regular expression "^[a-z]+$".`,
+				),
+			},
+		},
+		"regex-matches-invalid-message": {
+			validator: MapKeyRegexMatches(regexp.MustCompile(`^[a-z]+$`), "map keys must be lowercase letters"),
+			key:       "ABC",
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path,
+					"Invalid Map Key",
+					`Map key "ABC" is invalid: map keys must be lowercase letters.`,
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.validator.ValidateMapKey(context.Background(), testCase.key, path)
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
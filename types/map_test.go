@@ -7,6 +7,8 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -222,6 +224,18 @@ func TestMapTypeEqual(t *testing.T) {
 			// equal to anything
 			expected: false,
 		},
+		"key-validator": {
+			receiver: MapType{
+				ElemType:     StringType,
+				KeyValidator: MapKeyLengthAtMost(3),
+			},
+			input: MapType{
+				ElemType: StringType,
+			},
+			// Map values do not retain their KeyValidator, so it is
+			// not considered.
+			expected: true,
+		},
 	}
 	for name, test := range tests {
 		name, test := name, test
@@ -236,6 +250,126 @@ func TestMapTypeEqual(t *testing.T) {
 	}
 }
 
+func TestMapTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mapType       MapType
+		in            tftypes.Value
+		expectedDiags diag.Diagnostics
+	}{
+		"no-key-validator": {
+			mapType: MapType{
+				ElemType: StringType,
+			},
+			in: tftypes.NewValue(
+				tftypes.Map{
+					ElementType: tftypes.String,
+				},
+				map[string]tftypes.Value{
+					"toolong": tftypes.NewValue(tftypes.String, "value"),
+				},
+			),
+		},
+		"null": {
+			mapType: MapType{
+				ElemType:     StringType,
+				KeyValidator: MapKeyLengthAtMost(3),
+			},
+			in: tftypes.NewValue(
+				tftypes.Map{
+					ElementType: tftypes.String,
+				},
+				nil,
+			),
+		},
+		"unknown": {
+			mapType: MapType{
+				ElemType:     StringType,
+				KeyValidator: MapKeyLengthAtMost(3),
+			},
+			in: tftypes.NewValue(
+				tftypes.Map{
+					ElementType: tftypes.String,
+				},
+				tftypes.UnknownValue,
+			),
+		},
+		"valid-keys": {
+			mapType: MapType{
+				ElemType:     StringType,
+				KeyValidator: MapKeyLengthAtMost(3),
+			},
+			in: tftypes.NewValue(
+				tftypes.Map{
+					ElementType: tftypes.String,
+				},
+				map[string]tftypes.Value{
+					"a":   tftypes.NewValue(tftypes.String, "value"),
+					"abc": tftypes.NewValue(tftypes.String, "value"),
+				},
+			),
+		},
+		"invalid-keys": {
+			mapType: MapType{
+				ElemType:     StringType,
+				KeyValidator: MapKeyLengthAtMost(3),
+			},
+			in: tftypes.NewValue(
+				tftypes.Map{
+					ElementType: tftypes.String,
+				},
+				map[string]tftypes.Value{
+					"abc":  tftypes.NewValue(tftypes.String, "value"),
+					"abcd": tftypes.NewValue(tftypes.String, "value"),
+					"bcde": tftypes.NewValue(tftypes.String, "value"),
+				},
+			),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("abcd"),
+					"Invalid Map Key",
+					`Map key "abcd" is invalid: map keys must be at most 3 characters.`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test").AtMapKey("bcde"),
+					"Invalid Map Key",
+					`Map key "bcde" is invalid: map keys must be at most 3 characters.`,
+				),
+			},
+		},
+		"wrong-type": {
+			mapType: MapType{
+				ElemType:     StringType,
+				KeyValidator: MapKeyLengthAtMost(3),
+			},
+			in: tftypes.NewValue(tftypes.String, "testvalue"),
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path.Root("test"),
+					"Map Type Validation Error",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"expected Map value, received tftypes.Value with value: tftypes.String<\"testvalue\">",
+				),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := testCase.mapType.Validate(context.Background(), testCase.in, path.Root("test"))
+
+			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestMapElementsAs_mapStringString(t *testing.T) {
 	t.Parallel()
 
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/internal/reflect"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -112,7 +113,7 @@ func (t SetType) String() string {
 
 // Validate implements type validation. This type requires all elements to be
 // unique.
-func (s SetType) Validate(ctx context.Context, in tftypes.Value, path *tftypes.AttributePath) diag.Diagnostics {
+func (s SetType) Validate(ctx context.Context, in tftypes.Value, path path.Path) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if !in.Type().Is(tftypes.Set{}) {
@@ -157,7 +158,7 @@ func (s SetType) Validate(ctx context.Context, in tftypes.Value, path *tftypes.A
 			}
 
 			diags.AddAttributeError(
-				path.WithElementKeyValue(elemInner),
+				path.AtSetValue(elemInner),
 				"Duplicate Set Element",
 				fmt.Sprintf("This attribute contains duplicate values of: %s", elemInner),
 			)
@@ -208,7 +209,7 @@ func (s Set) ElementsAs(ctx context.Context, target interface{}, allowUnhandled
 	return reflect.Into(ctx, s.Type(ctx), val, target, reflect.Options{
 		UnhandledNullAsEmpty:    allowUnhandled,
 		UnhandledUnknownAsEmpty: allowUnhandled,
-	})
+	}, path.Empty())
 }
 
 // Type returns a SetType with the same element type as `s`.
@@ -279,3 +280,126 @@ func (s Set) contains(v attr.Value) bool {
 
 	return false
 }
+
+// Contains returns true if the Set has an element equal to `candidate`, which
+// can be an attr.Value or any Go value supported by reflection, such as a
+// struct with `tfsdk` tags for an object element type. Elements are compared
+// by their Terraform representation, so `candidate` does not need to be the
+// same attr.Value implementation as the elements. Null and unknown sets do
+// not contain any elements.
+func (s Set) Contains(ctx context.Context, candidate interface{}) (bool, diag.Diagnostics) {
+	if s.Null || s.Unknown {
+		return false, nil
+	}
+
+	candidateValue, diags := reflect.FromValue(ctx, s.ElemType, candidate, path.Empty())
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	rawCandidate, err := candidateValue.ToTerraformValue(ctx)
+
+	if err != nil {
+		diags.AddError(
+			"Set Element Conversion Error",
+			"An unexpected error was encountered trying to convert set elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+		)
+
+		return false, diags
+	}
+
+	rawElems, elemsDiags := s.terraformElems(ctx)
+	diags.Append(elemsDiags...)
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	for _, rawElem := range rawElems {
+		if rawElem.Equal(rawCandidate) {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+// Difference returns a Set with the elements of the Set which are not
+// elements of `o`, compared by their Terraform representation. The result
+// is unknown if either Set is unknown, and null if the Set is null. A null
+// `o` removes no elements.
+func (s Set) Difference(ctx context.Context, o Set) (Set, diag.Diagnostics) {
+	if s.Unknown || o.Unknown {
+		return Set{ElemType: s.ElemType, Unknown: true}, nil
+	}
+
+	if s.Null {
+		return Set{ElemType: s.ElemType, Null: true}, nil
+	}
+
+	rawElems, diags := s.terraformElems(ctx)
+
+	if diags.HasError() {
+		return Set{ElemType: s.ElemType, Unknown: true}, diags
+	}
+
+	otherRawElems, otherDiags := o.terraformElems(ctx)
+	diags.Append(otherDiags...)
+
+	if diags.HasError() {
+		return Set{ElemType: s.ElemType, Unknown: true}, diags
+	}
+
+	result := Set{
+		ElemType: s.ElemType,
+		Elems:    []attr.Value{},
+	}
+
+	for idx, rawElem := range rawElems {
+		found := false
+
+		for _, otherRawElem := range otherRawElems {
+			if rawElem.Equal(otherRawElem) {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			result.Elems = append(result.Elems, s.Elems[idx])
+		}
+	}
+
+	return result, diags
+}
+
+// terraformElems returns the Terraform representation of each element, in
+// the same order as Elems.
+func (s Set) terraformElems(ctx context.Context) ([]tftypes.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if s.Null || s.Unknown {
+		return nil, nil
+	}
+
+	rawElems := make([]tftypes.Value, 0, len(s.Elems))
+
+	for _, elem := range s.Elems {
+		rawElem, err := elem.ToTerraformValue(ctx)
+
+		if err != nil {
+			diags.AddError(
+				"Set Element Conversion Error",
+				"An unexpected error was encountered trying to convert set elements. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+			)
+
+			return nil, diags
+		}
+
+		rawElems = append(rawElems, rawElem)
+	}
+
+	return rawElems, diags
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -343,7 +344,7 @@ func benchmarkSetTypeValidate(b *testing.B, elementCount int) {
 		},
 		elements,
 	)
-	path := tftypes.NewAttributePath().WithAttributeName("test")
+	path := path.Root("test")
 	set := SetType{}
 
 	for n := 0; n < b.N; n++ {
@@ -414,7 +415,7 @@ func TestSetTypeValidate(t *testing.T) {
 			),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, nil)),
+					path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, nil)),
 					"Duplicate Set Element",
 					"This attribute contains duplicate values of: tftypes.String<null>",
 				),
@@ -504,7 +505,7 @@ func TestSetTypeValidate(t *testing.T) {
 			),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "hello")),
+					path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "hello")),
 					"Duplicate Set Element",
 					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
 				),
@@ -524,7 +525,7 @@ func TestSetTypeValidate(t *testing.T) {
 			),
 			expectedDiags: diag.Diagnostics{
 				diag.NewAttributeErrorDiagnostic(
-					tftypes.NewAttributePath().WithAttributeName("test").WithElementKeyValue(tftypes.NewValue(tftypes.String, "hello")),
+					path.Root("test").AtSetValue(tftypes.NewValue(tftypes.String, "hello")),
 					"Duplicate Set Element",
 					"This attribute contains duplicate values of: tftypes.String<\"hello\">",
 				),
@@ -536,7 +537,7 @@ func TestSetTypeValidate(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
 
-			diags := SetType{}.Validate(context.Background(), testCase.in, tftypes.NewAttributePath().WithAttributeName("test"))
+			diags := SetType{}.Validate(context.Background(), testCase.in, path.Root("test"))
 
 			if diff := cmp.Diff(diags, testCase.expectedDiags); diff != "" {
 				t.Errorf("Unexpected diagnostics (+got, -expected): %s", diff)
@@ -928,3 +929,234 @@ func TestSetEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestSetContains(t *testing.T) {
+	t.Parallel()
+
+	type testModel struct {
+		Name String `tfsdk:"name"`
+	}
+
+	objectType := ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"name": StringType,
+		},
+	}
+
+	tests := map[string]struct {
+		receiver      Set
+		candidate     interface{}
+		expected      bool
+		expectedError bool
+	}{
+		"attr-value": {
+			receiver: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+					String{Value: "world"},
+				},
+			},
+			candidate: String{Value: "world"},
+			expected:  true,
+		},
+		"attr-value-missing": {
+			receiver: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+				},
+			},
+			candidate: String{Value: "world"},
+			expected:  false,
+		},
+		"go-value": {
+			receiver: Set{
+				ElemType: Int64Type,
+				Elems: []attr.Value{
+					Int64{Value: 1},
+					Int64{Value: 2},
+				},
+			},
+			candidate: int64(2),
+			expected:  true,
+		},
+		"struct": {
+			receiver: Set{
+				ElemType: objectType,
+				Elems: []attr.Value{
+					Object{
+						AttrTypes: objectType.AttrTypes,
+						Attrs: map[string]attr.Value{
+							"name": String{Value: "hello"},
+						},
+					},
+				},
+			},
+			candidate: testModel{Name: String{Value: "hello"}},
+			expected:  true,
+		},
+		"null-set": {
+			receiver: Set{
+				ElemType: StringType,
+				Null:     true,
+			},
+			candidate: String{Value: "hello"},
+			expected:  false,
+		},
+		"unknown-set": {
+			receiver: Set{
+				ElemType: StringType,
+				Unknown:  true,
+			},
+			candidate: String{Value: "hello"},
+			expected:  false,
+		},
+		"wrong-type": {
+			receiver: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "hello"},
+				},
+			},
+			candidate:     true,
+			expected:      false,
+			expectedError: true,
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.receiver.Contains(context.Background(), test.candidate)
+
+			if diags.HasError() != test.expectedError {
+				t.Errorf("expected error %t, got diagnostics: %s", test.expectedError, diags)
+			}
+
+			if got != test.expected {
+				t.Errorf("Expected %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetDifference(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		receiver Set
+		input    Set
+		expected Set
+	}{
+		"difference": {
+			receiver: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+					String{Value: "b"},
+					String{Value: "c"},
+				},
+			},
+			input: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "b"},
+					String{Value: "d"},
+				},
+			},
+			expected: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+					String{Value: "c"},
+				},
+			},
+		},
+		"no-remaining-elements": {
+			receiver: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+				},
+			},
+			input: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+				},
+			},
+			expected: Set{
+				ElemType: StringType,
+				Elems:    []attr.Value{},
+			},
+		},
+		"null-input": {
+			receiver: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+				},
+			},
+			input: Set{
+				ElemType: StringType,
+				Null:     true,
+			},
+			expected: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+				},
+			},
+		},
+		"null-receiver": {
+			receiver: Set{
+				ElemType: StringType,
+				Null:     true,
+			},
+			input: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+				},
+			},
+			expected: Set{
+				ElemType: StringType,
+				Null:     true,
+			},
+		},
+		"unknown-input": {
+			receiver: Set{
+				ElemType: StringType,
+				Elems: []attr.Value{
+					String{Value: "a"},
+				},
+			},
+			input: Set{
+				ElemType: StringType,
+				Unknown:  true,
+			},
+			expected: Set{
+				ElemType: StringType,
+				Unknown:  true,
+			},
+		},
+	}
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.receiver.Difference(context.Background(), test.input)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error diagnostics: %s", diags)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+// Package validators contains subpackages of reusable tfsdk.AttributeValidator
+// implementations, grouped by the type of value they validate, such as
+// stringvalidator.
+//
+// Validators only validate known values. Null and unknown values are always
+// considered valid, which allows them to be combined with Required, Optional,
+// and interpolated configuration values.
+package validators
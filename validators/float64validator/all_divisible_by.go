@@ -0,0 +1,61 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = allDivisibleByValidator{}
+
+type allDivisibleByValidator struct {
+	divisors []float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v allDivisibleByValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be divisible by all of: %s", formatValues(v.divisors))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v allDivisibleByValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v allDivisibleByValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateFloat64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, divisor := range v.divisors {
+		if math.Mod(value, divisor) != 0 {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+				req.AttributePath,
+				v.Description(ctx),
+				validatordiag.RedactValue(req, strconv.FormatFloat(value, 'f', -1, 64)),
+			))
+
+			return
+		}
+	}
+}
+
+// AllDivisibleBy returns an AttributeValidator which ensures that any
+// configured float64 value is divisible by every one of the given divisors. A
+// zero divisor never divides a value.
+//
+// Divisibility of non-integer values is subject to the precision of their
+// binary floating point representation, so divisors such as 0.1 may not
+// behave as expected.
+func AllDivisibleBy(divisors ...float64) tfsdk.AttributeValidator {
+	return allDivisibleByValidator{
+		divisors: divisors,
+	}
+}
@@ -0,0 +1,46 @@
+package float64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestAllDivisibleBy(t *testing.T) {
+	t.Parallel()
+
+	validator := float64validator.AllDivisibleBy(0.5, 1.5)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Float64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Float64{Unknown: true},
+		},
+		"divisible": {
+			Validator: validator,
+			Value:     types.Float64{Value: 4.5},
+		},
+		"zero": {
+			Validator: validator,
+			Value:     types.Float64{Value: 0},
+		},
+		"not-divisible": {
+			Validator: validator,
+			Value:     types.Float64{Value: 2.5},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be divisible by all of: 0.5, 1.5, got: 2.5`,
+				),
+			},
+		},
+	})
+}
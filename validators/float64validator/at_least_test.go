@@ -0,0 +1,56 @@
+package float64validator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestAtLeast(t *testing.T) {
+	t.Parallel()
+
+	validator := float64validator.AtLeast(1.5)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Float64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Float64{Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value:     types.Float64{Value: 1.5},
+		},
+		"greater": {
+			Validator: validator,
+			Value:     types.Float64{Value: 2},
+		},
+		"less": {
+			Validator: validator,
+			Value:     types.Float64{Value: 1.25},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be at least 1.5, got: 1.25`,
+				),
+			},
+		},
+	})
+}
+
+func TestAtLeastBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, float64validator.AtLeast(2.5), tfsdk.AttributeBounds{
+		MinValue: big.NewFloat(2.5),
+	})
+}
@@ -0,0 +1,59 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = atMostValidator{}
+
+type atMostValidator struct {
+	max float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", strconv.FormatFloat(v.max, 'f', -1, 64))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v atMostValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MaxValue: big.NewFloat(v.max),
+	}
+}
+
+// Validate performs the validation.
+func (v atMostValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateFloat64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, strconv.FormatFloat(value, 'f', -1, 64)),
+		))
+	}
+}
+
+// AtMost returns an AttributeValidator which ensures that any configured
+// float64 value is less than or equal to the maximum.
+func AtMost(max float64) tfsdk.AttributeValidator {
+	return atMostValidator{
+		max: max,
+	}
+}
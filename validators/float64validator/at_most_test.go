@@ -0,0 +1,56 @@
+package float64validator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestAtMost(t *testing.T) {
+	t.Parallel()
+
+	validator := float64validator.AtMost(1.5)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Float64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Float64{Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value:     types.Float64{Value: 1.5},
+		},
+		"less": {
+			Validator: validator,
+			Value:     types.Float64{Value: 1},
+		},
+		"greater": {
+			Validator: validator,
+			Value:     types.Float64{Value: 1.75},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be at most 1.5, got: 1.75`,
+				),
+			},
+		},
+	})
+}
+
+func TestAtMostBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, float64validator.AtMost(4.5), tfsdk.AttributeBounds{
+		MaxValue: big.NewFloat(4.5),
+	})
+}
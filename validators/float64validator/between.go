@@ -0,0 +1,62 @@
+package float64validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = betweenValidator{}
+
+type betweenValidator struct {
+	min float64
+	max float64
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", strconv.FormatFloat(v.min, 'f', -1, 64), strconv.FormatFloat(v.max, 'f', -1, 64))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v betweenValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MinValue: big.NewFloat(v.min),
+		MaxValue: big.NewFloat(v.max),
+	}
+}
+
+// Validate performs the validation.
+func (v betweenValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateFloat64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value < v.min || value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, strconv.FormatFloat(value, 'f', -1, 64)),
+		))
+	}
+}
+
+// Between returns an AttributeValidator which ensures that any configured
+// float64 value is between the minimum and maximum, inclusive.
+func Between(min float64, max float64) tfsdk.AttributeValidator {
+	return betweenValidator{
+		min: min,
+		max: max,
+	}
+}
@@ -0,0 +1,2 @@
+// Package float64validator provides validators for types.Float64 attributes.
+package float64validator
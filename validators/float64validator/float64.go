@@ -0,0 +1,48 @@
+package float64validator
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+// validateFloat64 returns the known value of the attribute and true, or false if
+// the value is null, unknown, or cannot be converted to a float64.
+func validateFloat64(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (float64, bool) {
+	raw, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return 0, false
+	}
+
+	if !raw.IsKnown() || raw.IsNull() {
+		return 0, false
+	}
+
+	value, err := types.Float64Type.ValueFromTerraform(ctx, raw)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return 0, false
+	}
+
+	return value.(types.Float64).Value, true
+}
+
+// formatValues returns the values as a comma separated list.
+func formatValues(values []float64) string {
+	formatted := make([]string, 0, len(values))
+
+	for _, value := range values {
+		formatted = append(formatted, strconv.FormatFloat(value, 'f', -1, 64))
+	}
+
+	return strings.Join(formatted, ", ")
+}
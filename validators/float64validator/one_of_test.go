@@ -0,0 +1,42 @@
+package float64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+
+	validator := float64validator.OneOf(1.5, 2.5)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Float64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Float64{Unknown: true},
+		},
+		"match": {
+			Validator: validator,
+			Value:     types.Float64{Value: 2.5},
+		},
+		"mismatch": {
+			Validator: validator,
+			Value:     types.Float64{Value: 2},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: 1.5, 2.5, got: 2`,
+				),
+			},
+		},
+	})
+}
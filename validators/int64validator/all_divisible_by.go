@@ -0,0 +1,56 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = allDivisibleByValidator{}
+
+type allDivisibleByValidator struct {
+	divisors []int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v allDivisibleByValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be divisible by all of: %s", formatValues(v.divisors))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v allDivisibleByValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v allDivisibleByValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateInt64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, divisor := range v.divisors {
+		if divisor == 0 || value%divisor != 0 {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+				req.AttributePath,
+				v.Description(ctx),
+				validatordiag.RedactValue(req, strconv.FormatInt(value, 10)),
+			))
+
+			return
+		}
+	}
+}
+
+// AllDivisibleBy returns an AttributeValidator which ensures that any
+// configured int64 value is divisible by every one of the given divisors. A
+// zero divisor never divides a value.
+func AllDivisibleBy(divisors ...int64) tfsdk.AttributeValidator {
+	return allDivisibleByValidator{
+		divisors: divisors,
+	}
+}
@@ -0,0 +1,57 @@
+package int64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestAllDivisibleBy(t *testing.T) {
+	t.Parallel()
+
+	validator := int64validator.AllDivisibleBy(2, 3)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Int64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Int64{Unknown: true},
+		},
+		"divisible": {
+			Validator: validator,
+			Value:     types.Int64{Value: 12},
+		},
+		"negative": {
+			Validator: validator,
+			Value:     types.Int64{Value: -6},
+		},
+		"not-divisible": {
+			Validator: validator,
+			Value:     types.Int64{Value: 8},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be divisible by all of: 2, 3, got: 8`,
+				),
+			},
+		},
+		"zero-divisor": {
+			Validator: int64validator.AllDivisibleBy(0),
+			Value:     types.Int64{Value: 0},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be divisible by all of: 0, got: 0`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,59 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = atLeastValidator{}
+
+type atLeastValidator struct {
+	min int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", strconv.FormatInt(v.min, 10))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v atLeastValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MinValue: new(big.Float).SetInt64(v.min),
+	}
+}
+
+// Validate performs the validation.
+func (v atLeastValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateInt64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value < v.min {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, strconv.FormatInt(value, 10)),
+		))
+	}
+}
+
+// AtLeast returns an AttributeValidator which ensures that any configured
+// int64 value is greater than or equal to the minimum.
+func AtLeast(min int64) tfsdk.AttributeValidator {
+	return atLeastValidator{
+		min: min,
+	}
+}
@@ -0,0 +1,56 @@
+package int64validator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestAtLeast(t *testing.T) {
+	t.Parallel()
+
+	validator := int64validator.AtLeast(2)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Int64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Int64{Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value:     types.Int64{Value: 2},
+		},
+		"greater": {
+			Validator: validator,
+			Value:     types.Int64{Value: 3},
+		},
+		"less": {
+			Validator: validator,
+			Value:     types.Int64{Value: 1},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be at least 2, got: 1`,
+				),
+			},
+		},
+	})
+}
+
+func TestAtLeastBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, int64validator.AtLeast(2), tfsdk.AttributeBounds{
+		MinValue: big.NewFloat(2),
+	})
+}
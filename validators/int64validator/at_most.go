@@ -0,0 +1,59 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = atMostValidator{}
+
+type atMostValidator struct {
+	max int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", strconv.FormatInt(v.max, 10))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v atMostValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MaxValue: new(big.Float).SetInt64(v.max),
+	}
+}
+
+// Validate performs the validation.
+func (v atMostValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateInt64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, strconv.FormatInt(value, 10)),
+		))
+	}
+}
+
+// AtMost returns an AttributeValidator which ensures that any configured
+// int64 value is less than or equal to the maximum.
+func AtMost(max int64) tfsdk.AttributeValidator {
+	return atMostValidator{
+		max: max,
+	}
+}
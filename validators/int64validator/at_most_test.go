@@ -0,0 +1,56 @@
+package int64validator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestAtMost(t *testing.T) {
+	t.Parallel()
+
+	validator := int64validator.AtMost(2)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Int64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Int64{Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value:     types.Int64{Value: 2},
+		},
+		"less": {
+			Validator: validator,
+			Value:     types.Int64{Value: 1},
+		},
+		"greater": {
+			Validator: validator,
+			Value:     types.Int64{Value: 3},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be at most 2, got: 3`,
+				),
+			},
+		},
+	})
+}
+
+func TestAtMostBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, int64validator.AtMost(4), tfsdk.AttributeBounds{
+		MaxValue: big.NewFloat(4),
+	})
+}
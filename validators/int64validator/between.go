@@ -0,0 +1,62 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = betweenValidator{}
+
+type betweenValidator struct {
+	min int64
+	max int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", strconv.FormatInt(v.min, 10), strconv.FormatInt(v.max, 10))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v betweenValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MinValue: new(big.Float).SetInt64(v.min),
+		MaxValue: new(big.Float).SetInt64(v.max),
+	}
+}
+
+// Validate performs the validation.
+func (v betweenValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateInt64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value < v.min || value > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, strconv.FormatInt(value, 10)),
+		))
+	}
+}
+
+// Between returns an AttributeValidator which ensures that any configured
+// int64 value is between the minimum and maximum, inclusive.
+func Between(min int64, max int64) tfsdk.AttributeValidator {
+	return betweenValidator{
+		min: min,
+		max: max,
+	}
+}
@@ -0,0 +1,68 @@
+package int64validator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	validator := int64validator.Between(2, 4)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Int64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Int64{Unknown: true},
+		},
+		"minimum": {
+			Validator: validator,
+			Value:     types.Int64{Value: 2},
+		},
+		"maximum": {
+			Validator: validator,
+			Value:     types.Int64{Value: 4},
+		},
+		"less": {
+			Validator: validator,
+			Value:     types.Int64{Value: 1},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be between 2 and 4, got: 1`,
+				),
+			},
+		},
+		"greater": {
+			Validator: validator,
+			Value:     types.Int64{Value: 5},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be between 2 and 4, got: 5`,
+				),
+			},
+		},
+	})
+}
+
+func TestBetweenBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, int64validator.Between(2, 4), tfsdk.AttributeBounds{
+		MinValue: big.NewFloat(2),
+		MaxValue: big.NewFloat(4),
+	})
+}
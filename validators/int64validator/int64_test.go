@@ -0,0 +1,83 @@
+package int64validator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateInt64(t *testing.T) {
+	t.Parallel()
+
+	path := path.Root("test")
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expectedValue int64
+		expectedOk    bool
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			value:         types.Int64{Value: 123},
+			expectedValue: 123,
+			expectedOk:    true,
+		},
+		"known-number": {
+			value:         types.Number{Value: big.NewFloat(123)},
+			expectedValue: 123,
+			expectedOk:    true,
+		},
+		"null": {
+			value: types.Int64{Null: true},
+		},
+		"unknown": {
+			value: types.Int64{Unknown: true},
+		},
+		"wrong-type": {
+			value: types.String{Value: "test"},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path,
+					"Invalid Attribute Type",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.String into *big.Float, expected *big.Float",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path,
+				AttributeConfig: tc.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			gotValue, gotOk := validateInt64(context.Background(), req, resp)
+
+			if gotValue != tc.expectedValue {
+				t.Errorf("expected value %d, got %d", tc.expectedValue, gotValue)
+			}
+
+			if gotOk != tc.expectedOk {
+				t.Errorf("expected ok %t, got %t", tc.expectedOk, gotOk)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
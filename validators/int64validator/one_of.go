@@ -0,0 +1,55 @@
+package int64validator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = oneOfValidator{}
+
+type oneOfValidator struct {
+	values []int64
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", formatValues(v.values))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v oneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateInt64(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, expected := range v.values {
+		if value == expected {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.AttributePath,
+		v.Description(ctx),
+		validatordiag.RedactValue(req, strconv.FormatInt(value, 10)),
+	))
+}
+
+// OneOf returns an AttributeValidator which ensures that any configured
+// int64 value is equal to one of the given values.
+func OneOf(values ...int64) tfsdk.AttributeValidator {
+	return oneOfValidator{
+		values: values,
+	}
+}
@@ -0,0 +1,42 @@
+package int64validator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+)
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+
+	validator := int64validator.OneOf(2, 4)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Int64{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Int64{Unknown: true},
+		},
+		"match": {
+			Validator: validator,
+			Value:     types.Int64{Value: 4},
+		},
+		"mismatch": {
+			Validator: validator,
+			Value:     types.Int64{Value: 3},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: 2, 4, got: 3`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,82 @@
+// Package validatordiag contains the diagnostics shared by validators.
+package validatordiag
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// InvalidAttributeValueDiagnostic returns an error diagnostic for an attribute
+// value which does not satisfy the validator description.
+func InvalidAttributeValueDiagnostic(path path.Path, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Value",
+		fmt.Sprintf("Attribute %s %s, got: %s", path, description, value),
+	)
+}
+
+// InvalidAttributeValueLengthDiagnostic returns an error diagnostic for an
+// attribute value with a length which does not satisfy the validator
+// description.
+func InvalidAttributeValueLengthDiagnostic(path path.Path, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Value Length",
+		fmt.Sprintf("Attribute %s %s, got: %s", path, description, value),
+	)
+}
+
+// InvalidAttributeValueMatchDiagnostic returns an error diagnostic for an
+// attribute value which does not match the validator description.
+func InvalidAttributeValueMatchDiagnostic(path path.Path, description string, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Value Match",
+		fmt.Sprintf("Attribute %s %s, got: %s", path, description, value),
+	)
+}
+
+// InvalidAttributeTypeDiagnostic returns an error diagnostic for an attribute
+// value which cannot be validated, as it is not of the expected type.
+func InvalidAttributeTypeDiagnostic(path path.Path, err error) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Type",
+		"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+err.Error(),
+	)
+}
+
+// DuplicateAttributeValueDiagnostic returns an error diagnostic for an
+// attribute value which duplicates another value in the same collection.
+func DuplicateAttributeValueDiagnostic(path path.Path, value string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Duplicate Attribute Value",
+		fmt.Sprintf("Attribute %s contains a duplicate value, got: %s", path, value),
+	)
+}
+
+// InvalidAttributeCombinationDiagnostic returns an error diagnostic for an
+// attribute value which conflicts with, or is missing, the values of other
+// attributes.
+func InvalidAttributeCombinationDiagnostic(path path.Path, detail string) diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path,
+		"Invalid Attribute Combination",
+		detail,
+	)
+}
+
+// RedactValue returns the value for use in a diagnostic, or a placeholder if
+// the attribute is sensitive.
+func RedactValue(req tfsdk.ValidateAttributeRequest, value string) string {
+	if req.AttributeSensitive {
+		return tfsdk.RedactedValue
+	}
+
+	return value
+}
@@ -0,0 +1,96 @@
+// Package validatortest contains a shared test harness for validators.
+package validatortest
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Path is the attribute path used for all validation requests.
+var Path = path.Root("test")
+
+// TestCase is a single validation of an attribute value.
+type TestCase struct {
+	// Validator is the validator under test.
+	Validator tfsdk.AttributeValidator
+
+	// Value is the attribute configuration value.
+	Value attr.Value
+
+	// Config is the entire configuration, which is only necessary for
+	// validators which read other attributes.
+	Config tfsdk.Config
+
+	// Sensitive marks the attribute as sensitive.
+	Sensitive bool
+
+	// ExpectedDiags are the expected diagnostics. If empty, the value is
+	// expected to be valid.
+	ExpectedDiags diag.Diagnostics
+}
+
+// Run validates the value of every test case in a parallel subtest and
+// compares the resulting diagnostics.
+func Run(t *testing.T, testCases map[string]TestCase) {
+	t.Helper()
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:      Path,
+				AttributeConfig:    tc.Value,
+				Config:             tc.Config,
+				AttributeSensitive: tc.Sensitive,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			tc.Validator.Validate(context.Background(), req, resp)
+
+			if diff := cmp.Diff(resp.Diagnostics, tc.ExpectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+// RunBounds compares the bounds of a validator, which must implement
+// tfsdk.AttributeValidatorWithBounds.
+func RunBounds(t *testing.T, validator tfsdk.AttributeValidator, expected tfsdk.AttributeBounds) {
+	t.Helper()
+
+	validatorWithBounds, ok := validator.(tfsdk.AttributeValidatorWithBounds)
+
+	if !ok {
+		t.Fatalf("expected %T to implement tfsdk.AttributeValidatorWithBounds", validator)
+	}
+
+	got := validatorWithBounds.Bounds(context.Background())
+
+	if diff := cmp.Diff(got, expected, cmp.Comparer(bigFloatEqual)); diff != "" {
+		t.Errorf("unexpected bounds difference: %s", diff)
+	}
+}
+
+func bigFloatEqual(a, b *big.Float) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Cmp(b) == 0
+}
+
+// Int64Pointer returns a pointer to the value, for expected bounds.
+func Int64Pointer(value int64) *int64 {
+	return &value
+}
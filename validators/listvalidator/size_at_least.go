@@ -0,0 +1,60 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = sizeAtLeastValidator{}
+
+type sizeAtLeastValidator struct {
+	min int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeAtLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("list must contain at least %d elements", v.min)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeAtLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v sizeAtLeastValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	min := int64(v.min)
+
+	return tfsdk.AttributeBounds{
+		MinItems: &min,
+	}
+}
+
+// Validate performs the validation.
+func (v sizeAtLeastValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateList(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if len(elems) < v.min {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			strconv.Itoa(len(elems)),
+		))
+	}
+}
+
+// SizeAtLeast returns an AttributeValidator which ensures that any configured
+// list contains at least the minimum number of elements.
+func SizeAtLeast(min int) tfsdk.AttributeValidator {
+	return sizeAtLeastValidator{
+		min: min,
+	}
+}
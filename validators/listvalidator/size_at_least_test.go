@@ -0,0 +1,63 @@
+package listvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/listvalidator"
+)
+
+func TestSizeAtLeast(t *testing.T) {
+	t.Parallel()
+
+	validator := listvalidator.SizeAtLeast(2)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.List{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.List{ElemType: types.StringType, Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+				},
+			},
+		},
+		"less": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test list must contain at least 2 elements, got: 1`,
+				),
+			},
+		},
+	})
+}
+
+func TestSizeAtLeastBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, listvalidator.SizeAtLeast(2), tfsdk.AttributeBounds{
+		MinItems: validatortest.Int64Pointer(2),
+	})
+}
@@ -0,0 +1,59 @@
+package listvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = uniqueValuesValidator{}
+
+type uniqueValuesValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v uniqueValuesValidator) Description(_ context.Context) string {
+	return "list values must be unique"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v uniqueValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v uniqueValuesValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateList(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for idx, elem := range elems {
+		// Unknown values may be any value once known, so they cannot be
+		// compared.
+		if !elem.IsFullyKnown() {
+			continue
+		}
+
+		for _, prior := range elems[:idx] {
+			if prior.IsFullyKnown() && elem.Equal(prior) {
+				resp.Diagnostics.Append(validatordiag.DuplicateAttributeValueDiagnostic(
+					req.AttributePath.AtListIndex(idx),
+					validatordiag.RedactValue(req, elem.String()),
+				))
+
+				break
+			}
+		}
+	}
+}
+
+// UniqueValues returns an AttributeValidator which ensures that any configured
+// list does not contain duplicate element values. Each duplicate is reported
+// at the path of the element which repeats an earlier value. Elements which
+// are not fully known are skipped, while null elements are values like any
+// other, so more than one null element is reported as a duplicate.
+func UniqueValues() tfsdk.AttributeValidator {
+	return uniqueValuesValidator{}
+}
@@ -0,0 +1,109 @@
+package listvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/listvalidator"
+)
+
+func TestUniqueValues(t *testing.T) {
+	t.Parallel()
+
+	validator := listvalidator.UniqueValues()
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.List{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.List{ElemType: types.StringType, Unknown: true},
+		},
+		"unique": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+				},
+			},
+		},
+		"unknown elements": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Unknown: true},
+					types.String{Unknown: true},
+					types.String{Value: "a"},
+				},
+			},
+		},
+		"unknown element between duplicates": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Unknown: true},
+					types.String{Value: "a"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(2),
+					"Duplicate Attribute Value",
+					`Attribute test[2] contains a duplicate value, got: tftypes.String<"a">`,
+				),
+			},
+		},
+		"null elements": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Null: true},
+					types.String{Value: "a"},
+					types.String{Null: true},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(2),
+					"Duplicate Attribute Value",
+					"Attribute test[2] contains a duplicate value, got: tftypes.String<null>",
+				),
+			},
+		},
+		"duplicates": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+					types.String{Value: "a"},
+					types.String{Value: "a"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(2),
+					"Duplicate Attribute Value",
+					`Attribute test[2] contains a duplicate value, got: tftypes.String<"a">`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(3),
+					"Duplicate Attribute Value",
+					`Attribute test[3] contains a duplicate value, got: tftypes.String<"a">`,
+				),
+			},
+		},
+	})
+}
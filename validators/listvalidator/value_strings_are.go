@@ -0,0 +1,104 @@
+package listvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ tfsdk.AttributeValidator = valueStringsAreValidator{}
+
+type valueStringsAreValidator struct {
+	validators []tfsdk.AttributeValidator
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueStringsAreValidator) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.validators))
+
+	for _, validator := range v.validators {
+		descriptions = append(descriptions, validator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of: %s", strings.Join(descriptions, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueStringsAreValidator) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.validators))
+
+	for _, validator := range v.validators {
+		descriptions = append(descriptions, validator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of: %s", strings.Join(descriptions, ", "))
+}
+
+// Validate performs the validation.
+func (v valueStringsAreValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateList(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	typ, ok := req.AttributeConfig.Type(ctx).(attr.TypeWithElementType)
+
+	if !ok {
+		err := fmt.Errorf("%T does not implement attr.TypeWithElementType", req.AttributeConfig.Type(ctx))
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return
+	}
+
+	elemType := typ.ElementType()
+
+	if !elemType.TerraformType(ctx).Is(tftypes.String) {
+		err := fmt.Errorf("expected element type tftypes.String, got %s", elemType.TerraformType(ctx))
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return
+	}
+
+	for idx, elem := range elems {
+		v.validateElement(ctx, req, resp, elemType, req.AttributePath.AtListIndex(idx), elem)
+	}
+}
+
+// validateElement runs every validator against a single element value.
+func (v valueStringsAreValidator) validateElement(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse, elemType attr.Type, elemPath path.Path, elem tftypes.Value) {
+	elemValue, err := elemType.ValueFromTerraform(ctx, elem)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(elemPath, err))
+
+		return
+	}
+
+	elemReq := tfsdk.ValidateAttributeRequest{
+		AttributePath:      elemPath,
+		AttributeConfig:    elemValue,
+		Config:             req.Config,
+		AttributeSensitive: req.AttributeSensitive,
+	}
+
+	for _, validator := range v.validators {
+		validator.Validate(ctx, elemReq, resp)
+	}
+}
+
+// ValueStringsAre returns an AttributeValidator which runs the given string
+// validators, such as those in the stringvalidator package, against every
+// element value of any configured list. Diagnostics from those validators
+// refer to the path of the element. The list must have a string element type.
+func ValueStringsAre(validators ...tfsdk.AttributeValidator) tfsdk.AttributeValidator {
+	return valueStringsAreValidator{
+		validators: validators,
+	}
+}
@@ -0,0 +1,96 @@
+package listvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/stringvalidator"
+)
+
+func TestValueStringsAre(t *testing.T) {
+	t.Parallel()
+
+	validator := listvalidator.ValueStringsAre(stringvalidator.LengthBetween(1, 2), stringvalidator.NoneOf("abc"))
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.List{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.List{ElemType: types.StringType, Unknown: true},
+		},
+		"valid": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "ab"},
+				},
+			},
+		},
+		"invalid": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "abc"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(1),
+					"Invalid Attribute Value Length",
+					`Attribute test[1] string length must be between 1 and 2, got: 3`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(1),
+					"Invalid Attribute Value Match",
+					`Attribute test[1] value must be none of: "abc", got: "abc"`,
+				),
+			},
+		},
+		"invalid-sensitive": {
+			Validator: validator,
+			Value: types.List{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "abc"},
+				},
+			},
+			Sensitive: true,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(0),
+					"Invalid Attribute Value Length",
+					`Attribute test[0] string length must be between 1 and 2, got: 3`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtListIndex(0),
+					"Invalid Attribute Value Match",
+					`Attribute test[0] value must be none of: "abc", got: (sensitive value)`,
+				),
+			},
+		},
+		"non-string-elements": {
+			Validator: validator,
+			Value:     types.List{ElemType: types.BoolType},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Type",
+					`An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:
+
+expected element type tftypes.String, got tftypes.Bool`,
+				),
+			},
+		},
+	})
+}
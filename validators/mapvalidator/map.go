@@ -0,0 +1,89 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateMap returns the known elements of the attribute and true, or false
+// if the value is null, unknown, or not a map.
+func validateMap(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (map[string]tftypes.Value, bool) {
+	raw, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	if !raw.IsKnown() || raw.IsNull() {
+		return nil, false
+	}
+
+	var elems map[string]tftypes.Value
+
+	if err := raw.As(&elems); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	return elems, true
+}
+
+// sortedKeys returns the keys of the map in lexical order.
+func sortedKeys(m map[string]tftypes.Value) []string {
+	keys := make([]string, 0, len(m))
+
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// elementType returns the element type of the attribute, or false if the
+// attribute type does not have an element type.
+func elementType(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (attr.Type, bool) {
+	typ, ok := req.AttributeConfig.Type(ctx).(attr.TypeWithElementType)
+
+	if !ok {
+		err := fmt.Errorf("%T does not implement attr.TypeWithElementType", req.AttributeConfig.Type(ctx))
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	return typ.ElementType(), true
+}
+
+// validateElement runs every validator against a single element value.
+func validateElement(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse, elemType attr.Type, elemPath path.Path, elem tftypes.Value, validators []tfsdk.AttributeValidator) {
+	elemValue, err := elemType.ValueFromTerraform(ctx, elem)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(elemPath, err))
+
+		return
+	}
+
+	elemReq := tfsdk.ValidateAttributeRequest{
+		AttributePath:      elemPath,
+		AttributeConfig:    elemValue,
+		Config:             req.Config,
+		AttributeSensitive: req.AttributeSensitive,
+	}
+
+	for _, validator := range validators {
+		validator.Validate(ctx, elemReq, resp)
+	}
+}
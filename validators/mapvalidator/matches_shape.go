@@ -0,0 +1,138 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// UndeclaredKeys is an enum type of the ways a Shape handles map keys which
+// it does not declare.
+type UndeclaredKeys uint8
+
+const (
+	// UndeclaredKeysAllow passes through undeclared keys without
+	// diagnostics. This is the default.
+	UndeclaredKeysAllow UndeclaredKeys = 0
+
+	// UndeclaredKeysWarn returns a warning diagnostic for each undeclared
+	// key, such as when migrating practitioners away from unsupported keys.
+	UndeclaredKeysWarn UndeclaredKeys = 1
+
+	// UndeclaredKeysReject returns an error diagnostic for each undeclared
+	// key.
+	UndeclaredKeysReject UndeclaredKeys = 2
+)
+
+// ShapeKey describes a single expected key of a map.
+type ShapeKey struct {
+	// Required indicates the key must be present in the map.
+	Required bool
+
+	// Validators are run against the value of the key, when present.
+	Validators []tfsdk.AttributeValidator
+}
+
+// Shape describes the expected keys of a loosely structured map.
+type Shape struct {
+	// Keys are the declared keys of the map.
+	Keys map[string]ShapeKey
+
+	// UndeclaredKeys determines how keys missing from Keys are handled.
+	UndeclaredKeys UndeclaredKeys
+}
+
+var _ tfsdk.AttributeValidator = matchesShapeValidator{}
+
+type matchesShapeValidator struct {
+	shape Shape
+}
+
+// Description describes the validation in plain text formatting.
+func (v matchesShapeValidator) Description(_ context.Context) string {
+	keys := make([]string, 0, len(v.shape.Keys))
+
+	for key := range v.shape.Keys {
+		keys = append(keys, fmt.Sprintf("%q", key))
+	}
+
+	sort.Strings(keys)
+
+	if v.shape.UndeclaredKeys == UndeclaredKeysAllow {
+		return fmt.Sprintf("map keys may include: %s", strings.Join(keys, ", "))
+	}
+
+	return fmt.Sprintf("map keys must be one of: %s", strings.Join(keys, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v matchesShapeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v matchesShapeValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateMap(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	elemType, ok := elementType(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	declaredKeys := make([]string, 0, len(v.shape.Keys))
+
+	for key := range v.shape.Keys {
+		declaredKeys = append(declaredKeys, key)
+	}
+
+	sort.Strings(declaredKeys)
+
+	for _, key := range declaredKeys {
+		if _, ok := elems[key]; !ok && v.shape.Keys[key].Required {
+			resp.Diagnostics.AddAttributeError(
+				req.AttributePath,
+				"Missing Map Key",
+				fmt.Sprintf("Attribute %s must contain the key %q.", req.AttributePath, key),
+			)
+		}
+	}
+
+	for _, key := range sortedKeys(elems) {
+		elemPath := req.AttributePath.AtMapKey(key)
+		shapeKey, ok := v.shape.Keys[key]
+
+		if ok {
+			validateElement(ctx, req, resp, elemType, elemPath, elems[key], shapeKey.Validators)
+
+			continue
+		}
+
+		detail := fmt.Sprintf("Attribute %s contains the undeclared key %q, %s.", req.AttributePath, key, v.Description(ctx))
+
+		switch v.shape.UndeclaredKeys {
+		case UndeclaredKeysWarn:
+			resp.Diagnostics.Append(diag.NewAttributeWarningDiagnostic(elemPath, "Undeclared Map Key", detail))
+		case UndeclaredKeysReject:
+			resp.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(elemPath, "Undeclared Map Key", detail))
+		}
+	}
+}
+
+// MatchesShape returns an AttributeValidator which ensures that any configured
+// map contains the required keys of the shape and that the values of declared
+// keys pass their validators. Keys which the shape does not declare are
+// allowed, warned about, or rejected, depending on its UndeclaredKeys.
+func MatchesShape(shape Shape) tfsdk.AttributeValidator {
+	return matchesShapeValidator{
+		shape: shape,
+	}
+}
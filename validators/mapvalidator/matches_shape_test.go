@@ -0,0 +1,119 @@
+package mapvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/validators/stringvalidator"
+)
+
+func TestMatchesShape(t *testing.T) {
+	t.Parallel()
+
+	shape := func(undeclaredKeys mapvalidator.UndeclaredKeys) mapvalidator.Shape {
+		return mapvalidator.Shape{
+			Keys: map[string]mapvalidator.ShapeKey{
+				"name": {
+					Required: true,
+					Validators: []tfsdk.AttributeValidator{
+						stringvalidator.LengthBetween(1, 3),
+					},
+				},
+				"tier": {},
+			},
+			UndeclaredKeys: undeclaredKeys,
+		}
+	}
+
+	extraKeys := types.Map{
+		ElemType: types.StringType,
+		Elems: map[string]attr.Value{
+			"extra": types.String{Value: "value"},
+			"name":  types.String{Value: "abc"},
+		},
+	}
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysReject)),
+			Value:     types.Map{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysReject)),
+			Value:     types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"declared": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysReject)),
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"name": types.String{Value: "abc"},
+					"tier": types.String{Value: "anything"},
+				},
+			},
+		},
+		"missing-required": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysReject)),
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"tier": types.String{Value: "anything"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Missing Map Key",
+					`Attribute test must contain the key "name".`,
+				),
+			},
+		},
+		"invalid-value": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysReject)),
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"name": types.String{Value: "abcd"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtMapKey("name"),
+					"Invalid Attribute Value Length",
+					`Attribute test["name"] string length must be between 1 and 3, got: 4`,
+				),
+			},
+		},
+		"undeclared-allow": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysAllow)),
+			Value:     extraKeys,
+		},
+		"undeclared-warn": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysWarn)),
+			Value:     extraKeys,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(
+					validatortest.Path.AtMapKey("extra"),
+					"Undeclared Map Key",
+					`Attribute test contains the undeclared key "extra", map keys must be one of: "name", "tier".`,
+				),
+			},
+		},
+		"undeclared-reject": {
+			Validator: mapvalidator.MatchesShape(shape(mapvalidator.UndeclaredKeysReject)),
+			Value:     extraKeys,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtMapKey("extra"),
+					"Undeclared Map Key",
+					`Attribute test contains the undeclared key "extra", map keys must be one of: "name", "tier".`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package mapvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/mapvalidator"
+)
+
+func TestSizeAtLeast(t *testing.T) {
+	t.Parallel()
+
+	validator := mapvalidator.SizeAtLeast(2)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "a"},
+					"b": types.String{Value: "b"},
+				},
+			},
+		},
+		"less": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "a"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test map must contain at least 2 elements, got: 1`,
+				),
+			},
+		},
+	})
+}
+
+func TestSizeAtLeastBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, mapvalidator.SizeAtLeast(2), tfsdk.AttributeBounds{
+		MinItems: validatortest.Int64Pointer(2),
+	})
+}
@@ -0,0 +1,60 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = sizeAtMostValidator{}
+
+type sizeAtMostValidator struct {
+	max int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map must contain at most %d elements", v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v sizeAtMostValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	max := int64(v.max)
+
+	return tfsdk.AttributeBounds{
+		MaxItems: &max,
+	}
+}
+
+// Validate performs the validation.
+func (v sizeAtMostValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateMap(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if len(elems) > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			strconv.Itoa(len(elems)),
+		))
+	}
+}
+
+// SizeAtMost returns an AttributeValidator which ensures that any configured
+// map contains at most the maximum number of elements.
+func SizeAtMost(max int) tfsdk.AttributeValidator {
+	return sizeAtMostValidator{
+		max: max,
+	}
+}
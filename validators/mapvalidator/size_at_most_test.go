@@ -0,0 +1,70 @@
+package mapvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/mapvalidator"
+)
+
+func TestSizeAtMost(t *testing.T) {
+	t.Parallel()
+
+	validator := mapvalidator.SizeAtMost(1)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "a"},
+				},
+			},
+		},
+		"empty": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems:    map[string]attr.Value{},
+			},
+		},
+		"greater": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "a"},
+					"b": types.String{Value: "b"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test map must contain at most 1 elements, got: 2`,
+				),
+			},
+		},
+	})
+}
+
+func TestSizeAtMostBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, mapvalidator.SizeAtMost(2), tfsdk.AttributeBounds{
+		MaxItems: validatortest.Int64Pointer(2),
+	})
+}
@@ -0,0 +1,64 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = sizeBetweenValidator{}
+
+type sizeBetweenValidator struct {
+	min int
+	max int
+}
+
+// Description describes the validation in plain text formatting.
+func (v sizeBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("map must contain at least %d elements and at most %d elements", v.min, v.max)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v sizeBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v sizeBetweenValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	min := int64(v.min)
+	max := int64(v.max)
+
+	return tfsdk.AttributeBounds{
+		MinItems: &min,
+		MaxItems: &max,
+	}
+}
+
+// Validate performs the validation.
+func (v sizeBetweenValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateMap(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if len(elems) < v.min || len(elems) > v.max {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			strconv.Itoa(len(elems)),
+		))
+	}
+}
+
+// SizeBetween returns an AttributeValidator which ensures that any configured
+// map contains between the minimum and maximum number of elements, inclusive.
+func SizeBetween(min int, max int) tfsdk.AttributeValidator {
+	return sizeBetweenValidator{
+		min: min,
+		max: max,
+	}
+}
@@ -0,0 +1,89 @@
+package mapvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/mapvalidator"
+)
+
+func TestSizeBetween(t *testing.T) {
+	t.Parallel()
+
+	validator := mapvalidator.SizeBetween(1, 2)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"minimum": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "a"},
+				},
+			},
+		},
+		"maximum": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "a"},
+					"b": types.String{Value: "b"},
+				},
+			},
+		},
+		"less": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems:    map[string]attr.Value{},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test map must contain at least 1 elements and at most 2 elements, got: 0`,
+				),
+			},
+		},
+		"greater": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "a"},
+					"b": types.String{Value: "b"},
+					"c": types.String{Value: "c"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test map must contain at least 1 elements and at most 2 elements, got: 3`,
+				),
+			},
+		},
+	})
+}
+
+func TestSizeBetweenBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, mapvalidator.SizeBetween(1, 3), tfsdk.AttributeBounds{
+		MinItems: validatortest.Int64Pointer(1),
+		MaxItems: validatortest.Int64Pointer(3),
+	})
+}
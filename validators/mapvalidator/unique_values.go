@@ -0,0 +1,61 @@
+package mapvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = uniqueValuesValidator{}
+
+type uniqueValuesValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (v uniqueValuesValidator) Description(_ context.Context) string {
+	return "map values must be unique"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v uniqueValuesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v uniqueValuesValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateMap(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	keys := sortedKeys(elems)
+
+	for idx, key := range keys {
+		// Unknown values may be any value once known, so they cannot be
+		// compared.
+		if !elems[key].IsFullyKnown() {
+			continue
+		}
+
+		for _, priorKey := range keys[:idx] {
+			if elems[priorKey].IsFullyKnown() && elems[key].Equal(elems[priorKey]) {
+				resp.Diagnostics.Append(validatordiag.DuplicateAttributeValueDiagnostic(
+					req.AttributePath.AtMapKey(key),
+					validatordiag.RedactValue(req, elems[key].String()),
+				))
+
+				break
+			}
+		}
+	}
+}
+
+// UniqueValues returns an AttributeValidator which ensures that any configured
+// map does not contain duplicate element values. Each duplicate is reported at
+// the path of the key which sorts after the key of the earlier value. Values
+// which are not fully known are skipped, while null values are values like
+// any other, so more than one null value is reported as a duplicate.
+func UniqueValues() tfsdk.AttributeValidator {
+	return uniqueValuesValidator{}
+}
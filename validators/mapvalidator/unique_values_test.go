@@ -0,0 +1,103 @@
+package mapvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/mapvalidator"
+)
+
+func TestUniqueValues(t *testing.T) {
+	t.Parallel()
+
+	validator := mapvalidator.UniqueValues()
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Map{ElemType: types.StringType, Unknown: true},
+		},
+		"unique": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "x"},
+					"b": types.String{Value: "y"},
+				},
+			},
+		},
+		"unknown values": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Unknown: true},
+					"b": types.String{Unknown: true},
+					"c": types.String{Value: "x"},
+				},
+			},
+		},
+		"unknown value between duplicates": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "x"},
+					"b": types.String{Unknown: true},
+					"c": types.String{Value: "x"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtMapKey("c"),
+					"Duplicate Attribute Value",
+					`Attribute test["c"] contains a duplicate value, got: tftypes.String<"x">`,
+				),
+			},
+		},
+		"null values": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Null: true},
+					"b": types.String{Value: "x"},
+					"c": types.String{Null: true},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtMapKey("c"),
+					"Duplicate Attribute Value",
+					`Attribute test["c"] contains a duplicate value, got: tftypes.String<null>`,
+				),
+			},
+		},
+		"duplicates": {
+			Validator: validator,
+			Value: types.Map{
+				ElemType: types.StringType,
+				Elems: map[string]attr.Value{
+					"a": types.String{Value: "x"},
+					"b": types.String{Value: "y"},
+					"c": types.String{Value: "x"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path.AtMapKey("c"),
+					"Duplicate Attribute Value",
+					`Attribute test["c"] contains a duplicate value, got: tftypes.String<"x">`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package mapvalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ tfsdk.AttributeValidator = valueStringsAreValidator{}
+
+type valueStringsAreValidator struct {
+	validators []tfsdk.AttributeValidator
+}
+
+// Description describes the validation in plain text formatting.
+func (v valueStringsAreValidator) Description(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.validators))
+
+	for _, validator := range v.validators {
+		descriptions = append(descriptions, validator.Description(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of: %s", strings.Join(descriptions, ", "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v valueStringsAreValidator) MarkdownDescription(ctx context.Context) string {
+	descriptions := make([]string, 0, len(v.validators))
+
+	for _, validator := range v.validators {
+		descriptions = append(descriptions, validator.MarkdownDescription(ctx))
+	}
+
+	return fmt.Sprintf("element values must satisfy all of: %s", strings.Join(descriptions, ", "))
+}
+
+// Validate performs the validation.
+func (v valueStringsAreValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	elems, ok := validateMap(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	elemType, ok := elementType(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if !elemType.TerraformType(ctx).Is(tftypes.String) {
+		err := fmt.Errorf("expected element type tftypes.String, got %s", elemType.TerraformType(ctx))
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return
+	}
+
+	for _, key := range sortedKeys(elems) {
+		validateElement(ctx, req, resp, elemType, req.AttributePath.AtMapKey(key), elems[key], v.validators)
+	}
+}
+
+// ValueStringsAre returns an AttributeValidator which runs the given string
+// validators, such as those in the stringvalidator package, against every
+// element value of any configured map. Diagnostics from those validators
+// refer to the path of the element. The map must have a string element type.
+func ValueStringsAre(validators ...tfsdk.AttributeValidator) tfsdk.AttributeValidator {
+	return valueStringsAreValidator{
+		validators: validators,
+	}
+}
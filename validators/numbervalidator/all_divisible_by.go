@@ -0,0 +1,60 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = allDivisibleByValidator{}
+
+type allDivisibleByValidator struct {
+	divisors []*big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v allDivisibleByValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be divisible by all of: %s", formatValues(v.divisors))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v allDivisibleByValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v allDivisibleByValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateNumber(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, divisor := range v.divisors {
+		if !isDivisible(value, divisor) {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+				req.AttributePath,
+				v.Description(ctx),
+				validatordiag.RedactValue(req, value.Text('g', -1)),
+			))
+
+			return
+		}
+	}
+}
+
+// AllDivisibleBy returns an AttributeValidator which ensures that any
+// configured number value is divisible by every one of the given divisors. A
+// zero divisor never divides a value.
+//
+// Divisibility of non-integer values is subject to the precision of their
+// binary floating point representation, so divisors such as 0.1 may not
+// behave as expected.
+func AllDivisibleBy(divisors ...*big.Float) tfsdk.AttributeValidator {
+	return allDivisibleByValidator{
+		divisors: divisors,
+	}
+}
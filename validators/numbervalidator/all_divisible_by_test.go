@@ -0,0 +1,47 @@
+package numbervalidator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/numbervalidator"
+)
+
+func TestAllDivisibleBy(t *testing.T) {
+	t.Parallel()
+
+	validator := numbervalidator.AllDivisibleBy(big.NewFloat(0.5), big.NewFloat(1.5))
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Number{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Number{Unknown: true},
+		},
+		"divisible": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(4.5)},
+		},
+		"zero": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(0)},
+		},
+		"not-divisible": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(2.5)},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be divisible by all of: 0.5, 1.5, got: 2.5`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,58 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = atLeastValidator{}
+
+type atLeastValidator struct {
+	min *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at least %s", v.min.Text('g', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v atLeastValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MinValue: new(big.Float).Copy(v.min),
+	}
+}
+
+// Validate performs the validation.
+func (v atLeastValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateNumber(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value.Cmp(v.min) < 0 {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, value.Text('g', -1)),
+		))
+	}
+}
+
+// AtLeast returns an AttributeValidator which ensures that any configured
+// number value is greater than or equal to the minimum.
+func AtLeast(min *big.Float) tfsdk.AttributeValidator {
+	return atLeastValidator{
+		min: min,
+	}
+}
@@ -0,0 +1,56 @@
+package numbervalidator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/numbervalidator"
+)
+
+func TestAtLeast(t *testing.T) {
+	t.Parallel()
+
+	validator := numbervalidator.AtLeast(big.NewFloat(1.5))
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Number{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Number{Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(1.5)},
+		},
+		"greater": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(2)},
+		},
+		"less": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(1.25)},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be at least 1.5, got: 1.25`,
+				),
+			},
+		},
+	})
+}
+
+func TestAtLeastBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, numbervalidator.AtLeast(big.NewFloat(2.5)), tfsdk.AttributeBounds{
+		MinValue: big.NewFloat(2.5),
+	})
+}
@@ -0,0 +1,58 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = atMostValidator{}
+
+type atMostValidator struct {
+	max *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v atMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be at most %s", v.max.Text('g', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v atMostValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MaxValue: new(big.Float).Copy(v.max),
+	}
+}
+
+// Validate performs the validation.
+func (v atMostValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateNumber(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value.Cmp(v.max) > 0 {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, value.Text('g', -1)),
+		))
+	}
+}
+
+// AtMost returns an AttributeValidator which ensures that any configured
+// number value is less than or equal to the maximum.
+func AtMost(max *big.Float) tfsdk.AttributeValidator {
+	return atMostValidator{
+		max: max,
+	}
+}
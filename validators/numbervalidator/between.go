@@ -0,0 +1,61 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = betweenValidator{}
+
+type betweenValidator struct {
+	min *big.Float
+	max *big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v betweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be between %s and %s", v.min.Text('g', -1), v.max.Text('g', -1))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v betweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v betweenValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	return tfsdk.AttributeBounds{
+		MinValue: new(big.Float).Copy(v.min),
+		MaxValue: new(big.Float).Copy(v.max),
+	}
+}
+
+// Validate performs the validation.
+func (v betweenValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateNumber(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if value.Cmp(v.min) < 0 || value.Cmp(v.max) > 0 {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, value.Text('g', -1)),
+		))
+	}
+}
+
+// Between returns an AttributeValidator which ensures that any configured
+// number value is between the minimum and maximum, inclusive.
+func Between(min *big.Float, max *big.Float) tfsdk.AttributeValidator {
+	return betweenValidator{
+		min: min,
+		max: max,
+	}
+}
@@ -0,0 +1,68 @@
+package numbervalidator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/numbervalidator"
+)
+
+func TestBetween(t *testing.T) {
+	t.Parallel()
+
+	validator := numbervalidator.Between(big.NewFloat(1.5), big.NewFloat(2.5))
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Number{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Number{Unknown: true},
+		},
+		"minimum": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(1.5)},
+		},
+		"maximum": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(2.5)},
+		},
+		"less": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(1.25)},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be between 1.5 and 2.5, got: 1.25`,
+				),
+			},
+		},
+		"greater": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(2.75)},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test value must be between 1.5 and 2.5, got: 2.75`,
+				),
+			},
+		},
+	})
+}
+
+func TestBetweenBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, numbervalidator.Between(big.NewFloat(2.5), big.NewFloat(4.5)), tfsdk.AttributeBounds{
+		MinValue: big.NewFloat(2.5),
+		MaxValue: big.NewFloat(4.5),
+	})
+}
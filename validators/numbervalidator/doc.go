@@ -0,0 +1,2 @@
+// Package numbervalidator provides validators for types.Number attributes.
+package numbervalidator
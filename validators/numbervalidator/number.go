@@ -0,0 +1,58 @@
+package numbervalidator
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+// validateNumber returns the known value of the attribute and true, or false if
+// the value is null, unknown, or cannot be converted to a number.
+func validateNumber(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (*big.Float, bool) {
+	raw, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	if !raw.IsKnown() || raw.IsNull() {
+		return nil, false
+	}
+
+	value, err := types.NumberType.ValueFromTerraform(ctx, raw)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	return value.(types.Number).Value, true
+}
+
+// formatValues returns the values as a comma separated list.
+func formatValues(values []*big.Float) string {
+	formatted := make([]string, 0, len(values))
+
+	for _, value := range values {
+		formatted = append(formatted, value.Text('g', -1))
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// isDivisible returns true if the quotient of value and divisor is an
+// integer. A zero divisor never divides a value.
+func isDivisible(value *big.Float, divisor *big.Float) bool {
+	if divisor.Sign() == 0 {
+		return false
+	}
+
+	return new(big.Float).Quo(value, divisor).IsInt()
+}
@@ -0,0 +1,55 @@
+package numbervalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = oneOfValidator{}
+
+type oneOfValidator struct {
+	values []*big.Float
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", formatValues(v.values))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v oneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	value, ok := validateNumber(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, expected := range v.values {
+		if value.Cmp(expected) == 0 {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.AttributePath,
+		v.Description(ctx),
+		validatordiag.RedactValue(req, value.Text('g', -1)),
+	))
+}
+
+// OneOf returns an AttributeValidator which ensures that any configured
+// number value is equal to one of the given values.
+func OneOf(values ...*big.Float) tfsdk.AttributeValidator {
+	return oneOfValidator{
+		values: values,
+	}
+}
@@ -0,0 +1,43 @@
+package numbervalidator_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/numbervalidator"
+)
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+
+	validator := numbervalidator.OneOf(big.NewFloat(1.5), big.NewFloat(2.5))
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Number{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Number{Unknown: true},
+		},
+		"match": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(2.5)},
+		},
+		"mismatch": {
+			Validator: validator,
+			Value:     types.Number{Value: big.NewFloat(2)},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: 1.5, 2.5, got: 2`,
+				),
+			},
+		},
+	})
+}
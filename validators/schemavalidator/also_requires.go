@@ -0,0 +1,68 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var (
+	_ tfsdk.AttributeValidator  = alsoRequiresValidator{}
+	_ tfsdk.AttributeReferencer = alsoRequiresValidator{}
+)
+
+type alsoRequiresValidator struct {
+	paths path.Paths
+}
+
+// Description describes the validation in plain text formatting.
+func (v alsoRequiresValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("attribute requires %s to also be specified", formatPaths(v.paths))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v alsoRequiresValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ReferencedAttributes returns the required attribute paths.
+func (v alsoRequiresValidator) ReferencedAttributes(_ context.Context) path.Paths {
+	return v.paths
+}
+
+// Validate performs the validation.
+func (v alsoRequiresValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	if !isConfigured(ctx, req, resp) {
+		return
+	}
+
+	values, ok := configValues(ctx, req, resp, withoutPath(req.AttributePath, v.paths))
+
+	if !ok {
+		return
+	}
+
+	for _, other := range values {
+		if !other.value.IsNull() {
+			continue
+		}
+
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			req.AttributePath,
+			fmt.Sprintf("Attribute %s must be specified when %s is specified", other.path, req.AttributePath),
+		))
+	}
+}
+
+// AlsoRequires returns an AttributeValidator which ensures that, when the
+// attribute is configured, all of the given attribute paths are also
+// configured. It is the equivalent of the terraform-plugin-sdk RequiredWith
+// schema behavior.
+func AlsoRequires(paths ...path.Path) tfsdk.AttributeValidator {
+	return alsoRequiresValidator{
+		paths: paths,
+	}
+}
@@ -0,0 +1,56 @@
+package schemavalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAlsoRequires(t *testing.T) {
+	t.Parallel()
+
+	validator := schemavalidator.AlsoRequires(otherPath, anotherPath)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config:    testConfig(nil),
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Unknown: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"all-configured": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"another": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"other":   tftypes.NewValue(tftypes.String, "other"),
+				"test":    tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"missing": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "other"),
+				"test":  tftypes.NewValue(tftypes.String, "test"),
+			}),
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Combination",
+					`Attribute another must be specified when test is specified`,
+				),
+			},
+		},
+	})
+}
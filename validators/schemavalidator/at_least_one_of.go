@@ -0,0 +1,63 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var (
+	_ tfsdk.AttributeValidator  = atLeastOneOfValidator{}
+	_ tfsdk.AttributeReferencer = atLeastOneOfValidator{}
+)
+
+type atLeastOneOfValidator struct {
+	paths path.Paths
+}
+
+// Description describes the validation in plain text formatting.
+func (v atLeastOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("at least one of this attribute or %s must be specified", formatPaths(v.paths))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v atLeastOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ReferencedAttributes returns the other attribute paths.
+func (v atLeastOneOfValidator) ReferencedAttributes(_ context.Context) path.Paths {
+	return v.paths
+}
+
+// Validate performs the validation.
+func (v atLeastOneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	paths := withPath(req.AttributePath, v.paths)
+	values, ok := configValues(ctx, req, resp, paths)
+
+	if !ok {
+		return
+	}
+
+	for _, value := range values {
+		if !value.value.IsNull() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+		req.AttributePath,
+		fmt.Sprintf("No attributes specified when at least one of %s must be specified", formatPaths(paths)),
+	))
+}
+
+// AtLeastOneOf returns an AttributeValidator which ensures that at least one
+// of the attribute and the given attribute paths is configured.
+func AtLeastOneOf(paths ...path.Path) tfsdk.AttributeValidator {
+	return atLeastOneOfValidator{
+		paths: paths,
+	}
+}
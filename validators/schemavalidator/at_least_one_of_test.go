@@ -0,0 +1,54 @@
+package schemavalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAtLeastOneOf(t *testing.T) {
+	t.Parallel()
+
+	validator := schemavalidator.AtLeastOneOf(otherPath)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"self": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"both": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "other"),
+				"test":  tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"none": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config:    testConfig(nil),
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Combination",
+					`No attributes specified when at least one of [test, other] must be specified`,
+				),
+			},
+		},
+	})
+}
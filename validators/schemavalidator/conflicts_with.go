@@ -0,0 +1,66 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var (
+	_ tfsdk.AttributeValidator  = conflictsWithValidator{}
+	_ tfsdk.AttributeReferencer = conflictsWithValidator{}
+)
+
+type conflictsWithValidator struct {
+	paths path.Paths
+}
+
+// Description describes the validation in plain text formatting.
+func (v conflictsWithValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("attribute cannot be specified when any of %s are specified", formatPaths(v.paths))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v conflictsWithValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ReferencedAttributes returns the conflicting attribute paths.
+func (v conflictsWithValidator) ReferencedAttributes(_ context.Context) path.Paths {
+	return v.paths
+}
+
+// Validate performs the validation.
+func (v conflictsWithValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	if !isConfigured(ctx, req, resp) {
+		return
+	}
+
+	values, ok := configValues(ctx, req, resp, withoutPath(req.AttributePath, v.paths))
+
+	if !ok {
+		return
+	}
+
+	for _, other := range values {
+		if !other.configured() {
+			continue
+		}
+
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			req.AttributePath,
+			fmt.Sprintf("Attribute %s cannot be specified when %s is specified", req.AttributePath, other.path),
+		))
+	}
+}
+
+// ConflictsWith returns an AttributeValidator which ensures that, when the
+// attribute is configured, none of the given attribute paths are configured.
+func ConflictsWith(paths ...path.Path) tfsdk.AttributeValidator {
+	return conflictsWithValidator{
+		paths: paths,
+	}
+}
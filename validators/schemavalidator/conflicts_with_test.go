@@ -0,0 +1,78 @@
+package schemavalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestConflictsWith(t *testing.T) {
+	t.Parallel()
+
+	validator := schemavalidator.ConflictsWith(otherPath, anotherPath)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "other"),
+			}),
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Unknown: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"test":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"other": tftypes.NewValue(tftypes.String, "other"),
+			}),
+		},
+		"no-conflict": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"other-unknown": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"test":  tftypes.NewValue(tftypes.String, "test"),
+				"other": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"conflicts": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"another": tftypes.NewValue(tftypes.String, "another"),
+				"other":   tftypes.NewValue(tftypes.String, "other"),
+				"test":    tftypes.NewValue(tftypes.String, "test"),
+			}),
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Combination",
+					`Attribute test cannot be specified when other is specified`,
+				),
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Combination",
+					`Attribute test cannot be specified when another is specified`,
+				),
+			},
+		},
+		"self-reference": {
+			Validator: schemavalidator.ConflictsWith(validatortest.Path),
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+	})
+}
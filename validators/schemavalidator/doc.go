@@ -0,0 +1,13 @@
+// Package schemavalidator provides attribute validators which express
+// relationships between the attribute and other attribute paths in the same
+// configuration, similar to the ConflictsWith, RequiredWith, ExactlyOneOf,
+// and AtLeastOneOf schema behaviors of terraform-plugin-sdk.
+//
+// Paths are absolute, starting from the root of the schema. An unknown value
+// may later become null or not, so no error is reported when unknown values
+// prevent a decision. For example, an unknown value does not conflict with
+// other attributes, but does satisfy AlsoRequires.
+//
+// Every validator in this package implements tfsdk.AttributeReferencer, so
+// the relationships are included in Schema.AttributeDependencies.
+package schemavalidator
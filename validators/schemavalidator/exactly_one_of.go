@@ -0,0 +1,80 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var (
+	_ tfsdk.AttributeValidator  = exactlyOneOfValidator{}
+	_ tfsdk.AttributeReferencer = exactlyOneOfValidator{}
+)
+
+type exactlyOneOfValidator struct {
+	paths path.Paths
+}
+
+// Description describes the validation in plain text formatting.
+func (v exactlyOneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("exactly one of this attribute or %s must be specified", formatPaths(v.paths))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v exactlyOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ReferencedAttributes returns the other attribute paths.
+func (v exactlyOneOfValidator) ReferencedAttributes(_ context.Context) path.Paths {
+	return v.paths
+}
+
+// Validate performs the validation.
+func (v exactlyOneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	paths := withPath(req.AttributePath, v.paths)
+	values, ok := configValues(ctx, req, resp, paths)
+
+	if !ok {
+		return
+	}
+
+	var configured, unknown int
+
+	for _, value := range values {
+		switch {
+		case !value.value.IsKnown():
+			unknown++
+		case !value.value.IsNull():
+			configured++
+		}
+	}
+
+	switch {
+	case configured > 1:
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			req.AttributePath,
+			fmt.Sprintf("%d attributes specified when exactly one of %s must be specified", configured, formatPaths(paths)),
+		))
+	case configured == 0 && unknown == 0:
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			req.AttributePath,
+			fmt.Sprintf("No attributes specified when exactly one of %s must be specified", formatPaths(paths)),
+		))
+	}
+}
+
+// ExactlyOneOf returns an AttributeValidator which ensures that exactly one
+// of the attribute and the given attribute paths is configured.
+//
+// The same validator is typically added to each of the related attributes,
+// which reports the same error for each of them. Add it to only one of them
+// to report a single error.
+func ExactlyOneOf(paths ...path.Path) tfsdk.AttributeValidator {
+	return exactlyOneOfValidator{
+		paths: paths,
+	}
+}
@@ -0,0 +1,68 @@
+package schemavalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExactlyOneOf(t *testing.T) {
+	t.Parallel()
+
+	validator := schemavalidator.ExactlyOneOf(otherPath, anotherPath)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"self": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"test": tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"other": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "other"),
+			}),
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"none": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config:    testConfig(nil),
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Combination",
+					`No attributes specified when exactly one of [test, other, another] must be specified`,
+				),
+			},
+		},
+		"multiple": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"another": tftypes.NewValue(tftypes.String, "another"),
+				"test":    tftypes.NewValue(tftypes.String, "test"),
+			}),
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Combination",
+					`2 attributes specified when exactly one of [test, other, another] must be specified`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ tfsdk.AttributeValidator  = requiredWhenValidator{}
+	_ tfsdk.AttributeReferencer = requiredWhenValidator{}
+)
+
+type requiredWhenValidator struct {
+	path  path.Path
+	value attr.Value
+}
+
+// Description describes the validation in plain text formatting.
+func (v requiredWhenValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("attribute must be specified when %s is %s", v.path, formatAttrValue(ctx, v.value))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v requiredWhenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ReferencedAttributes returns the conditional attribute path.
+func (v requiredWhenValidator) ReferencedAttributes(_ context.Context) path.Paths {
+	return path.Paths{v.path}
+}
+
+// Validate performs the validation.
+func (v requiredWhenValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	tfValue, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return
+	}
+
+	// an unknown value may later be specified
+	if !tfValue.IsNull() {
+		return
+	}
+
+	values, ok := configValues(ctx, req, resp, path.Paths{v.path})
+
+	if !ok {
+		return
+	}
+
+	expected, err := v.value.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(v.path, err))
+
+		return
+	}
+
+	for _, other := range values {
+		if !other.value.IsKnown() || !other.value.Equal(expected) {
+			continue
+		}
+
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+			req.AttributePath,
+			fmt.Sprintf("Attribute %s must be specified when %s is %s", req.AttributePath, other.path, formatAttrValue(ctx, v.value)),
+		))
+	}
+}
+
+// RequiredWhen returns an AttributeValidator which ensures that the
+// attribute is configured when the attribute at the given path is
+// configured with the given value, such as requiring a certificate when a
+// protocol attribute is "https". The value must be of the type of the
+// attribute at the path.
+func RequiredWhen(path path.Path, value attr.Value) tfsdk.AttributeValidator {
+	return requiredWhenValidator{
+		path:  path,
+		value: value,
+	}
+}
+
+// formatAttrValue returns a human readable representation of a value, such
+// as a quoted string.
+func formatAttrValue(ctx context.Context, value attr.Value) string {
+	tfValue, err := value.ToTerraformValue(ctx)
+
+	if err != nil || !tfValue.IsKnown() || tfValue.IsNull() {
+		return fmt.Sprintf("%v", value)
+	}
+
+	switch {
+	case tfValue.Type().Is(tftypes.String):
+		var s string
+
+		if err := tfValue.As(&s); err == nil {
+			return strconv.Quote(s)
+		}
+	case tfValue.Type().Is(tftypes.Number):
+		var f big.Float
+
+		if err := tfValue.As(&f); err == nil {
+			return f.Text('f', -1)
+		}
+	case tfValue.Type().Is(tftypes.Bool):
+		var b bool
+
+		if err := tfValue.As(&b); err == nil {
+			return strconv.FormatBool(b)
+		}
+	}
+
+	return tfValue.String()
+}
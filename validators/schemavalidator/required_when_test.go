@@ -0,0 +1,69 @@
+package schemavalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/schemavalidator"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestRequiredWhen(t *testing.T) {
+	t.Parallel()
+
+	validator := schemavalidator.RequiredWhen(otherPath, types.String{Value: "https"})
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"configured": {
+			Validator: validator,
+			Value:     types.String{Value: "test"},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "https"),
+				"test":  tftypes.NewValue(tftypes.String, "test"),
+			}),
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Unknown: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "https"),
+				"test":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"other-different-value": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "http"),
+			}),
+		},
+		"other-null": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config:    testConfig(nil),
+		},
+		"other-unknown": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"missing": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+			Config: testConfig(map[string]tftypes.Value{
+				"other": tftypes.NewValue(tftypes.String, "https"),
+			}),
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Combination",
+					`Attribute test must be specified when other is "https"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,106 @@
+package schemavalidator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// pathValue is the configuration value of an attribute path.
+type pathValue struct {
+	path  path.Path
+	value tftypes.Value
+}
+
+// configured returns true if the value is known and not null.
+func (p pathValue) configured() bool {
+	return p.value.IsKnown() && !p.value.IsNull()
+}
+
+// configValues returns the configuration value of each path, in order. It
+// returns false if any value could not be read.
+func configValues(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse, paths path.Paths) ([]pathValue, bool) {
+	result := make([]pathValue, 0, len(paths))
+
+	for _, path := range paths {
+		var value attr.Value
+
+		diags := req.Config.GetAttribute(ctx, path, &value)
+
+		resp.Diagnostics.Append(diags...)
+
+		if diags.HasError() {
+			return nil, false
+		}
+
+		tfValue, err := value.ToTerraformValue(ctx)
+
+		if err != nil {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(path, err))
+
+			return nil, false
+		}
+
+		result = append(result, pathValue{
+			path:  path,
+			value: tfValue,
+		})
+	}
+
+	return result, true
+}
+
+// isConfigured returns true if the attribute value is known and not null.
+func isConfigured(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) bool {
+	tfValue, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return false
+	}
+
+	return tfValue.IsKnown() && !tfValue.IsNull()
+}
+
+// withPath returns the paths with target prepended, unless it is already one
+// of the paths.
+func withPath(target path.Path, paths path.Paths) path.Paths {
+	for _, p := range paths {
+		if p.Equal(target) {
+			return paths
+		}
+	}
+
+	return append(path.Paths{target}, paths...)
+}
+
+// withoutPath returns the paths, excluding target.
+func withoutPath(target path.Path, paths path.Paths) path.Paths {
+	result := make(path.Paths, 0, len(paths))
+
+	for _, p := range paths {
+		if !p.Equal(target) {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
+
+// formatPaths returns the paths as a bracketed, comma separated list.
+func formatPaths(paths path.Paths) string {
+	formatted := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		formatted = append(formatted, path.String())
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(formatted, ", "))
+}
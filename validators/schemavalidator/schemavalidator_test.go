@@ -0,0 +1,53 @@
+package schemavalidator_test
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	otherPath   = path.Root("other")
+	anotherPath = path.Root("another")
+)
+
+// testConfig returns a configuration with the optional string attributes
+// "test", "other", and "another". Attributes missing from values are null.
+func testConfig(values map[string]tftypes.Value) tfsdk.Config {
+	attrs := map[string]tftypes.Value{
+		"another": tftypes.NewValue(tftypes.String, nil),
+		"other":   tftypes.NewValue(tftypes.String, nil),
+		"test":    tftypes.NewValue(tftypes.String, nil),
+	}
+
+	for name, value := range values {
+		attrs[name] = value
+	}
+
+	return tfsdk.Config{
+		Raw: tftypes.NewValue(tftypes.Object{
+			AttributeTypes: map[string]tftypes.Type{
+				"another": tftypes.String,
+				"other":   tftypes.String,
+				"test":    tftypes.String,
+			},
+		}, attrs),
+		Schema: tfsdk.Schema{
+			Attributes: map[string]tfsdk.Attribute{
+				"another": {
+					Type:     types.StringType,
+					Optional: true,
+				},
+				"other": {
+					Type:     types.StringType,
+					Optional: true,
+				},
+				"test": {
+					Type:     types.StringType,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
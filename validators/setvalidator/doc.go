@@ -0,0 +1,5 @@
+// Package setvalidator provides validators for types.Set attributes.
+//
+// Set elements are always unique, so there is no equivalent of the
+// listvalidator and mapvalidator UniqueValues validators.
+package setvalidator
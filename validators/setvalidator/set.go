@@ -0,0 +1,35 @@
+package setvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// validateSet returns the known elements of the attribute and true, or false
+// if the value is null, unknown, or not a set.
+func validateSet(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) ([]tftypes.Value, bool) {
+	raw, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	if !raw.IsKnown() || raw.IsNull() {
+		return nil, false
+	}
+
+	var elems []tftypes.Value
+
+	if err := raw.As(&elems); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return nil, false
+	}
+
+	return elems, true
+}
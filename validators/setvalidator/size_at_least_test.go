@@ -0,0 +1,63 @@
+package setvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/setvalidator"
+)
+
+func TestSizeAtLeast(t *testing.T) {
+	t.Parallel()
+
+	validator := setvalidator.SizeAtLeast(2)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Set{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Set{ElemType: types.StringType, Unknown: true},
+		},
+		"equal": {
+			Validator: validator,
+			Value: types.Set{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+				},
+			},
+		},
+		"less": {
+			Validator: validator,
+			Value: types.Set{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test set must contain at least 2 elements, got: 1`,
+				),
+			},
+		},
+	})
+}
+
+func TestSizeAtLeastBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, setvalidator.SizeAtLeast(2), tfsdk.AttributeBounds{
+		MinItems: validatortest.Int64Pointer(2),
+	})
+}
@@ -0,0 +1,89 @@
+package setvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/setvalidator"
+)
+
+func TestSizeBetween(t *testing.T) {
+	t.Parallel()
+
+	validator := setvalidator.SizeBetween(1, 2)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.Set{ElemType: types.StringType, Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.Set{ElemType: types.StringType, Unknown: true},
+		},
+		"minimum": {
+			Validator: validator,
+			Value: types.Set{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+				},
+			},
+		},
+		"maximum": {
+			Validator: validator,
+			Value: types.Set{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+				},
+			},
+		},
+		"less": {
+			Validator: validator,
+			Value: types.Set{
+				ElemType: types.StringType,
+				Elems:    []attr.Value{},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test set must contain at least 1 elements and at most 2 elements, got: 0`,
+				),
+			},
+		},
+		"greater": {
+			Validator: validator,
+			Value: types.Set{
+				ElemType: types.StringType,
+				Elems: []attr.Value{
+					types.String{Value: "a"},
+					types.String{Value: "b"},
+					types.String{Value: "c"},
+				},
+			},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value",
+					`Attribute test set must contain at least 1 elements and at most 2 elements, got: 3`,
+				),
+			},
+		},
+	})
+}
+
+func TestSizeBetweenBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, setvalidator.SizeBetween(1, 3), tfsdk.AttributeBounds{
+		MinItems: validatortest.Int64Pointer(1),
+		MaxItems: validatortest.Int64Pointer(3),
+	})
+}
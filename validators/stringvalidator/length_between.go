@@ -0,0 +1,65 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidatorWithBounds = lengthBetweenValidator{}
+
+type lengthBetweenValidator struct {
+	minLength int
+	maxLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v lengthBetweenValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("string length must be between %d and %d", v.minLength, v.maxLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v lengthBetweenValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Bounds returns the bounds enforced by the validator.
+func (v lengthBetweenValidator) Bounds(_ context.Context) tfsdk.AttributeBounds {
+	minLength := int64(v.minLength)
+	maxLength := int64(v.maxLength)
+
+	return tfsdk.AttributeBounds{
+		MinLength: &minLength,
+		MaxLength: &maxLength,
+	}
+}
+
+// Validate performs the validation.
+func (v lengthBetweenValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := validateString(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if l := len(s); l < v.minLength || l > v.maxLength {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueLengthDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			strconv.Itoa(l),
+		))
+	}
+}
+
+// LengthBetween returns an AttributeValidator which ensures that any
+// configured string value has a length in bytes between the minimum and
+// maximum, inclusive. Use UTF8LengthAtMost to count characters instead.
+func LengthBetween(minLength int, maxLength int) tfsdk.AttributeValidator {
+	return lengthBetweenValidator{
+		minLength: minLength,
+		maxLength: maxLength,
+	}
+}
@@ -0,0 +1,67 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/stringvalidator"
+)
+
+func TestLengthBetween(t *testing.T) {
+	t.Parallel()
+
+	validator := stringvalidator.LengthBetween(2, 4)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Unknown: true},
+		},
+		"minimum": {
+			Validator: validator,
+			Value:     types.String{Value: "ab"},
+		},
+		"maximum": {
+			Validator: validator,
+			Value:     types.String{Value: "abcd"},
+		},
+		"too-short": {
+			Validator: validator,
+			Value:     types.String{Value: "a"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Length",
+					`Attribute test string length must be between 2 and 4, got: 1`,
+				),
+			},
+		},
+		"too-long-bytes": {
+			Validator: validator,
+			Value:     types.String{Value: "äää"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Length",
+					`Attribute test string length must be between 2 and 4, got: 6`,
+				),
+			},
+		},
+	})
+}
+
+func TestLengthBetweenBounds(t *testing.T) {
+	t.Parallel()
+
+	validatortest.RunBounds(t, stringvalidator.LengthBetween(1, 3), tfsdk.AttributeBounds{
+		MinLength: validatortest.Int64Pointer(1),
+		MaxLength: validatortest.Int64Pointer(3),
+	})
+}
@@ -0,0 +1,55 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = noneOfValidator{}
+
+type noneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v noneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be none of: %s", quotedValues(v.values))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v noneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v noneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := validateString(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, value := range v.values {
+		if s == value {
+			resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+				req.AttributePath,
+				v.Description(ctx),
+				validatordiag.RedactValue(req, strconv.Quote(s)),
+			))
+
+			return
+		}
+	}
+}
+
+// NoneOf returns an AttributeValidator which ensures that any configured
+// string value is not equal to any of the given values.
+func NoneOf(values ...string) tfsdk.AttributeValidator {
+	return noneOfValidator{
+		values: values,
+	}
+}
@@ -0,0 +1,42 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/stringvalidator"
+)
+
+func TestNoneOf(t *testing.T) {
+	t.Parallel()
+
+	validator := stringvalidator.NoneOf("alpha", "beta")
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Unknown: true},
+		},
+		"no-match": {
+			Validator: validator,
+			Value:     types.String{Value: "gamma"},
+		},
+		"match": {
+			Validator: validator,
+			Value:     types.String{Value: "alpha"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must be none of: "alpha", "beta", got: "alpha"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = oneOfValidator{}
+
+type oneOfValidator struct {
+	values []string
+}
+
+// Description describes the validation in plain text formatting.
+func (v oneOfValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("value must be one of: %s", quotedValues(v.values))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v oneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v oneOfValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := validateString(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	for _, value := range v.values {
+		if s == value {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+		req.AttributePath,
+		v.Description(ctx),
+		validatordiag.RedactValue(req, strconv.Quote(s)),
+	))
+}
+
+// OneOf returns an AttributeValidator which ensures that any configured
+// string value is equal to one of the given values.
+func OneOf(values ...string) tfsdk.AttributeValidator {
+	return oneOfValidator{
+		values: values,
+	}
+}
+
+// quotedValues returns the values as a comma separated list of quoted
+// strings.
+func quotedValues(values []string) string {
+	quoted := make([]string, 0, len(values))
+
+	for _, value := range values {
+		quoted = append(quoted, strconv.Quote(value))
+	}
+
+	return strings.Join(quoted, ", ")
+}
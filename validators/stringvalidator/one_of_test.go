@@ -0,0 +1,54 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/stringvalidator"
+)
+
+func TestOneOf(t *testing.T) {
+	t.Parallel()
+
+	validator := stringvalidator.OneOf("alpha", "beta")
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Unknown: true},
+		},
+		"match": {
+			Validator: validator,
+			Value:     types.String{Value: "beta"},
+		},
+		"mismatch": {
+			Validator: validator,
+			Value:     types.String{Value: "gamma"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: "alpha", "beta", got: "gamma"`,
+				),
+			},
+		},
+		"mismatch-sensitive": {
+			Validator: validator,
+			Value:     types.String{Value: "gamma"},
+			Sensitive: true,
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must be one of: "alpha", "beta", got: (sensitive value)`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,60 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = regexMatchesValidator{}
+
+type regexMatchesValidator struct {
+	regexp  *regexp.Regexp
+	message string
+}
+
+// Description describes the validation in plain text formatting.
+func (v regexMatchesValidator) Description(_ context.Context) string {
+	if v.message != "" {
+		return v.message
+	}
+
+	return fmt.Sprintf("value must match regular expression '%s'", v.regexp)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v regexMatchesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v regexMatchesValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := validateString(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if !v.regexp.MatchString(s) {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueMatchDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			validatordiag.RedactValue(req, strconv.Quote(s)),
+		))
+	}
+}
+
+// RegexMatches returns an AttributeValidator which ensures that any
+// configured string value matches the regular expression. The optional
+// message replaces the default description, such as "value must contain only
+// lowercase alphanumeric characters".
+func RegexMatches(regexp *regexp.Regexp, message string) tfsdk.AttributeValidator {
+	return regexMatchesValidator{
+		regexp:  regexp,
+		message: message,
+	}
+}
@@ -0,0 +1,54 @@
+package stringvalidator_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/stringvalidator"
+)
+
+func TestRegexMatches(t *testing.T) {
+	t.Parallel()
+
+	re := regexp.MustCompile(`^[a-z]+$`)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: stringvalidator.RegexMatches(re, ""),
+			Value:     types.String{Null: true},
+		},
+		"unknown": {
+			Validator: stringvalidator.RegexMatches(re, ""),
+			Value:     types.String{Unknown: true},
+		},
+		"match": {
+			Validator: stringvalidator.RegexMatches(re, ""),
+			Value:     types.String{Value: "abc"},
+		},
+		"mismatch": {
+			Validator: stringvalidator.RegexMatches(re, ""),
+			Value:     types.String{Value: "ABC"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must match regular expression '^[a-z]+$', got: "ABC"`,
+				),
+			},
+		},
+		"mismatch-message": {
+			Validator: stringvalidator.RegexMatches(re, "value must contain only lowercase letters"),
+			Value:     types.String{Value: "ABC"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Match",
+					`Attribute test value must contain only lowercase letters, got: "ABC"`,
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,34 @@
+package stringvalidator
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+// validateString returns the known string value of the attribute and true,
+// or false if the value is null, unknown, or not a string.
+func validateString(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) (string, bool) {
+	raw, err := req.AttributeConfig.ToTerraformValue(ctx)
+
+	if err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return "", false
+	}
+
+	if !raw.IsKnown() || raw.IsNull() {
+		return "", false
+	}
+
+	var s string
+
+	if err := raw.As(&s); err != nil {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeTypeDiagnostic(req.AttributePath, err))
+
+		return "", false
+	}
+
+	return s, true
+}
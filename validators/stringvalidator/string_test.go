@@ -0,0 +1,77 @@
+package stringvalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateString(t *testing.T) {
+	t.Parallel()
+
+	path := path.Root("test")
+
+	testCases := map[string]struct {
+		value         attr.Value
+		expectedValue string
+		expectedOk    bool
+		expectedDiags diag.Diagnostics
+	}{
+		"known": {
+			value:         types.String{Value: "test"},
+			expectedValue: "test",
+			expectedOk:    true,
+		},
+		"null": {
+			value: types.String{Null: true},
+		},
+		"unknown": {
+			value: types.String{Unknown: true},
+		},
+		"wrong-type": {
+			value: types.Bool{Value: true},
+			expectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					path,
+					"Invalid Attribute Type",
+					"An unexpected error was encountered trying to validate an attribute value. This is always an error in the provider. Please report the following to the provider developer:\n\n"+
+						"can't unmarshal tftypes.Bool into *string, expected string",
+				),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfsdk.ValidateAttributeRequest{
+				AttributePath:   path,
+				AttributeConfig: tc.value,
+			}
+			resp := &tfsdk.ValidateAttributeResponse{}
+
+			gotValue, gotOk := validateString(context.Background(), req, resp)
+
+			if gotValue != tc.expectedValue {
+				t.Errorf("expected value %q, got %q", tc.expectedValue, gotValue)
+			}
+
+			if gotOk != tc.expectedOk {
+				t.Errorf("expected ok %t, got %t", tc.expectedOk, gotOk)
+			}
+
+			if diff := cmp.Diff(resp.Diagnostics, tc.expectedDiags); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package stringvalidator
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatordiag"
+)
+
+var _ tfsdk.AttributeValidator = utf8LengthAtMostValidator{}
+
+type utf8LengthAtMostValidator struct {
+	maxLength int
+}
+
+// Description describes the validation in plain text formatting.
+func (v utf8LengthAtMostValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("UTF-8 character count must be at most %d", v.maxLength)
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (v utf8LengthAtMostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// Validate performs the validation.
+func (v utf8LengthAtMostValidator) Validate(ctx context.Context, req tfsdk.ValidateAttributeRequest, resp *tfsdk.ValidateAttributeResponse) {
+	s, ok := validateString(ctx, req, resp)
+
+	if !ok {
+		return
+	}
+
+	if count := utf8.RuneCountInString(s); count > v.maxLength {
+		resp.Diagnostics.Append(validatordiag.InvalidAttributeValueLengthDiagnostic(
+			req.AttributePath,
+			v.Description(ctx),
+			strconv.Itoa(count),
+		))
+	}
+}
+
+// UTF8LengthAtMost returns an AttributeValidator which ensures that any
+// configured string value contains at most the given number of UTF-8
+// encoded characters, rather than bytes.
+func UTF8LengthAtMost(maxLength int) tfsdk.AttributeValidator {
+	return utf8LengthAtMostValidator{
+		maxLength: maxLength,
+	}
+}
@@ -0,0 +1,42 @@
+package stringvalidator_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validators/internal/validatortest"
+	"github.com/hashicorp/terraform-plugin-framework/validators/stringvalidator"
+)
+
+func TestUTF8LengthAtMost(t *testing.T) {
+	t.Parallel()
+
+	validator := stringvalidator.UTF8LengthAtMost(3)
+
+	validatortest.Run(t, map[string]validatortest.TestCase{
+		"null": {
+			Validator: validator,
+			Value:     types.String{Null: true},
+		},
+		"unknown": {
+			Validator: validator,
+			Value:     types.String{Unknown: true},
+		},
+		"multibyte-maximum": {
+			Validator: validator,
+			Value:     types.String{Value: "äää"},
+		},
+		"too-long": {
+			Validator: validator,
+			Value:     types.String{Value: "äääa"},
+			ExpectedDiags: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(
+					validatortest.Path,
+					"Invalid Attribute Value Length",
+					`Attribute test UTF-8 character count must be at most 3, got: 4`,
+				),
+			},
+		},
+	})
+}